@@ -0,0 +1,176 @@
+package poseidon377
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/utils/cpu"
+)
+
+// minVectorBatch is the smallest number of states worth permuting lane-parallel. fr.Vector
+// multiplies in blocks of 16 lanes with AVX-512 and falls back to generic code for the remainder,
+// so smaller batches are slower than the scalar path and stay on it.
+const minVectorBatch = 16
+
+// useVectorPermutation selects the lane-parallel fr.Vector path. It is only enabled when
+// gnark-crypto reports AVX-512 support (and neither the purego nor noavx build tag is set).
+var useVectorPermutation = cpu.SupportAVX512
+
+// HashBatch hashes independent inputs of the same rate with a shared domain, returning
+// Hash(domain, inputs[i]...) for every i. On amd64 with AVX-512 the states are permuted
+// lane-parallel with fr.Vector operations; otherwise each state goes through the scalar path.
+func HashBatch(domain fr.Element, inputs [][]fr.Element) ([]fr.Element, error) {
+	if len(inputs) == 0 {
+		return nil, nil
+	}
+	rate := len(inputs[0])
+	if rate < 1 {
+		return nil, fmt.Errorf("poseidon377: need at least 1 limb")
+	}
+	for i, in := range inputs {
+		if len(in) != rate {
+			return nil, fmt.Errorf("poseidon377: batch input %d has %d limbs, expected %d", i, len(in), rate)
+		}
+	}
+	perm, err := newPermutation(rate)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]fr.Element, len(inputs))
+	if !useVectorPermutation || len(inputs) < minVectorBatch {
+		state := make([]fr.Element, perm.params.StateSize)
+		for i, in := range inputs {
+			state[0] = domain
+			copy(state[1:], in)
+			perm.permute(state)
+			out[i] = state[1]
+		}
+		return out, nil
+	}
+
+	b := newBatchState(perm.params.StateSize, len(inputs))
+	for lane, in := range inputs {
+		b.limbs[0][lane] = domain
+		for i := range in {
+			b.limbs[i+1][lane] = in[i]
+		}
+	}
+	perm.permuteBatch(b)
+	copy(out, b.limbs[1])
+	return out, nil
+}
+
+// batchState holds several permutation states in limb-major order: limbs[i][lane] is limb i of
+// the state in the given lane, so every round operation maps to whole-vector calls.
+type batchState struct {
+	limbs   []fr.Vector
+	scratch []fr.Vector
+	tmp     fr.Vector
+}
+
+func newBatchState(width, lanes int) *batchState {
+	b := &batchState{
+		limbs:   make([]fr.Vector, width),
+		scratch: make([]fr.Vector, width),
+		tmp:     make(fr.Vector, lanes),
+	}
+	for i := 0; i < width; i++ {
+		b.limbs[i] = make(fr.Vector, lanes)
+		b.scratch[i] = make(fr.Vector, lanes)
+	}
+	return b
+}
+
+// permuteBatch applies permute to every lane of b. It mirrors permute step by step.
+func (p *permutation) permuteBatch(b *batchState) {
+	t := p.params.StateSize
+	rF := p.params.FullRounds / 2
+	arc := p.params.OptimizedArc
+
+	for r := 0; r < rF; r++ {
+		addArcRowBatch(b.limbs, arc, r, t)
+		for i := range b.limbs {
+			exp17Batch(b.limbs[i], b.tmp)
+		}
+		denseMatMulBatch(b, p.params.MDS)
+	}
+	round := rF
+
+	addArcRowBatch(b.limbs, arc, round, t)
+	denseMatMulBatch(b, p.params.OptimizedMDS.MI)
+
+	for r := 0; r < p.params.PartialRounds-1; r++ {
+		exp17Batch(b.limbs[0], b.tmp)
+		round++
+		addConstBatch(b.limbs[0], &arc[round*t])
+		p.sparseMatMulBatch(b, p.params.PartialRounds-r-1)
+	}
+
+	exp17Batch(b.limbs[0], b.tmp)
+	p.sparseMatMulBatch(b, 0)
+	round++
+
+	for r := 0; r < rF; r++ {
+		addArcRowBatch(b.limbs, arc, round, t)
+		for i := range b.limbs {
+			exp17Batch(b.limbs[i], b.tmp)
+		}
+		denseMatMulBatch(b, p.params.MDS)
+		round++
+	}
+}
+
+// denseMatMulBatch computes limbs = M·limbs for a row-major t×t matrix.
+func denseMatMulBatch(b *batchState, m []fr.Element) {
+	t := len(b.limbs)
+	for i := 0; i < t; i++ {
+		acc := b.scratch[i]
+		acc.ScalarMul(b.limbs[0], &m[i*t])
+		for j := 1; j < t; j++ {
+			b.tmp.ScalarMul(b.limbs[j], &m[i*t+j])
+			acc.Add(acc, b.tmp)
+		}
+	}
+	b.limbs, b.scratch = b.scratch, b.limbs
+}
+
+func (p *permutation) sparseMatMulBatch(b *batchState, round int) {
+	t := p.params.StateSize
+	subSize := t - 1
+	v := p.params.OptimizedMDS.VCollection[round*subSize : (round+1)*subSize]
+	wHat := p.params.OptimizedMDS.WHatCollection[round*subSize : (round+1)*subSize]
+
+	newZero := b.scratch[0]
+	newZero.ScalarMul(b.limbs[0], &p.params.OptimizedMDS.M00)
+	for i := 0; i < subSize; i++ {
+		b.tmp.ScalarMul(b.limbs[i+1], &wHat[i])
+		newZero.Add(newZero, b.tmp)
+
+		b.tmp.ScalarMul(b.limbs[0], &v[i])
+		b.limbs[i+1].Add(b.limbs[i+1], b.tmp)
+	}
+	b.limbs[0], b.scratch[0] = newZero, b.limbs[0]
+}
+
+func addArcRowBatch(limbs []fr.Vector, arc []fr.Element, row, width int) {
+	offset := row * width
+	for i := 0; i < width; i++ {
+		addConstBatch(limbs[i], &arc[offset+i])
+	}
+}
+
+func addConstBatch(x fr.Vector, c *fr.Element) {
+	for lane := range x {
+		x[lane].Add(&x[lane], c)
+	}
+}
+
+// exp17Batch raises every lane of x to the 17th power, using tmp as scratch.
+func exp17Batch(x, tmp fr.Vector) {
+	tmp.Mul(x, x)
+	tmp.Mul(tmp, tmp)
+	tmp.Mul(tmp, tmp)
+	tmp.Mul(tmp, tmp)
+	x.Mul(tmp, x)
+}
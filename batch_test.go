@@ -0,0 +1,86 @@
+package poseidon377
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+func TestHashBatchMatchesScalar(t *testing.T) {
+	defer func(v bool) { useVectorPermutation = v }(useVectorPermutation)
+
+	domain := DomainFromLEBytes([]byte("Penumbra_TestVec"))
+	for rate := 1; rate <= maxRate; rate++ {
+		for _, n := range []int{1, 7, 8, 16, 17, 40} {
+			inputs := make([][]fr.Element, n)
+			expected := make([]fr.Element, n)
+			for i := range inputs {
+				inputs[i] = make([]fr.Element, rate)
+				for j := range inputs[i] {
+					inputs[i][j].MustSetRandom()
+				}
+				h, err := Hash(domain, inputs[i]...)
+				if err != nil {
+					t.Fatal(err)
+				}
+				expected[i] = h
+			}
+			// The lane-parallel path is forced on as well: fr.Vector falls back to generic
+			// code without AVX-512, so the batch logic is exercised on every machine.
+			for _, vector := range []bool{false, true} {
+				useVectorPermutation = vector
+				got, err := HashBatch(domain, inputs)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for i := range got {
+					if !got[i].Equal(&expected[i]) {
+						t.Fatalf("rate %d batch %d vector=%v lane %d mismatch\nexpected %s\ngot      %s",
+							rate, n, vector, i, expected[i].String(), got[i].String())
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestHashBatchErrors(t *testing.T) {
+	var domain fr.Element
+	if out, err := HashBatch(domain, nil); err != nil || out != nil {
+		t.Fatalf("empty batch: got %v, %v", out, err)
+	}
+	if _, err := HashBatch(domain, [][]fr.Element{{}}); err == nil {
+		t.Fatalf("expected error for empty input")
+	}
+	if _, err := HashBatch(domain, [][]fr.Element{make([]fr.Element, 2), make([]fr.Element, 3)}); err == nil {
+		t.Fatalf("expected error for mixed rates")
+	}
+	if _, err := HashBatch(domain, [][]fr.Element{make([]fr.Element, 8)}); err == nil {
+		t.Fatalf("expected error for unsupported rate")
+	}
+}
+
+func TestMultiHashVectorPathMatchesScalar(t *testing.T) {
+	defer func(v bool) { useVectorPermutation = v }(useVectorPermutation)
+
+	domain := DomainFromLEBytes([]byte("Penumbra_TestVec"))
+	inputs := make([]fr.Element, MaxMultiHashInputs)
+	for i := range inputs {
+		inputs[i].MustSetRandom()
+	}
+	for _, n := range []int{8, 64, 100, MaxMultiHashInputs} {
+		useVectorPermutation = false
+		expected, err := MultiHash(domain, inputs[:n]...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		useVectorPermutation = true
+		got, err := MultiHash(domain, inputs[:n]...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(&expected) {
+			t.Fatalf("multihash %d mismatch\nexpected %s\ngot      %s", n, expected.String(), got.String())
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package poseidon377
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark/frontend"
+)
+
+// Benchmarks report ns/op and, for circuits, a "constraints" metric. cmd/benchcheck records them
+// in testdata/bench/baseline.json and flags regressions; see the README. Sub-benchmarks are named
+// key=value so that the -GOMAXPROCS suffix can be stripped unambiguously.
+
+func benchInputs(n int) []fr.Element {
+	inputs := make([]fr.Element, n)
+	for i := range inputs {
+		inputs[i].SetUint64(uint64(i + 1))
+	}
+	return inputs
+}
+
+func BenchmarkHash(b *testing.B) {
+	domain := DomainFromLEBytes([]byte("Penumbra_TestVec"))
+	for rate := 1; rate <= maxRate; rate++ {
+		inputs := benchInputs(rate)
+		b.Run(fmt.Sprintf("rate=%d", rate), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := Hash(domain, inputs...); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkMultiHash(b *testing.B) {
+	domain := DomainFromLEBytes([]byte("Penumbra_TestVec"))
+	for _, n := range []int{16, 64, 256} {
+		inputs := benchInputs(n)
+		b.Run(fmt.Sprintf("inputs=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := MultiHash(domain, inputs...); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+type benchCircuitCase struct {
+	name   string
+	gadget string
+	kind   int
+	n      int
+}
+
+func benchCircuitCases() []benchCircuitCase {
+	var cases []benchCircuitCase
+	for _, rate := range []int{1, 3, 7} {
+		cases = append(cases, benchCircuitCase{fmt.Sprintf("native/hash/rate=%d", rate), "native", countKindHash, rate})
+	}
+	for _, n := range []int{16, 64, 256} {
+		cases = append(cases, benchCircuitCase{fmt.Sprintf("native/multihash/inputs=%d", n), "native", countKindMultiHash, n})
+	}
+	// Emulated sizes stay small: a 256-input emulated MultiHash is several million constraints.
+	for _, rate := range []int{1, 7} {
+		cases = append(cases, benchCircuitCase{fmt.Sprintf("emulated-bw6/hash/rate=%d", rate), "emulated-bw6", countKindHash, rate})
+	}
+	cases = append(cases, benchCircuitCase{"emulated-bw6/multihash/inputs=16", "emulated-bw6", countKindMultiHash, 16})
+	return cases
+}
+
+// BenchmarkCompile measures circuit compilation and reports the constraint count of each gadget
+// under the R1CS (Groth16) and SCS (PLONK) builders.
+func BenchmarkCompile(b *testing.B) {
+	for _, bc := range countBuilders {
+		for _, tc := range benchCircuitCases() {
+			b.Run(bc.name+"/"+tc.name, func(b *testing.B) {
+				var constraints int
+				for i := 0; i < b.N; i++ {
+					ccs, err := frontend.Compile(countFields[tc.gadget], bc.builder, countCircuit(tc.gadget, tc.kind, tc.n, 1))
+					if err != nil {
+						b.Fatal(err)
+					}
+					constraints = ccs.GetNbConstraints()
+				}
+				b.ReportMetric(float64(constraints), "constraints")
+			})
+		}
+	}
+}
@@ -0,0 +1,210 @@
+// Package preimage provides a ready-made circuit proving knowledge of inputs x such that
+// Poseidon377(domain, x) equals a public digest, with witness builders and Groth16 helpers.
+//
+// The circuit is compiled over the BLS12-377 scalar field with the native gadget. The domain is
+// a compile-time constant of the circuit; the inputs are private and the digest is the only
+// public input.
+package preimage
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/backend/witness"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+
+	poseidon "github.com/vocdoni/poseidon377"
+	gposeidon "github.com/vocdoni/poseidon377/gnark/poseidon377"
+)
+
+// Kind selects the hash function proven by the circuit.
+type Kind int
+
+const (
+	// KindHash is a single permutation over 1..7 inputs (Hash).
+	KindHash Kind = iota
+	// KindMultiHash is the rate-7 tree over 1..MaxMultiHashInputs inputs (MultiHash).
+	KindMultiHash
+	// KindMultiHashV2 is the length-binding tree (MultiHashV2).
+	KindMultiHashV2
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindHash:
+		return "hash"
+	case KindMultiHash:
+		return "multihash"
+	case KindMultiHashV2:
+		return "multihash-v2"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+}
+
+// Circuit proves Digest = H(domain, Inputs...) for the configured kind. Create it with New; the
+// same value serves as the compile-time placeholder and, through Assign, as the assignment.
+type Circuit struct {
+	Inputs []frontend.Variable
+	Digest frontend.Variable `gnark:",public"`
+
+	kind   Kind
+	domain fr.Element
+}
+
+// New returns the placeholder circuit for n inputs hashed with kind under domain.
+func New(kind Kind, domain fr.Element, n int) (*Circuit, error) {
+	switch kind {
+	case KindHash:
+		if n < 1 || n > 7 {
+			return nil, fmt.Errorf("preimage: hash supports 1..7 inputs, got %d", n)
+		}
+	case KindMultiHash, KindMultiHashV2:
+		if n < 1 || n > poseidon.MaxMultiHashInputs {
+			return nil, fmt.Errorf("preimage: %s supports 1..%d inputs, got %d", kind, poseidon.MaxMultiHashInputs, n)
+		}
+	default:
+		return nil, fmt.Errorf("preimage: unknown kind %d", int(kind))
+	}
+	return &Circuit{Inputs: make([]frontend.Variable, n), kind: kind, domain: domain}, nil
+}
+
+// Define implements frontend.Circuit.
+func (c *Circuit) Define(api frontend.API) error {
+	var (
+		out frontend.Variable
+		err error
+	)
+	switch c.kind {
+	case KindHash:
+		out, err = gposeidon.Hash(api, c.domain, c.Inputs...)
+	case KindMultiHash:
+		out, err = gposeidon.MultiHash(api, c.domain, c.Inputs...)
+	case KindMultiHashV2:
+		out, err = gposeidon.MultiHashV2(api, c.domain, c.Inputs...)
+	default:
+		err = fmt.Errorf("preimage: unknown kind %d", int(c.kind))
+	}
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(out, c.Digest)
+	return nil
+}
+
+// NativeDigest computes the native digest of inputs for the circuit's kind and domain.
+func (c *Circuit) NativeDigest(inputs []fr.Element) (fr.Element, error) {
+	if len(inputs) != len(c.Inputs) {
+		return fr.Element{}, fmt.Errorf("preimage: circuit takes %d inputs, got %d", len(c.Inputs), len(inputs))
+	}
+	switch c.kind {
+	case KindHash:
+		return poseidon.Hash(c.domain, inputs...)
+	case KindMultiHash:
+		return poseidon.MultiHash(c.domain, inputs...)
+	default:
+		return poseidon.MultiHashV2(c.domain, inputs...)
+	}
+}
+
+// Assign returns the full assignment for inputs, with the digest computed natively.
+func (c *Circuit) Assign(inputs []fr.Element) (*Circuit, fr.Element, error) {
+	digest, err := c.NativeDigest(inputs)
+	if err != nil {
+		return nil, fr.Element{}, err
+	}
+	assignment := &Circuit{Inputs: make([]frontend.Variable, len(inputs)), Digest: digest, kind: c.kind, domain: c.domain}
+	for i := range inputs {
+		assignment.Inputs[i] = inputs[i]
+	}
+	return assignment, digest, nil
+}
+
+// Witness builds the full (private and public) witness for inputs.
+func (c *Circuit) Witness(inputs []fr.Element) (witness.Witness, fr.Element, error) {
+	assignment, digest, err := c.Assign(inputs)
+	if err != nil {
+		return nil, fr.Element{}, err
+	}
+	w, err := frontend.NewWitness(assignment, ecc.BLS12_377.ScalarField())
+	if err != nil {
+		return nil, fr.Element{}, err
+	}
+	return w, digest, nil
+}
+
+// publicInputs has the same public layout as Circuit.
+type publicInputs struct {
+	Digest frontend.Variable `gnark:",public"`
+}
+
+func (*publicInputs) Define(frontend.API) error { return nil }
+
+// PublicWitness builds the public witness (the digest) used for verification.
+func PublicWitness(digest fr.Element) (witness.Witness, error) {
+	return frontend.NewWitness(&publicInputs{Digest: digest}, ecc.BLS12_377.ScalarField(), frontend.PublicOnly())
+}
+
+// Prover holds a compiled circuit and its Groth16 keys.
+type Prover struct {
+	circuit *Circuit
+	ccs     constraint.ConstraintSystem
+	pk      groth16.ProvingKey
+	vk      groth16.VerifyingKey
+}
+
+// Setup compiles c and runs a Groth16 setup. The setup is not a ceremony: it is only suitable for
+// tests and development; production keys must come from an MPC and be loaded with NewProver.
+func Setup(c *Circuit) (*Prover, error) {
+	ccs, err := Compile(c)
+	if err != nil {
+		return nil, err
+	}
+	pk, vk, err := groth16.Setup(ccs)
+	if err != nil {
+		return nil, err
+	}
+	return &Prover{circuit: c, ccs: ccs, pk: pk, vk: vk}, nil
+}
+
+// NewProver wraps existing keys for the compiled circuit.
+func NewProver(c *Circuit, ccs constraint.ConstraintSystem, pk groth16.ProvingKey, vk groth16.VerifyingKey) *Prover {
+	return &Prover{circuit: c, ccs: ccs, pk: pk, vk: vk}
+}
+
+// Compile compiles c to an R1CS over the BLS12-377 scalar field.
+func Compile(c *Circuit) (constraint.ConstraintSystem, error) {
+	return frontend.Compile(ecc.BLS12_377.ScalarField(), r1cs.NewBuilder, c)
+}
+
+// ConstraintSystem returns the compiled circuit.
+func (p *Prover) ConstraintSystem() constraint.ConstraintSystem { return p.ccs }
+
+// VerifyingKey returns the Groth16 verifying key.
+func (p *Prover) VerifyingKey() groth16.VerifyingKey { return p.vk }
+
+// Prove proves knowledge of inputs and returns the proof with its public digest.
+func (p *Prover) Prove(inputs []fr.Element) (groth16.Proof, fr.Element, error) {
+	w, digest, err := p.circuit.Witness(inputs)
+	if err != nil {
+		return nil, fr.Element{}, err
+	}
+	proof, err := groth16.Prove(p.ccs, p.pk, w)
+	if err != nil {
+		return nil, fr.Element{}, err
+	}
+	return proof, digest, nil
+}
+
+// Verify checks a proof that the prover knows a preimage of digest.
+func Verify(vk groth16.VerifyingKey, proof groth16.Proof, digest fr.Element) error {
+	public, err := PublicWitness(digest)
+	if err != nil {
+		return err
+	}
+	return groth16.Verify(proof, vk, public)
+}
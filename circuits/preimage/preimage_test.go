@@ -0,0 +1,133 @@
+package preimage
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark/logger"
+	"github.com/consensys/gnark/test"
+
+	poseidon "github.com/vocdoni/poseidon377"
+)
+
+var testDomain = poseidon.DomainFromLEBytes([]byte("Penumbra_TestVec"))
+
+func testInputs(n int) []fr.Element {
+	inputs := make([]fr.Element, n)
+	for i := range inputs {
+		inputs[i].SetUint64(uint64(i + 1))
+	}
+	return inputs
+}
+
+func TestCircuitSolves(t *testing.T) {
+	cases := []struct {
+		kind Kind
+		n    int
+	}{
+		{KindMultiHash, 8},
+		{KindMultiHash, 50},
+		{KindMultiHashV2, 16},
+	}
+	for rate := 1; rate <= 7; rate++ {
+		cases = append(cases, struct {
+			kind Kind
+			n    int
+		}{KindHash, rate})
+	}
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("%s/%d", tc.kind, tc.n), func(t *testing.T) {
+			c, err := New(tc.kind, testDomain, tc.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			inputs := testInputs(tc.n)
+			assignment, digest, err := c.Assign(inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := test.IsSolved(c, assignment, ecc.BLS12_377.ScalarField()); err != nil {
+				t.Fatal(err)
+			}
+
+			// The digest is bound to every input.
+			inputs[tc.n-1].SetUint64(1000)
+			other, err := c.NativeDigest(inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if other.Equal(&digest) {
+				t.Fatalf("digest does not depend on the last input")
+			}
+			assignment.Digest = other
+			if err := test.IsSolved(c, assignment, ecc.BLS12_377.ScalarField()); err == nil {
+				t.Fatalf("circuit accepted a wrong digest")
+			}
+		})
+	}
+}
+
+func TestNewRejectsSizes(t *testing.T) {
+	for _, tc := range []struct {
+		kind Kind
+		n    int
+	}{
+		{KindHash, 0}, {KindHash, 8}, {KindMultiHash, 0}, {KindMultiHashV2, poseidon.MaxMultiHashInputs + 1}, {Kind(9), 1},
+	} {
+		if _, err := New(tc.kind, testDomain, tc.n); err == nil {
+			t.Fatalf("%s with %d inputs accepted", tc.kind, tc.n)
+		}
+	}
+	c, err := New(KindHash, testDomain, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := c.Assign(testInputs(3)); err == nil {
+		t.Fatalf("assignment with the wrong input count accepted")
+	}
+}
+
+func TestGroth16(t *testing.T) {
+	logger.Disable()
+	for _, tc := range []struct {
+		kind Kind
+		n    int
+	}{
+		{KindHash, 2},
+		{KindMultiHashV2, 16},
+	} {
+		t.Run(fmt.Sprintf("%s/%d", tc.kind, tc.n), func(t *testing.T) {
+			c, err := New(tc.kind, testDomain, tc.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			prover, err := Setup(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			inputs := testInputs(tc.n)
+			proof, digest, err := prover.Prove(inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := c.NativeDigest(inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !digest.Equal(&want) {
+				t.Fatalf("prove returned digest %s, expected %s", digest.String(), want.String())
+			}
+			if err := Verify(prover.VerifyingKey(), proof, digest); err != nil {
+				t.Fatal(err)
+			}
+
+			var wrong fr.Element
+			wrong.Add(&digest, new(fr.Element).SetOne())
+			if err := Verify(prover.VerifyingKey(), proof, wrong); err == nil {
+				t.Fatalf("proof verified against a wrong digest")
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+// Command benchcheck records `go test -bench` results in a JSON baseline and compares new runs
+// against it.
+//
+// Usage:
+//
+//	go test -run '^$' -bench . -count 5 | benchcheck [-baseline FILE] [-threshold 0.25]
+//	go test -run '^$' -bench . -count 5 | benchcheck -write [-baseline FILE]
+//
+// For every benchmark the minimum of each metric over all runs is kept. Timing and memory
+// metrics (ns/op, B/op, allocs/op) regress when they exceed the baseline by more than the
+// threshold; the "constraints" metric is deterministic and regresses on any increase.
+// Benchmarks missing from either side are reported but never fail the check.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Baseline is the on-disk format: benchmark name -> metric unit -> value.
+type Baseline struct {
+	GoOS       string                        `json:"goos,omitempty"`
+	GoArch     string                        `json:"goarch,omitempty"`
+	CPU        string                        `json:"cpu,omitempty"`
+	Benchmarks map[string]map[string]float64 `json:"benchmarks"`
+}
+
+// exactMetrics regress on any increase.
+var exactMetrics = map[string]bool{"constraints": true}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "benchcheck:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	fs := flag.NewFlagSet("benchcheck", flag.ContinueOnError)
+	path := fs.String("baseline", "testdata/bench/baseline.json", "baseline file")
+	threshold := fs.Float64("threshold", 0.25, "allowed relative increase for timing and memory metrics")
+	write := fs.Bool("write", false, "write the results to the baseline instead of comparing")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	current, err := parse(stdin)
+	if err != nil {
+		return err
+	}
+	if len(current.Benchmarks) == 0 {
+		return fmt.Errorf("no benchmark results on stdin")
+	}
+
+	if *write {
+		data, err := json.MarshalIndent(current, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(*path, append(data, '\n'), 0o644); err != nil {
+			return err
+		}
+		fmt.Fprintf(stdout, "wrote %d benchmarks to %s\n", len(current.Benchmarks), *path)
+		return nil
+	}
+
+	data, err := os.ReadFile(*path)
+	if err != nil {
+		return err
+	}
+	var base Baseline
+	if err := json.Unmarshal(data, &base); err != nil {
+		return fmt.Errorf("parse %s: %w", *path, err)
+	}
+	regressions := compare(&base, current, *threshold, stdout)
+	if regressions > 0 {
+		return fmt.Errorf("%d regressions against %s", regressions, *path)
+	}
+	return nil
+}
+
+var procSuffix = regexp.MustCompile(`-\d+$`)
+
+// parse reads `go test -bench` output and keeps the minimum of each metric per benchmark.
+func parse(r io.Reader) (*Baseline, error) {
+	b := &Baseline{Benchmarks: map[string]map[string]float64{}}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		for _, key := range []struct {
+			prefix string
+			dst    *string
+		}{{"goos: ", &b.GoOS}, {"goarch: ", &b.GoArch}, {"cpu: ", &b.CPU}} {
+			if strings.HasPrefix(line, key.prefix) {
+				*key.dst = strings.TrimPrefix(line, key.prefix)
+			}
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 4 || !strings.HasPrefix(fields[0], "Benchmark") || len(fields)%2 != 0 {
+			continue
+		}
+		if _, err := strconv.Atoi(fields[1]); err != nil {
+			continue
+		}
+		name := procSuffix.ReplaceAllString(fields[0], "")
+		metrics := b.Benchmarks[name]
+		if metrics == nil {
+			metrics = map[string]float64{}
+			b.Benchmarks[name] = metrics
+		}
+		for i := 2; i < len(fields); i += 2 {
+			v, err := strconv.ParseFloat(fields[i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("parse %q: %w", line, err)
+			}
+			unit := fields[i+1]
+			if old, ok := metrics[unit]; ok {
+				v = math.Min(old, v)
+			}
+			metrics[unit] = v
+		}
+	}
+	return b, scanner.Err()
+}
+
+// compare prints one line per metric outside its tolerance and returns the number of regressions.
+func compare(base, current *Baseline, threshold float64, w io.Writer) int {
+	names := make([]string, 0, len(current.Benchmarks))
+	for name := range current.Benchmarks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	regressions := 0
+	for _, name := range names {
+		want, ok := base.Benchmarks[name]
+		if !ok {
+			fmt.Fprintf(w, "new       %s\n", name)
+			continue
+		}
+		got := current.Benchmarks[name]
+		units := make([]string, 0, len(got))
+		for unit := range got {
+			units = append(units, unit)
+		}
+		sort.Strings(units)
+		for _, unit := range units {
+			old, ok := want[unit]
+			if !ok {
+				continue
+			}
+			v := got[unit]
+			limit := old * (1 + threshold)
+			if exactMetrics[unit] {
+				limit = old
+			}
+			switch {
+			case v > limit:
+				regressions++
+				fmt.Fprintf(w, "REGRESSED %s %s: %g -> %g (%+.1f%%)\n", name, unit, old, v, change(old, v))
+			case v < old && exactMetrics[unit]:
+				fmt.Fprintf(w, "improved  %s %s: %g -> %g, update the baseline\n", name, unit, old, v)
+			}
+		}
+	}
+	var missing []string
+	for name := range base.Benchmarks {
+		if _, ok := current.Benchmarks[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	sort.Strings(missing)
+	for _, name := range missing {
+		fmt.Fprintf(w, "not run   %s\n", name)
+	}
+	return regressions
+}
+
+func change(old, v float64) float64 {
+	if old == 0 {
+		return math.Inf(1)
+	}
+	return (v - old) / old * 100
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const sampleOutput = `goos: linux
+goarch: amd64
+pkg: github.com/vocdoni/poseidon377
+cpu: Test CPU
+BenchmarkHash/rate=1-8         	   20000	     50000 ns/op	     496 B/op	       8 allocs/op
+BenchmarkHash/rate=1-8         	   20000	     48000 ns/op	     496 B/op	       8 allocs/op
+BenchmarkCompile/r1cs/native/hash/rate=1-8   	       1	   1000590 ns/op	       236.0 constraints
+PASS
+ok  	github.com/vocdoni/poseidon377	12.013s
+`
+
+func TestParseKeepsMinimum(t *testing.T) {
+	b, err := parse(strings.NewReader(sampleOutput))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.CPU != "Test CPU" || b.GoArch != "amd64" {
+		t.Fatalf("unexpected header: %+v", b)
+	}
+	hash := b.Benchmarks["BenchmarkHash/rate=1"]
+	if hash["ns/op"] != 48000 || hash["allocs/op"] != 8 {
+		t.Fatalf("unexpected hash metrics: %v", hash)
+	}
+	if got := b.Benchmarks["BenchmarkCompile/r1cs/native/hash/rate=1"]["constraints"]; got != 236 {
+		t.Fatalf("unexpected constraints: %v", got)
+	}
+}
+
+func TestWriteAndCompare(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	var out bytes.Buffer
+	if err := run([]string{"-write", "-baseline", path}, strings.NewReader(sampleOutput), &out); err != nil {
+		t.Fatal(err)
+	}
+	if err := run([]string{"-baseline", path}, strings.NewReader(sampleOutput), &out); err != nil {
+		t.Fatalf("identical run reported: %v\n%s", err, out.String())
+	}
+
+	// 10% slower is within the default threshold; one extra constraint is not.
+	slower := strings.ReplaceAll(sampleOutput, "48000 ns/op", "52800 ns/op")
+	slower = strings.ReplaceAll(slower, "50000 ns/op", "52800 ns/op")
+	if err := run([]string{"-baseline", path}, strings.NewReader(slower), &out); err != nil {
+		t.Fatalf("10%% slowdown reported: %v", err)
+	}
+	if err := run([]string{"-baseline", path, "-threshold", "0.05"}, strings.NewReader(slower), &out); err == nil {
+		t.Fatalf("10%% slowdown not reported with a 5%% threshold")
+	}
+	out.Reset()
+	costlier := strings.ReplaceAll(sampleOutput, "236.0 constraints", "237.0 constraints")
+	if err := run([]string{"-baseline", path}, strings.NewReader(costlier), &out); err == nil {
+		t.Fatalf("constraint increase not reported")
+	}
+	if !strings.Contains(out.String(), "REGRESSED BenchmarkCompile/r1cs/native/hash/rate=1 constraints") {
+		t.Fatalf("unexpected report:\n%s", out.String())
+	}
+}
+
+func TestBaselineParses(t *testing.T) {
+	data, err := os.ReadFile("../../testdata/bench/baseline.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b Baseline
+	if err := json.Unmarshal(data, &b); err != nil {
+		t.Fatal(err)
+	}
+	if got := b.Benchmarks["BenchmarkCompile/r1cs/native/hash/rate=1"]["constraints"]; got == 0 {
+		t.Fatalf("baseline is missing the compile constraint counts")
+	}
+}
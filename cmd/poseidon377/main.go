@@ -0,0 +1,215 @@
+// Command poseidon377 hashes field elements and dumps test vectors and parameters.
+//
+// Usage:
+//
+//	poseidon377 hash [-rate N] [-domain STR] [-hex] [inputs...]
+//	poseidon377 multihash [-domain STR] [-hex] [inputs...]
+//	poseidon377 domain [-hex] STR
+//	poseidon377 vectors [-format markdown|json] [-rates N]
+//	poseidon377 params -rate N [-format json|toml]
+//	poseidon377 jsconstants [-o FILE]
+//
+// Inputs are decimal or 0x-prefixed hex field elements. When no inputs are given on the command
+// line they are read from stdin, separated by whitespace. Domains are strings interpreted as
+// little-endian bytes (DomainFromLEBytes).
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+
+	poseidon "github.com/vocdoni/poseidon377"
+)
+
+const usage = `usage: poseidon377 <command> [flags] [args]
+
+commands:
+  hash         hash 1..7 inputs with a single permutation
+  multihash    hash up to 256 inputs with the rate-7 tree
+  domain       print the domain separator for a string
+  vectors      print test vectors (markdown or json)
+  params       dump the parameter set for a rate in canonical form (json or toml)
+  jsconstants  print js/src/constants.ts for the TypeScript port
+`
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "poseidon377:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing command\n%s", usage)
+	}
+	cmd, args := args[0], args[1:]
+	switch cmd {
+	case "hash":
+		return runHash(args, stdin, stdout)
+	case "multihash":
+		return runMultiHash(args, stdin, stdout)
+	case "domain":
+		return runDomain(args, stdout)
+	case "vectors":
+		return runVectors(args, stdout)
+	case "params":
+		return runParams(args, stdout)
+	case "jsconstants":
+		return runJSConstants(args, stdout)
+	case "help", "-h", "-help", "--help":
+		_, err := fmt.Fprint(stdout, usage)
+		return err
+	default:
+		return fmt.Errorf("unknown command %q\n%s", cmd, usage)
+	}
+}
+
+func runHash(args []string, stdin io.Reader, stdout io.Writer) error {
+	fs := flag.NewFlagSet("hash", flag.ContinueOnError)
+	rate := fs.Int("rate", 0, "expected number of inputs (1..7); defaults to len(inputs)")
+	domain := fs.String("domain", "", "domain separator string (little-endian bytes)")
+	hexOut := fs.Bool("hex", false, "print the digest as 0x-prefixed hex")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	inputs, err := readInputs(fs.Args(), stdin)
+	if err != nil {
+		return err
+	}
+	if *rate != 0 && *rate != len(inputs) {
+		return fmt.Errorf("rate %d does not match %d inputs", *rate, len(inputs))
+	}
+	d, err := poseidon.HashDigest(poseidon.DomainFromLEBytes([]byte(*domain)), inputs...)
+	if err != nil {
+		return err
+	}
+	return printDigest(stdout, d, *hexOut)
+}
+
+func runMultiHash(args []string, stdin io.Reader, stdout io.Writer) error {
+	fs := flag.NewFlagSet("multihash", flag.ContinueOnError)
+	domain := fs.String("domain", "", "domain separator string (little-endian bytes)")
+	hexOut := fs.Bool("hex", false, "print the digest as 0x-prefixed hex")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	inputs, err := readInputs(fs.Args(), stdin)
+	if err != nil {
+		return err
+	}
+	d, err := poseidon.MultiHashDigest(poseidon.DomainFromLEBytes([]byte(*domain)), inputs...)
+	if err != nil {
+		return err
+	}
+	return printDigest(stdout, d, *hexOut)
+}
+
+func runDomain(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("domain", flag.ContinueOnError)
+	hexOut := fs.Bool("hex", false, "print the element as 0x-prefixed hex")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("domain expects exactly one string argument")
+	}
+	d := poseidon.Digest(poseidon.DomainFromLEBytes([]byte(fs.Arg(0))))
+	return printDigest(stdout, d, *hexOut)
+}
+
+func printDigest(w io.Writer, d poseidon.Digest, hexOut bool) error {
+	if hexOut {
+		text, err := d.MarshalText()
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(text))
+		return err
+	}
+	_, err := fmt.Fprintln(w, d.String())
+	return err
+}
+
+// readInputs parses args, or whitespace-separated stdin tokens when args is empty.
+func readInputs(args []string, stdin io.Reader) ([]fr.Element, error) {
+	if len(args) == 0 {
+		scanner := bufio.NewScanner(stdin)
+		scanner.Split(bufio.ScanWords)
+		for scanner.Scan() {
+			args = append(args, scanner.Text())
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("read stdin: %w", err)
+		}
+	}
+	if len(args) == 0 {
+		return nil, fmt.Errorf("no inputs")
+	}
+	out := make([]fr.Element, len(args))
+	for i, s := range args {
+		e, err := parseElement(s)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = e
+	}
+	return out, nil
+}
+
+// parseElement accepts decimal or 0x-prefixed hex values in [0, r).
+func parseElement(s string) (fr.Element, error) {
+	base := 10
+	digits := s
+	if len(s) > 2 && (s[:2] == "0x" || s[:2] == "0X") {
+		base, digits = 16, s[2:]
+	}
+	bi, ok := new(big.Int).SetString(digits, base)
+	if !ok || bi.Sign() < 0 {
+		return fr.Element{}, fmt.Errorf("invalid input %q", s)
+	}
+	if bi.Cmp(fr.Modulus()) >= 0 {
+		return fr.Element{}, fmt.Errorf("input %q is not below the field modulus", s)
+	}
+	var e fr.Element
+	e.SetBigInt(bi)
+	return e, nil
+}
+
+// writeOutput runs write on stdout, or on a temporary file next to path that replaces path only
+// once write and Close succeed, so a failed run never leaves a truncated file behind.
+func writeOutput(path string, stdout io.Writer, write func(io.Writer) error) error {
+	if path == "" {
+		return write(stdout)
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	if err := write(f); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := os.Chmod(tmp, 0o644); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+
+	"github.com/vocdoni/poseidon377/internal/params"
+)
+
+func runCmd(t *testing.T, stdin string, args ...string) string {
+	t.Helper()
+	var out bytes.Buffer
+	if err := run(args, strings.NewReader(stdin), &out); err != nil {
+		t.Fatalf("%v: %v", args, err)
+	}
+	return out.String()
+}
+
+func TestVectorsMatchDocs(t *testing.T) {
+	docs, err := os.ReadFile("../../docs/test_vectors.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := runCmd(t, "", "vectors"); got != string(docs) {
+		t.Fatalf("docs/test_vectors.md is stale, regenerate with `go run ./cmd/poseidon377 vectors > docs/test_vectors.md`")
+	}
+
+	var set vectorSet
+	if err := json.Unmarshal([]byte(runCmd(t, "", "vectors", "-format", "json", "-rates", "7")), &set); err != nil {
+		t.Fatal(err)
+	}
+	if len(set.Vectors) != 7 || set.Vectors[5].Output != "6797655301930638258044003960605211404784492298673033525596396177265014216269" {
+		t.Fatalf("unexpected json vectors: %+v", set)
+	}
+}
+
+func TestHashCommands(t *testing.T) {
+	const expected = "2337838243217876174544784248400816541933405738836087430664765452605435675740\n"
+
+	if got := runCmd(t, "", "hash", "-domain", vectorDomain, vectorSeed); got != expected {
+		t.Fatalf("hash mismatch: %s", got)
+	}
+	if got := runCmd(t, vectorSeed+"\n", "hash", "-rate", "1", "-domain", vectorDomain); got != expected {
+		t.Fatalf("hash from stdin mismatch: %s", got)
+	}
+	seed, _ := new(big.Int).SetString(vectorSeed, 10)
+	if got := runCmd(t, "", "hash", "-domain", vectorDomain, "0x"+seed.Text(16)); got != expected {
+		t.Fatalf("hash with hex input mismatch: %s", got)
+	}
+	if got := runCmd(t, "", "hash", "-domain", vectorDomain, "-hex", vectorSeed); got != "0x052b2b67e91a79824a66e464d19909b6cb1406d78ed864420b3bc502c284045c\n" {
+		t.Fatalf("hex output mismatch: %s", got)
+	}
+
+	// Matches js/test/poseidon377.test.ts.
+	if got := runCmd(t, "", "multihash", "1", "2", "3", "4", "5", "6", "7", "8"); got != "5764845866250656314303187921704945420217061658264314081928253972326618949319\n" {
+		t.Fatalf("multihash mismatch: %s", got)
+	}
+	if got := runCmd(t, "", "domain", "abc"); got != "6513249\n" {
+		t.Fatalf("domain mismatch: %s", got)
+	}
+}
+
+func TestParamsCommand(t *testing.T) {
+	var dump params.Canonical
+	if err := json.Unmarshal([]byte(runCmd(t, "", "params", "-rate", "2")), &dump); err != nil {
+		t.Fatal(err)
+	}
+	if dump.StateSize != 3 || len(dump.MDS) != 9 || len(dump.Arc) != (dump.FullRounds+dump.PartialRounds)*3 {
+		t.Fatalf("unexpected params dump: t=%d mds=%d arc=%d", dump.StateSize, len(dump.MDS), len(dump.Arc))
+	}
+	if _, err := dump.Import(); err != nil {
+		t.Fatal(err)
+	}
+	if toml := runCmd(t, "", "params", "-rate", "2", "-format", "toml"); !strings.Contains(toml, "\n[optimized_mds]\n") {
+		t.Fatalf("unexpected toml dump:\n%.200s", toml)
+	}
+}
+
+func TestDecimalNearModulus(t *testing.T) {
+	var e fr.Element
+	e.SetOne()
+	e.Neg(&e)
+	if got, want := decimal(e), new(big.Int).Sub(fr.Modulus(), big.NewInt(1)).String(); got != want {
+		t.Fatalf("r-1 printed as %s", got)
+	}
+}
+
+func TestJSConstantsCommand(t *testing.T) {
+	got := runCmd(t, "", "jsconstants")
+	if !strings.HasPrefix(got, "export const M00: Record<number, string> = {") || !strings.HasSuffix(got, "export const PARTIAL_ROUNDS = 31;\n") {
+		t.Fatalf("unexpected constants.ts output:\n%.200s", got)
+	}
+}
+
+func TestWriteOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test_vectors.md")
+	if err := os.WriteFile(path, []byte("previous"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	failed := func(w io.Writer) error {
+		io.WriteString(w, "partial")
+		return errors.New("write failed")
+	}
+	if err := writeOutput(path, nil, failed); err == nil {
+		t.Fatalf("expected the write error")
+	}
+	if got, err := os.ReadFile(path); err != nil || string(got) != "previous" {
+		t.Fatalf("failed write replaced the file: %q, %v", got, err)
+	}
+
+	runCmd(t, "", "vectors", "-o", path)
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != runCmd(t, "", "vectors") {
+		t.Fatalf("-o output differs from stdout")
+	}
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("temporary files left behind: %v, %v", entries, err)
+	}
+}
+
+func TestCommandErrors(t *testing.T) {
+	cases := [][]string{
+		{},
+		{"unknown"},
+		{"hash", "-rate", "2", "1"},
+		{"hash", "abc"},
+		{"hash", "0x12ab655e9a2ca55660b44d1e5c37b00159aa76fed00000010a11800000000001"},
+		{"hash", "1", "2", "3", "4", "5", "6", "7", "8"},
+		{"domain"},
+		{"vectors", "-rates", "8"},
+		{"params", "-rate", "9"},
+		{"params", "-rate", "1", "-format", "yaml"},
+		{"jsconstants", "extra"},
+	}
+	for _, args := range cases {
+		if err := run(args, strings.NewReader(""), &bytes.Buffer{}); err == nil {
+			t.Fatalf("%v: expected error", args)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+
+	"github.com/vocdoni/poseidon377/internal/jsconst"
+	"github.com/vocdoni/poseidon377/internal/params"
+)
+
+// runParams dumps a parameter set in the canonical form of params.Export.
+func runParams(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("params", flag.ContinueOnError)
+	rate := fs.Int("rate", 0, "rate of the parameter set (1..7)")
+	format := fs.String("format", "json", "output format: json or toml")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	p, ok := params.AllParameters[*rate]
+	if !ok {
+		return fmt.Errorf("unsupported rate %d", *rate)
+	}
+	c := params.Export(p)
+	switch *format {
+	case "json":
+		return c.WriteJSON(stdout)
+	case "toml":
+		return c.WriteTOML(stdout)
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+}
+
+func runJSConstants(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("jsconstants", flag.ContinueOnError)
+	output := fs.String("o", "", "write to this file instead of stdout")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return fmt.Errorf("jsconstants takes no arguments")
+	}
+	return writeOutput(*output, stdout, jsconst.Write)
+}
+
+// decimal avoids fr.Element.String, which prints values close to r as small negatives.
+func decimal(e fr.Element) string {
+	return params.Decimal(e)
+}
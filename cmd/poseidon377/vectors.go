@@ -0,0 +1,122 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+
+	poseidon "github.com/vocdoni/poseidon377"
+	"github.com/vocdoni/poseidon377/internal/testvectors"
+)
+
+const (
+	vectorDomain = "Penumbra_TestVec"
+	vectorSeed   = "7553885614632219548127688026174585776320152166623257619763178041781456016062"
+)
+
+type vector struct {
+	Rate   int      `json:"rate"`
+	Inputs []string `json:"inputs"`
+	Output string   `json:"output"`
+}
+
+type vectorSet struct {
+	Domain  string   `json:"domain"`
+	Vectors []vector `json:"vectors"`
+}
+
+// chainedVectors reproduces docs/test_vectors.md: the rate-N inputs are the seed followed by the
+// outputs of rates 1..N-1.
+func chainedVectors(maxRate int) (vectorSet, error) {
+	domain := poseidon.DomainFromLEBytes([]byte(vectorDomain))
+	var seed fr.Element
+	if _, err := seed.SetString(vectorSeed); err != nil {
+		return vectorSet{}, err
+	}
+	chain := []fr.Element{seed}
+	set := vectorSet{Domain: vectorDomain}
+	for rate := 1; rate <= maxRate; rate++ {
+		out, err := poseidon.Hash(domain, chain[:rate]...)
+		if err != nil {
+			return vectorSet{}, err
+		}
+		v := vector{Rate: rate, Output: decimal(out)}
+		for i := range rate {
+			v.Inputs = append(v.Inputs, decimal(chain[i]))
+		}
+		set.Vectors = append(set.Vectors, v)
+		chain = append(chain, out)
+	}
+	return set, nil
+}
+
+func runVectors(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("vectors", flag.ContinueOnError)
+	format := fs.String("format", "markdown", "output format: markdown, json or suite (testdata/vectors.json)")
+	maxRate := fs.Int("rates", 6, "generate vectors for rates 1..N (max 7)")
+	output := fs.String("o", "", "write to this file instead of stdout")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	return writeOutput(*output, stdout, func(w io.Writer) error {
+		return writeVectors(w, *format, *maxRate)
+	})
+}
+
+func writeVectors(w io.Writer, format string, maxRate int) error {
+	if format == "suite" {
+		suite, err := testvectors.Generate()
+		if err != nil {
+			return err
+		}
+		return testvectors.Write(w, suite)
+	}
+	if maxRate < 1 || maxRate > 7 {
+		return fmt.Errorf("rates must be in 1..7, got %d", maxRate)
+	}
+	set, err := chainedVectors(maxRate)
+	if err != nil {
+		return err
+	}
+	switch format {
+	case "markdown", "md":
+		_, err = io.WriteString(w, vectorsMarkdown(set))
+		return err
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(set)
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+func vectorsMarkdown(set vectorSet) string {
+	var sb strings.Builder
+	sb.WriteString(`# Test Vectors
+
+The following are test vectors for the ` + "`poseidon377`" + ` Poseidon instantiation.
+
+Each section is for a given rate of a fixed-width hash function, where
+capacity is 1.  Inputs and output are $F_q$ elements. The domain separator
+used in each case are the bytes ` + "`\"" + set.Domain + "\"`" + ` decoded to an $F_q$ element,
+where we interpret these bytes in little-endian order.
+`)
+	for _, v := range set.Vectors {
+		fmt.Fprintf(&sb, "\n## Rate %d\n\n", v.Rate)
+		if len(v.Inputs) == 1 {
+			sb.WriteString("Input element:\n")
+		} else {
+			sb.WriteString("Input elements:\n")
+		}
+		for _, in := range v.Inputs {
+			fmt.Fprintf(&sb, "* `%s`\n", in)
+		}
+		fmt.Fprintf(&sb, "\nOutput element:\n* `%s`\n", v.Output)
+	}
+	return sb.String()
+}
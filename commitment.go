@@ -0,0 +1,40 @@
+package poseidon377
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+
+	"github.com/vocdoni/poseidon377/internal/domain"
+)
+
+// CommitmentDomain is the domain separator used by Commit ("poseidon377.commitment" as LE bytes).
+var CommitmentDomain = domain.Commitment
+
+// Commitment is a hiding and binding commitment to a tuple of field elements. It shares the
+// Digest encodings.
+type Commitment = Digest
+
+// Commit computes MultiHashV2(CommitmentDomain, blinding, values...). The blinding factor must be
+// sampled uniformly at random and kept secret until the commitment is opened.
+// Supports up to MaxMultiHashInputs-1 values. MultiHashV2 binds the number of values, so a
+// commitment cannot be opened to tuples of different lengths.
+func Commit(blinding fr.Element, values ...fr.Element) (Commitment, error) {
+	if len(values) == 0 {
+		return Commitment{}, fmt.Errorf("poseidon377: need at least 1 value to commit")
+	}
+	inputs := make([]fr.Element, 0, len(values)+1)
+	inputs = append(inputs, blinding)
+	inputs = append(inputs, values...)
+	out, err := MultiHashV2(CommitmentDomain, inputs...)
+	return Commitment(out), err
+}
+
+// VerifyOpening reports whether (blinding, values...) opens the commitment.
+func VerifyOpening(c Commitment, blinding fr.Element, values ...fr.Element) bool {
+	recomputed, err := Commit(blinding, values...)
+	if err != nil {
+		return false
+	}
+	return c.Equal(&recomputed)
+}
@@ -0,0 +1,153 @@
+package poseidon377
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark/backend"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+
+	gposeidon "github.com/vocdoni/poseidon377/gnark/poseidon377"
+)
+
+func TestCommitOpening(t *testing.T) {
+	var blinding fr.Element
+	if _, err := blinding.SetRandom(); err != nil {
+		t.Fatal(err)
+	}
+	values := make([]fr.Element, 9)
+	for i := range values {
+		values[i].SetUint64(uint64(i + 1))
+	}
+
+	c, err := Commit(blinding, values...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !VerifyOpening(c, blinding, values...) {
+		t.Fatalf("valid opening rejected")
+	}
+
+	expected, err := MultiHashV2(CommitmentDomain, append([]fr.Element{blinding}, values...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out := c.Element(); !out.Equal(&expected) {
+		t.Fatalf("commitment mismatch\nexpected %s\ngot      %s", expected.String(), out.String())
+	}
+
+	var otherBlinding fr.Element
+	otherBlinding.SetUint64(7)
+	if VerifyOpening(c, otherBlinding, values...) {
+		t.Fatalf("opening with wrong blinding accepted")
+	}
+	tampered := append([]fr.Element{}, values...)
+	tampered[3].SetUint64(100)
+	if VerifyOpening(c, blinding, tampered...) {
+		t.Fatalf("opening with wrong values accepted")
+	}
+	if VerifyOpening(c, blinding, values[:8]...) {
+		t.Fatalf("opening with truncated values accepted")
+	}
+
+	if _, err := Commit(blinding); err == nil {
+		t.Fatalf("expected error committing to no values")
+	}
+	if _, err := Commit(blinding, make([]fr.Element, MaxMultiHashInputs)...); err == nil {
+		t.Fatalf("expected error committing to too many values")
+	}
+}
+
+// With the v1 tree, Commit(b, v1..v48) would equal Commit(h1, h2..h7), where h1..h7 are the
+// level-1 chunk digests of [b, v1..v48].
+func TestCommitBindsLength(t *testing.T) {
+	var blinding fr.Element
+	blinding.SetUint64(0xb1)
+	values := make([]fr.Element, 48)
+	for i := range values {
+		values[i].SetUint64(uint64(i + 1))
+	}
+	inputs := append([]fr.Element{blinding}, values...)
+	chunks := make([]fr.Element, 7)
+	for i := range chunks {
+		h, err := Hash(CommitmentDomain, inputs[i*7:(i+1)*7]...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		chunks[i] = h
+	}
+	v1Long, err := MultiHash(CommitmentDomain, inputs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v1Short, err := MultiHash(CommitmentDomain, chunks...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !v1Long.Equal(&v1Short) {
+		t.Fatal("expected the v1 tree to collide on these tuples")
+	}
+
+	long, err := Commit(blinding, values...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	short, err := Commit(chunks[0], chunks[1:]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if long.Equal(&short) {
+		t.Fatal("commitments to tuples of different lengths collide")
+	}
+	if VerifyOpening(long, chunks[0], chunks[1:]...) {
+		t.Fatal("commitment opened to a tuple of a different length")
+	}
+}
+
+type commitmentCircuit struct {
+	Commitment frontend.Variable `gnark:",public"`
+	Blinding   frontend.Variable
+	Values     [3]frontend.Variable
+}
+
+func (c *commitmentCircuit) Define(api frontend.API) error {
+	return gposeidon.AssertCommitmentOpens(api, c.Commitment, c.Blinding, c.Values[:]...)
+}
+
+func TestCommitmentCircuit(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	var blinding, a, b, c fr.Element
+	blinding.SetUint64(0xdeadbeef)
+	a.SetUint64(1)
+	b.SetUint64(2)
+	c.SetUint64(3)
+	commitment, err := Commit(blinding, a, b, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.ProverSucceeded(
+		&commitmentCircuit{},
+		&commitmentCircuit{
+			Commitment: commitment.Element(),
+			Blinding:   blinding,
+			Values:     [3]frontend.Variable{a, b, c},
+		},
+		test.WithCurves(ecc.BLS12_377),
+		test.WithBackends(backend.GROTH16),
+	)
+
+	assert.ProverFailed(
+		&commitmentCircuit{},
+		&commitmentCircuit{
+			Commitment: commitment.Element(),
+			Blinding:   blinding,
+			Values:     [3]frontend.Variable{a, b, 4},
+		},
+		test.WithCurves(ecc.BLS12_377),
+		test.WithBackends(backend.GROTH16),
+	)
+}
@@ -0,0 +1,254 @@
+package poseidon377
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/frontend/cs/scs"
+	"github.com/consensys/gnark/std/math/emulated"
+
+	emposeidon "github.com/vocdoni/poseidon377/gnark/emulated/poseidon377"
+	gposeidon "github.com/vocdoni/poseidon377/gnark/poseidon377"
+)
+
+const (
+	countKindHash = iota
+	countKindMultiHash
+	countKindHashN
+)
+
+// countNativeCircuit hashes Inputs with the native gadget; kind selects Hash, MultiHash or HashN
+// with the given number of outputs.
+type countNativeCircuit struct {
+	Domain frontend.Variable
+	Inputs []frontend.Variable
+
+	kind    int
+	outputs int
+}
+
+func (c *countNativeCircuit) Define(api frontend.API) error {
+	var (
+		out frontend.Variable
+		err error
+	)
+	switch c.kind {
+	case countKindHash:
+		out, err = gposeidon.Hash(api, c.Domain, c.Inputs...)
+	case countKindHashN:
+		outs, err := gposeidon.HashN(api, c.Domain, c.outputs, c.Inputs...)
+		if err != nil {
+			return err
+		}
+		for i := range outs {
+			api.AssertIsEqual(outs[i], outs[i])
+		}
+		return nil
+	default:
+		out, err = gposeidon.MultiHash(api, c.Domain, c.Inputs...)
+	}
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(out, out)
+	return nil
+}
+
+// countEmulatedCircuit is countNativeCircuit for the emulated gadget.
+type countEmulatedCircuit struct {
+	Domain emulated.Element[emposeidon.FrParams]
+	Inputs []emulated.Element[emposeidon.FrParams]
+
+	kind    int
+	outputs int
+}
+
+func (c *countEmulatedCircuit) Define(api frontend.API) error {
+	field, err := emulated.NewField[emposeidon.FrParams](api)
+	if err != nil {
+		return err
+	}
+	var out emulated.Element[emposeidon.FrParams]
+	switch c.kind {
+	case countKindHash:
+		out, err = emposeidon.Hash(api, c.Domain, c.Inputs...)
+	case countKindHashN:
+		outs, err := emposeidon.HashN(api, c.Domain, c.outputs, c.Inputs...)
+		if err != nil {
+			return err
+		}
+		for i := range outs {
+			field.AssertIsEqual(&outs[i], &outs[i])
+		}
+		return nil
+	default:
+		out, err = emposeidon.MultiHash(api, c.Domain, c.Inputs...)
+	}
+	if err != nil {
+		return err
+	}
+	field.AssertIsEqual(&out, &out)
+	return nil
+}
+
+var countBuilders = []struct {
+	name    string
+	builder frontend.NewBuilder
+}{
+	{"r1cs", r1cs.NewBuilder[constraint.U64]},
+	{"scs", scs.NewBuilder[constraint.U64]},
+}
+
+var countFields = map[string]*big.Int{
+	"native":         ecc.BLS12_377.ScalarField(),
+	"emulated-bw6":   ecc.BW6_761.ScalarField(),
+	"emulated-bn254": ecc.BN254.ScalarField(),
+}
+
+// expectedConstraints pins the constraint count of every gadget configuration, keyed by
+// builder/gadget/function. Update it deliberately when a change to the gadgets is meant to alter
+// the cost; any other difference is a regression.
+var expectedConstraints = map[string]int{
+	"r1cs/native/hash/rate=1":                    236,
+	"r1cs/native/hash/rate=2":                    276,
+	"r1cs/native/hash/rate=3":                    316,
+	"r1cs/native/hash/rate=4":                    356,
+	"r1cs/native/hash/rate=5":                    396,
+	"r1cs/native/hash/rate=6":                    436,
+	"r1cs/native/hash/rate=7":                    476,
+	"r1cs/emulated-bw6/hash/rate=1":              34805,
+	"r1cs/emulated-bw6/hash/rate=2":              47444,
+	"r1cs/emulated-bw6/hash/rate=3":              62565,
+	"r1cs/emulated-bw6/hash/rate=4":              77313,
+	"r1cs/emulated-bw6/hash/rate=5":              93342,
+	"r1cs/emulated-bw6/hash/rate=6":              110901,
+	"r1cs/emulated-bw6/hash/rate=7":              130037,
+	"r1cs/emulated-bn254/hash/rate=1":            34805,
+	"r1cs/emulated-bn254/hash/rate=2":            47444,
+	"r1cs/emulated-bn254/hash/rate=3":            62565,
+	"r1cs/emulated-bn254/hash/rate=4":            77313,
+	"r1cs/emulated-bn254/hash/rate=5":            93342,
+	"r1cs/emulated-bn254/hash/rate=6":            110901,
+	"r1cs/emulated-bn254/hash/rate=7":            130037,
+	"r1cs/native/multihash/inputs=8":             986,
+	"r1cs/native/multihash/inputs=16":            1541,
+	"r1cs/native/multihash/inputs=49":            3801,
+	"r1cs/native/multihash/inputs=50":            4546,
+	"r1cs/native/multihash/inputs=64":            5576,
+	"r1cs/native/multihash/inputs=256":           20541,
+	"r1cs/emulated-bw6/multihash/inputs=8":       200189,
+	"r1cs/emulated-bw6/multihash/inputs=16":      333042,
+	"r1cs/emulated-bn254/multihash/inputs=8":     200189,
+	"r1cs/emulated-bn254/multihash/inputs=16":    333042,
+	"r1cs/native/hashn/rate=7/outputs=1":         476,
+	"r1cs/native/hashn/rate=7/outputs=7":         482,
+	"r1cs/emulated-bw6/hashn/rate=7/outputs=1":   130037,
+	"r1cs/emulated-bw6/hashn/rate=7/outputs=7":   130439,
+	"r1cs/emulated-bn254/hashn/rate=7/outputs=1": 130037,
+	"r1cs/emulated-bn254/hashn/rate=7/outputs=7": 130439,
+	"scs/native/hash/rate=1":                     362,
+	"scs/native/hash/rate=2":                     507,
+	"scs/native/hash/rate=3":                     670,
+	"scs/native/hash/rate=4":                     851,
+	"scs/native/hash/rate=5":                     1050,
+	"scs/native/hash/rate=6":                     1267,
+	"scs/native/hash/rate=7":                     1502,
+	"scs/emulated-bw6/hash/rate=1":               140653,
+	"scs/emulated-bw6/hash/rate=2":               192027,
+	"scs/emulated-bw6/hash/rate=3":               247837,
+	"scs/emulated-bw6/hash/rate=4":               305956,
+	"scs/emulated-bw6/hash/rate=5":               369581,
+	"scs/emulated-bw6/hash/rate=6":               439294,
+	"scs/emulated-bw6/hash/rate=7":               515306,
+	"scs/emulated-bn254/hash/rate=1":             140653,
+	"scs/emulated-bn254/hash/rate=2":             192027,
+	"scs/emulated-bn254/hash/rate=3":             247837,
+	"scs/emulated-bn254/hash/rate=4":             305956,
+	"scs/emulated-bn254/hash/rate=5":             369581,
+	"scs/emulated-bn254/hash/rate=6":             439294,
+	"scs/emulated-bn254/hash/rate=7":             515306,
+	"scs/native/multihash/inputs=8":              2369,
+	"scs/native/multihash/inputs=16":             4178,
+	"scs/native/multihash/inputs=49":             12009,
+	"scs/native/multihash/inputs=50":             13237,
+	"scs/native/multihash/inputs=64":             16547,
+	"scs/native/multihash/inputs=256":            64164,
+	"scs/emulated-bw6/multihash/inputs=8":        792191,
+	"scs/emulated-bw6/multihash/inputs=16":       1280373,
+	"scs/emulated-bn254/multihash/inputs=8":      792191,
+	"scs/emulated-bn254/multihash/inputs=16":     1280373,
+	"scs/native/hashn/rate=7/outputs=1":          1502,
+	"scs/native/hashn/rate=7/outputs=7":          1508,
+	"scs/emulated-bw6/hashn/rate=7/outputs=1":    515306,
+	"scs/emulated-bw6/hashn/rate=7/outputs=7":    516938,
+	"scs/emulated-bn254/hashn/rate=7/outputs=1":  515306,
+	"scs/emulated-bn254/hashn/rate=7/outputs=7":  516938,
+}
+
+func countCircuit(gadget string, kind, n, outputs int) frontend.Circuit {
+	if gadget == "native" {
+		return &countNativeCircuit{Inputs: make([]frontend.Variable, n), kind: kind, outputs: outputs}
+	}
+	return &countEmulatedCircuit{Inputs: make([]emulated.Element[emposeidon.FrParams], n), kind: kind, outputs: outputs}
+}
+
+func TestConstraintCounts(t *testing.T) {
+	type countCase struct {
+		gadget  string
+		kind    int
+		n       int
+		outputs int
+	}
+	var cases []countCase
+	for _, gadget := range []string{"native", "emulated-bw6", "emulated-bn254"} {
+		for rate := 1; rate <= maxRate; rate++ {
+			cases = append(cases, countCase{gadget, countKindHash, rate, 1})
+		}
+	}
+	for _, n := range []int{8, 16, 49, 50, 64, 256} {
+		cases = append(cases, countCase{"native", countKindMultiHash, n, 1})
+	}
+	// Emulated MultiHash costs roughly 20k constraints per input; larger trees are covered by
+	// TestEmulatedMultiHashBW6.
+	for _, gadget := range []string{"emulated-bw6", "emulated-bn254"} {
+		cases = append(cases, countCase{gadget, countKindMultiHash, 8, 1}, countCase{gadget, countKindMultiHash, 16, 1})
+	}
+	// HashN only reduces the limbs it returns, so one output costs as much as Hash.
+	for _, gadget := range []string{"native", "emulated-bw6", "emulated-bn254"} {
+		cases = append(cases, countCase{gadget, countKindHashN, maxRate, 1}, countCase{gadget, countKindHashN, maxRate, maxRate})
+	}
+
+	for _, b := range countBuilders {
+		for _, tc := range cases {
+			name := fmt.Sprintf("%s/%s/hash/rate=%d", b.name, tc.gadget, tc.n)
+			switch tc.kind {
+			case countKindMultiHash:
+				name = fmt.Sprintf("%s/%s/multihash/inputs=%d", b.name, tc.gadget, tc.n)
+			case countKindHashN:
+				name = fmt.Sprintf("%s/%s/hashn/rate=%d/outputs=%d", b.name, tc.gadget, tc.n, tc.outputs)
+			}
+			t.Run(name, func(t *testing.T) {
+				if tc.gadget != "native" && testing.Short() {
+					t.Skip("emulated gadget compiles are slow")
+				}
+				ccs, err := frontend.Compile(countFields[tc.gadget], b.builder, countCircuit(tc.gadget, tc.kind, tc.n, tc.outputs))
+				if err != nil {
+					t.Fatal(err)
+				}
+				got := ccs.GetNbConstraints()
+				want, ok := expectedConstraints[name]
+				if !ok {
+					t.Fatalf("no expected count for %s (got %d)", name, got)
+				}
+				if got != want {
+					t.Fatalf("%s: expected %d constraints, got %d", name, want, got)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package poseidon377
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+// DigestSize is the length of the binary encodings of a Digest.
+const DigestSize = fr.Bytes
+
+// Digest is a hash output with canonical encodings:
+//   - binary: 32 bytes big-endian (MarshalBinary, Bytes),
+//   - little-endian: 32 bytes as in Penumbra's Fq::to_bytes (LEBytes, DigestFromLEBytes),
+//   - text/JSON: "0x" followed by 64 hex digits, big-endian; decimal is accepted on decode.
+//     DecimalDigest encodes the same value in decimal.
+//
+// Decoding is strict: wrong lengths, values >= r, signs and leading zeros are rejected, so each
+// digest has exactly one accepted encoding per format.
+type Digest fr.Element
+
+// HashDigest is Hash returning a Digest.
+func HashDigest(domain fr.Element, inputs ...fr.Element) (Digest, error) {
+	out, err := Hash(domain, inputs...)
+	return Digest(out), err
+}
+
+// MultiHashDigest is MultiHash returning a Digest.
+func MultiHashDigest(domain fr.Element, inputs ...fr.Element) (Digest, error) {
+	out, err := MultiHash(domain, inputs...)
+	return Digest(out), err
+}
+
+// MultiHashV2Digest is MultiHashV2 returning a Digest.
+func MultiHashV2Digest(domain fr.Element, inputs ...fr.Element) (Digest, error) {
+	out, err := MultiHashV2(domain, inputs...)
+	return Digest(out), err
+}
+
+// Element returns the digest as a field element.
+func (d Digest) Element() fr.Element {
+	return fr.Element(d)
+}
+
+// Equal reports whether both digests are the same field element.
+func (d *Digest) Equal(other *Digest) bool {
+	a, b := fr.Element(*d), fr.Element(*other)
+	return a.Equal(&b)
+}
+
+// String returns the decimal representation. Unlike fr.Element.String, values close to r are
+// not printed as negative numbers.
+func (d Digest) String() string {
+	e := fr.Element(d)
+	return e.BigInt(new(big.Int)).String()
+}
+
+// Bytes returns the canonical big-endian encoding.
+func (d Digest) Bytes() [DigestSize]byte {
+	var out [DigestSize]byte
+	fr.BigEndian.PutElement(&out, fr.Element(d))
+	return out
+}
+
+// LEBytes returns the canonical little-endian encoding used by Penumbra.
+func (d Digest) LEBytes() [DigestSize]byte {
+	var out [DigestSize]byte
+	fr.LittleEndian.PutElement(&out, fr.Element(d))
+	return out
+}
+
+// DigestFromBytes decodes a canonical big-endian encoding.
+func DigestFromBytes(data []byte) (Digest, error) {
+	if len(data) != DigestSize {
+		return Digest{}, fmt.Errorf("poseidon377: digest must be %d bytes, got %d", DigestSize, len(data))
+	}
+	e, err := fr.BigEndian.Element((*[DigestSize]byte)(data))
+	if err != nil {
+		return Digest{}, fmt.Errorf("poseidon377: non-canonical digest: %w", err)
+	}
+	return Digest(e), nil
+}
+
+// DigestFromLEBytes decodes a canonical little-endian encoding.
+func DigestFromLEBytes(data []byte) (Digest, error) {
+	if len(data) != DigestSize {
+		return Digest{}, fmt.Errorf("poseidon377: digest must be %d bytes, got %d", DigestSize, len(data))
+	}
+	e, err := fr.LittleEndian.Element((*[DigestSize]byte)(data))
+	if err != nil {
+		return Digest{}, fmt.Errorf("poseidon377: non-canonical digest: %w", err)
+	}
+	return Digest(e), nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler (big-endian).
+func (d Digest) MarshalBinary() ([]byte, error) {
+	b := d.Bytes()
+	return b[:], nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler (big-endian, strict).
+func (d *Digest) UnmarshalBinary(data []byte) error {
+	out, err := DigestFromBytes(data)
+	if err != nil {
+		return err
+	}
+	*d = out
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, also used for JSON.
+func (d Digest) MarshalText() ([]byte, error) {
+	b := d.Bytes()
+	return []byte("0x" + hex.EncodeToString(b[:])), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, also used for JSON. It accepts "0x" followed
+// by exactly 64 hex digits, or a decimal number without sign or leading zeros, below r.
+func (d *Digest) UnmarshalText(text []byte) error {
+	s := string(text)
+	if hexDigits, ok := strings.CutPrefix(s, "0x"); ok {
+		if len(hexDigits) != 2*DigestSize {
+			return fmt.Errorf("poseidon377: hex digest must have %d digits", 2*DigestSize)
+		}
+		raw, err := hex.DecodeString(hexDigits)
+		if err != nil {
+			return fmt.Errorf("poseidon377: invalid hex digest: %w", err)
+		}
+		return d.UnmarshalBinary(raw)
+	}
+
+	if s == "" || (len(s) > 1 && s[0] == '0') {
+		return fmt.Errorf("poseidon377: non-canonical decimal digest %q", s)
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return fmt.Errorf("poseidon377: invalid decimal digest %q", s)
+		}
+	}
+	bi, _ := new(big.Int).SetString(s, 10)
+	if bi.Cmp(fr.Modulus()) >= 0 {
+		return fmt.Errorf("poseidon377: decimal digest not below the field modulus")
+	}
+	var e fr.Element
+	e.SetBigInt(bi)
+	*d = Digest(e)
+	return nil
+}
+
+// DecimalDigest is a Digest whose text and JSON encoding is the decimal string, for consumers
+// that expect field elements in decimal (circom, snarkjs). Decoding accepts the same canonical
+// hex and decimal forms as Digest.
+type DecimalDigest Digest
+
+// String returns the decimal representation.
+func (d DecimalDigest) String() string {
+	return Digest(d).String()
+}
+
+// MarshalText implements encoding.TextMarshaler, also used for JSON.
+func (d DecimalDigest) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, also used for JSON.
+func (d *DecimalDigest) UnmarshalText(text []byte) error {
+	return (*Digest)(d).UnmarshalText(text)
+}
@@ -0,0 +1,195 @@
+package poseidon377
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+func TestDigestEncodings(t *testing.T) {
+	domain := DomainFromLEBytes([]byte("Penumbra_TestVec"))
+	in := mustElement(t, "7553885614632219548127688026174585776320152166623257619763178041781456016062")
+	d, err := HashDigest(domain, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := mustElement(t, "2337838243217876174544784248400816541933405738836087430664765452605435675740")
+	if e := d.Element(); !e.Equal(&expected) {
+		t.Fatalf("digest mismatch\nexpected %s\ngot      %s", expected.String(), e.String())
+	}
+	if d.String() != expected.String() {
+		t.Fatalf("decimal string mismatch: %s", d.String())
+	}
+
+	bin, err := d.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	be := expected.Bytes()
+	if !bytes.Equal(bin, be[:]) {
+		t.Fatalf("binary encoding is not big-endian")
+	}
+	var fromBin Digest
+	if err := fromBin.UnmarshalBinary(bin); err != nil || !fromBin.Equal(&d) {
+		t.Fatalf("binary round trip failed: %v", err)
+	}
+
+	le := d.LEBytes()
+	for i := range le {
+		if le[i] != be[DigestSize-1-i] {
+			t.Fatalf("little-endian encoding is not the reverse of big-endian")
+		}
+	}
+	// Little-endian bytes decode back through DomainFromLEBytes (from_le_bytes_mod_order).
+	if back := DomainFromLEBytes(le[:]); !back.Equal(&expected) {
+		t.Fatalf("little-endian encoding not compatible with from_le_bytes")
+	}
+	fromLE, err := DigestFromLEBytes(le[:])
+	if err != nil || !fromLE.Equal(&d) {
+		t.Fatalf("little-endian round trip failed: %v", err)
+	}
+
+	js, err := json.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fromJSON Digest
+	if err := json.Unmarshal(js, &fromJSON); err != nil || !fromJSON.Equal(&d) {
+		t.Fatalf("json round trip failed (%s): %v", js, err)
+	}
+	var fromDecimal Digest
+	if err := json.Unmarshal([]byte(`"`+expected.String()+`"`), &fromDecimal); err != nil || !fromDecimal.Equal(&d) {
+		t.Fatalf("decimal json decode failed: %v", err)
+	}
+}
+
+func TestDigestStrictDecoding(t *testing.T) {
+	modulus := fr.Modulus()
+	var pBytes [DigestSize]byte
+	modulus.FillBytes(pBytes[:])
+
+	var d Digest
+	if err := d.UnmarshalBinary(pBytes[:]); err == nil {
+		t.Fatalf("accepted p as big-endian digest")
+	}
+	if err := d.UnmarshalBinary(pBytes[1:]); err == nil {
+		t.Fatalf("accepted short encoding")
+	}
+	var pLE [DigestSize]byte
+	for i := range pBytes {
+		pLE[i] = pBytes[DigestSize-1-i]
+	}
+	if _, err := DigestFromLEBytes(pLE[:]); err == nil {
+		t.Fatalf("accepted p as little-endian digest")
+	}
+
+	invalidText := []string{
+		"",
+		"01",
+		"-1",
+		"+1",
+		"1_000",
+		"0x01",
+		"0X" + "00000000000000000000000000000000000000000000000000000000000000001",
+		"0x" + modulus.Text(16),
+		modulus.String(),
+		"0xzz00000000000000000000000000000000000000000000000000000000000000",
+	}
+	for _, s := range invalidText {
+		if err := d.UnmarshalText([]byte(s)); err == nil {
+			t.Fatalf("accepted non-canonical text %q", s)
+		}
+	}
+
+	for _, s := range []string{"0", "1", "0x0000000000000000000000000000000000000000000000000000000000000001"} {
+		if err := d.UnmarshalText([]byte(s)); err != nil {
+			t.Fatalf("rejected canonical text %q: %v", s, err)
+		}
+	}
+}
+
+func TestMultiHashDigest(t *testing.T) {
+	domain := DomainFromLEBytes([]byte("Penumbra_TestVec"))
+	inputs := make([]fr.Element, 10)
+	for i := range inputs {
+		inputs[i].SetUint64(uint64(i + 1))
+	}
+	d, err := MultiHashDigest(domain, inputs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := MultiHash(domain, inputs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := d.Element(); !e.Equal(&expected) {
+		t.Fatalf("multihash digest mismatch")
+	}
+	if _, err := MultiHashDigest(domain); err == nil {
+		t.Fatalf("expected error for empty input")
+	}
+}
+
+func TestDecimalDigest(t *testing.T) {
+	var pMinus1 fr.Element
+	pMinus1.SetOne()
+	pMinus1.Neg(&pMinus1)
+	expected := new(big.Int).Sub(fr.Modulus(), big.NewInt(1)).String()
+
+	js, err := json.Marshal(DecimalDigest(pMinus1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(js) != `"`+expected+`"` {
+		t.Fatalf("decimal json mismatch: %s", js)
+	}
+	var back DecimalDigest
+	if err := json.Unmarshal(js, &back); err != nil || Digest(back).Element() != pMinus1 {
+		t.Fatalf("decimal json round trip failed: %v", err)
+	}
+	hexText, err := Digest(pMinus1).MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := back.UnmarshalText(hexText); err != nil || Digest(back).Element() != pMinus1 {
+		t.Fatalf("hex decode into DecimalDigest failed: %v", err)
+	}
+	if err := back.UnmarshalText([]byte(fr.Modulus().String())); err == nil {
+		t.Fatalf("accepted r as decimal digest")
+	}
+}
+
+func TestMultiHashV2Digest(t *testing.T) {
+	domain := DomainFromLEBytes([]byte("Penumbra_TestVec"))
+	inputs := make([]fr.Element, 10)
+	for i := range inputs {
+		inputs[i].SetUint64(uint64(i + 1))
+	}
+	d, err := MultiHashV2Digest(domain, inputs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := MultiHashV2(domain, inputs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := d.Element(); !e.Equal(&expected) {
+		t.Fatalf("multihash v2 digest mismatch")
+	}
+	if _, err := MultiHashV2Digest(domain); err == nil {
+		t.Fatalf("expected error for empty input")
+	}
+}
+
+func TestDigestStringNearModulus(t *testing.T) {
+	var pMinus1 fr.Element
+	pMinus1.SetOne()
+	pMinus1.Neg(&pMinus1)
+	expected := new(big.Int).Sub(fr.Modulus(), big.NewInt(1)).String()
+	if got := Digest(pMinus1).String(); got != expected {
+		t.Fatalf("p-1 printed as %s", got)
+	}
+}
@@ -0,0 +1,144 @@
+package poseidon377
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+
+	"github.com/vocdoni/poseidon377/internal/domain"
+)
+
+// Domain is a named domain separator: Bytes interpreted as a little-endian integer is Element.
+type Domain struct {
+	Name    string
+	Bytes   []byte
+	Element fr.Element
+}
+
+// DomainRegistry records named domains and rejects duplicates, so that two protocols cannot
+// silently share a separator.
+type DomainRegistry struct {
+	mu        sync.Mutex
+	domains   []Domain
+	byName    map[string]int
+	byElement map[fr.Element]int
+}
+
+// Domains is the process-wide registry. The built-in commitment, encryption and MultiHashV2 length
+// domains are registered at init; applications should register theirs from package-level vars or init.
+var Domains = newBuiltinRegistry()
+
+func newBuiltinRegistry() *DomainRegistry {
+	r := NewDomainRegistry()
+	r.MustRegister(domain.CommitmentLabel, []byte(domain.CommitmentLabel))
+	r.MustRegister(domain.EncryptionLabel, []byte(domain.EncryptionLabel))
+	r.MustRegister(domain.LengthLabel, []byte(domain.LengthLabel))
+	return r
+}
+
+// NewDomainRegistry returns an empty registry.
+func NewDomainRegistry() *DomainRegistry {
+	return &DomainRegistry{
+		byName:    make(map[string]int),
+		byElement: make(map[fr.Element]int),
+	}
+}
+
+// Register records a domain built from data as with DomainFromLEBytes. It fails if the name is
+// already taken, if data does not fit in the field without reduction (more than 32 bytes or a
+// value >= r), or if the resulting element collides with a registered domain (e.g. the same bytes
+// with trailing zeros).
+func (r *DomainRegistry) Register(name string, data []byte) (Domain, error) {
+	if name == "" {
+		return Domain{}, fmt.Errorf("poseidon377: empty domain name")
+	}
+	if len(data) > fr.Bytes {
+		return Domain{}, fmt.Errorf("poseidon377: domain %q is %d bytes, max %d", name, len(data), fr.Bytes)
+	}
+	reversed := make([]byte, len(data))
+	for i := range data {
+		reversed[len(data)-1-i] = data[i]
+	}
+	if new(big.Int).SetBytes(reversed).Cmp(fr.Modulus()) >= 0 {
+		return Domain{}, fmt.Errorf("poseidon377: domain %q is not below the field modulus", name)
+	}
+
+	d := Domain{
+		Name:    name,
+		Bytes:   append([]byte(nil), data...),
+		Element: domain.FromLEBytes(data),
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.byName[name]; ok {
+		return Domain{}, fmt.Errorf("poseidon377: domain %q already registered", name)
+	}
+	if i, ok := r.byElement[d.Element]; ok {
+		return Domain{}, fmt.Errorf("poseidon377: domain %q collides with %q", name, r.domains[i].Name)
+	}
+	r.byName[name] = len(r.domains)
+	r.byElement[d.Element] = len(r.domains)
+	r.domains = append(r.domains, d)
+	return d, nil
+}
+
+// MustRegister is like Register but panics on error. It is meant for package initialization.
+func (r *DomainRegistry) MustRegister(name string, data []byte) Domain {
+	d, err := r.Register(name, data)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// Lookup returns the domain registered under name.
+func (r *DomainRegistry) Lookup(name string) (Domain, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	i, ok := r.byName[name]
+	if !ok {
+		return Domain{}, false
+	}
+	return r.domains[i], true
+}
+
+// List returns the registered domains in registration order.
+func (r *DomainRegistry) List() []Domain {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Domain(nil), r.domains...)
+}
+
+// MarshalJSON exports the registry for audit as a list of {name, bytes (hex), element (decimal)}.
+func (r *DomainRegistry) MarshalJSON() ([]byte, error) {
+	type entry struct {
+		Name    string `json:"name"`
+		Bytes   string `json:"bytes"`
+		Element string `json:"element"`
+	}
+	domains := r.List()
+	out := make([]entry, len(domains))
+	for i, d := range domains {
+		out[i] = entry{
+			Name:    d.Name,
+			Bytes:   hex.EncodeToString(d.Bytes),
+			Element: d.Element.BigInt(new(big.Int)).String(),
+		}
+	}
+	return json.Marshal(out)
+}
+
+// RegisterDomain registers a domain in the process-wide registry.
+func RegisterDomain(name string, data []byte) (Domain, error) {
+	return Domains.Register(name, data)
+}
+
+// MustRegisterDomain registers a domain in the process-wide registry and panics on error.
+func MustRegisterDomain(name string, data []byte) Domain {
+	return Domains.MustRegister(name, data)
+}
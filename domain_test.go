@@ -0,0 +1,97 @@
+package poseidon377
+
+import (
+	"encoding/json"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+func TestDomainRegistry(t *testing.T) {
+	r := NewDomainRegistry()
+
+	d, err := r.Register("testvec", []byte("Penumbra_TestVec"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := DomainFromLEBytes([]byte("Penumbra_TestVec"))
+	if !d.Element.Equal(&expected) {
+		t.Fatalf("domain element mismatch\nexpected %s\ngot      %s", expected.String(), d.Element.String())
+	}
+	if got, ok := r.Lookup("testvec"); !ok || !got.Element.Equal(&expected) {
+		t.Fatalf("lookup failed")
+	}
+
+	if _, err := r.Register("testvec", []byte("other")); err == nil {
+		t.Fatalf("expected duplicate name error")
+	}
+	// Trailing zero bytes do not change the little-endian value.
+	if _, err := r.Register("testvec-padded", []byte("Penumbra_TestVec\x00\x00")); err == nil {
+		t.Fatalf("expected collision error")
+	}
+	if _, err := r.Register("too-long", make([]byte, 33)); err == nil {
+		t.Fatalf("expected length error")
+	}
+	// 32 ASCII bytes exceed r (the top byte 0x61 > 0x12).
+	if _, err := r.Register("above-modulus", []byte("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")); err == nil {
+		t.Fatalf("expected modulus error")
+	}
+	if _, err := r.Register("", []byte("x")); err == nil {
+		t.Fatalf("expected empty name error")
+	}
+	if n := len(r.List()); n != 1 {
+		t.Fatalf("expected 1 registered domain, got %d", n)
+	}
+}
+
+func TestDomainRegistryJSON(t *testing.T) {
+	r := NewDomainRegistry()
+	r.MustRegister("abc", []byte("abc"))
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `[{"name":"abc","bytes":"616263","element":"6513249"}]`
+	if string(data) != expected {
+		t.Fatalf("json mismatch\nexpected %s\ngot      %s", expected, data)
+	}
+}
+
+// fr.Element.String prints values close to r as small negatives; the export must not.
+func TestDomainRegistryJSONNearModulus(t *testing.T) {
+	rMinus1 := new(big.Int).Sub(fr.Modulus(), big.NewInt(1))
+	le := rMinus1.FillBytes(make([]byte, 32))
+	slices.Reverse(le)
+	r := NewDomainRegistry()
+	r.MustRegister("r-1", le)
+
+	var got []struct {
+		Element string `json:"element"`
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Element != rMinus1.String() {
+		t.Fatalf("r-1 exported as %s", data)
+	}
+}
+
+func TestBuiltinDomains(t *testing.T) {
+	d, ok := Domains.Lookup("poseidon377.commitment")
+	if !ok {
+		t.Fatalf("commitment domain not registered")
+	}
+	if !d.Element.Equal(&CommitmentDomain) {
+		t.Fatalf("commitment domain mismatch")
+	}
+	if _, ok := Domains.Lookup("poseidon377.encryption"); !ok {
+		t.Fatalf("encryption domain not registered")
+	}
+}
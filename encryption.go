@@ -0,0 +1,101 @@
+package poseidon377
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+
+	"github.com/vocdoni/poseidon377/internal/domain"
+)
+
+// EncryptionRate is the number of plaintext elements absorbed per permutation call.
+const EncryptionRate = 3
+
+// EncryptionKey is a shared secret made of two field elements (e.g. derived from an ECDH point).
+type EncryptionKey [2]fr.Element
+
+// Encrypt implements the duplex sponge encryption from the Poseidon paper over the rate-3
+// permutation. The initial state is [EncryptionDomain, k0, k1, nonce + len(plaintext)*2^128];
+// each block is permuted, the plaintext is added to the rate limbs and the result is both
+// emitted as ciphertext and kept as the new rate. A final permutation yields the tag (state[1]).
+// The plaintext is zero-padded to a multiple of EncryptionRate. The nonce must be below 2^128
+// and never reused with the same key.
+func Encrypt(key EncryptionKey, nonce fr.Element, plaintext []fr.Element) ([]fr.Element, fr.Element, error) {
+	state, err := encryptionState(key, nonce, len(plaintext))
+	if err != nil {
+		return nil, fr.Element{}, err
+	}
+	perm, err := newPermutation(EncryptionRate)
+	if err != nil {
+		return nil, fr.Element{}, err
+	}
+
+	padded := make([]fr.Element, paddedLen(len(plaintext)))
+	copy(padded, plaintext)
+	ciphertext := make([]fr.Element, len(padded))
+	for i := 0; i < len(padded); i += EncryptionRate {
+		perm.permute(state)
+		for j := 0; j < EncryptionRate; j++ {
+			state[j+1].Add(&state[j+1], &padded[i+j])
+			ciphertext[i+j] = state[j+1]
+		}
+	}
+	perm.permute(state)
+	return ciphertext, state[1], nil
+}
+
+// Decrypt reverses Encrypt for a plaintext of the given length, checking the tag and padding.
+func Decrypt(key EncryptionKey, nonce fr.Element, ciphertext []fr.Element, tag fr.Element, length int) ([]fr.Element, error) {
+	if length < 0 || len(ciphertext) != paddedLen(length) {
+		return nil, fmt.Errorf("poseidon377: ciphertext length %d does not match plaintext length %d", len(ciphertext), length)
+	}
+	state, err := encryptionState(key, nonce, length)
+	if err != nil {
+		return nil, err
+	}
+	perm, err := newPermutation(EncryptionRate)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext := make([]fr.Element, len(ciphertext))
+	for i := 0; i < len(ciphertext); i += EncryptionRate {
+		perm.permute(state)
+		for j := 0; j < EncryptionRate; j++ {
+			plaintext[i+j].Sub(&ciphertext[i+j], &state[j+1])
+			state[j+1] = ciphertext[i+j]
+		}
+	}
+	for i := length; i < len(plaintext); i++ {
+		if !plaintext[i].IsZero() {
+			return nil, fmt.Errorf("poseidon377: invalid padding")
+		}
+	}
+	perm.permute(state)
+	if !state[1].Equal(&tag) {
+		return nil, fmt.Errorf("poseidon377: authentication tag mismatch")
+	}
+	return plaintext[:length], nil
+}
+
+func encryptionState(key EncryptionKey, nonce fr.Element, length int) ([]fr.Element, error) {
+	if nonce.BigInt(new(big.Int)).BitLen() > 128 {
+		return nil, fmt.Errorf("poseidon377: nonce must be below 2^128")
+	}
+	var two128, l fr.Element
+	two128.SetBigInt(new(big.Int).Lsh(big.NewInt(1), 128))
+	l.SetUint64(uint64(length))
+	l.Mul(&l, &two128)
+
+	state := make([]fr.Element, EncryptionRate+1)
+	state[0] = domain.Encryption
+	state[1] = key[0]
+	state[2] = key[1]
+	state[3].Add(&nonce, &l)
+	return state, nil
+}
+
+func paddedLen(n int) int {
+	return (n + EncryptionRate - 1) / EncryptionRate * EncryptionRate
+}
@@ -0,0 +1,150 @@
+package poseidon377
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark/backend"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+
+	gposeidon "github.com/vocdoni/poseidon377/gnark/poseidon377"
+)
+
+func testEncryptionKey() EncryptionKey {
+	var key EncryptionKey
+	key[0].SetUint64(0x1234)
+	key[1].SetUint64(0x5678)
+	return key
+}
+
+func TestEncryptDecryptRoundTrip(t *testing.T) {
+	key := testEncryptionKey()
+	var nonce fr.Element
+	nonce.SetUint64(5)
+
+	for n := 0; n <= 8; n++ {
+		plaintext := make([]fr.Element, n)
+		for i := range plaintext {
+			plaintext[i].SetUint64(uint64(100 + i))
+		}
+		ciphertext, tag, err := Encrypt(key, nonce, plaintext)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ciphertext)%EncryptionRate != 0 || len(ciphertext) < n {
+			t.Fatalf("n=%d: unexpected ciphertext length %d", n, len(ciphertext))
+		}
+		decrypted, err := Decrypt(key, nonce, ciphertext, tag, n)
+		if err != nil {
+			t.Fatalf("n=%d: %v", n, err)
+		}
+		for i := range plaintext {
+			if !decrypted[i].Equal(&plaintext[i]) {
+				t.Fatalf("n=%d: element %d mismatch", n, i)
+			}
+		}
+	}
+}
+
+func TestDecryptRejectsTampering(t *testing.T) {
+	key := testEncryptionKey()
+	var nonce fr.Element
+	nonce.SetUint64(9)
+	plaintext := []fr.Element{fr.NewElement(1), fr.NewElement(2), fr.NewElement(3), fr.NewElement(4)}
+	ciphertext, tag, err := Encrypt(key, nonce, plaintext)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tampered := append([]fr.Element{}, ciphertext...)
+	tampered[1].SetUint64(42)
+	if _, err := Decrypt(key, nonce, tampered, tag, len(plaintext)); err == nil {
+		t.Fatalf("tampered ciphertext accepted")
+	}
+
+	otherKey := key
+	otherKey[1].SetUint64(1)
+	if _, err := Decrypt(otherKey, nonce, ciphertext, tag, len(plaintext)); err == nil {
+		t.Fatalf("wrong key accepted")
+	}
+
+	var otherNonce fr.Element
+	otherNonce.SetUint64(10)
+	if _, err := Decrypt(key, otherNonce, ciphertext, tag, len(plaintext)); err == nil {
+		t.Fatalf("wrong nonce accepted")
+	}
+
+	if _, err := Decrypt(key, nonce, ciphertext, tag, len(plaintext)+1); err == nil {
+		t.Fatalf("wrong length accepted")
+	}
+
+	var bigNonce fr.Element
+	bigNonce.SetString("340282366920938463463374607431768211456") // 2^128
+	if _, _, err := Encrypt(key, bigNonce, plaintext); err == nil {
+		t.Fatalf("expected error for nonce >= 2^128")
+	}
+}
+
+type decryptCircuit struct {
+	Key        [2]frontend.Variable
+	Nonce      frontend.Variable    `gnark:",public"`
+	Ciphertext [6]frontend.Variable `gnark:",public"`
+	Tag        frontend.Variable    `gnark:",public"`
+	Plaintext  [5]frontend.Variable
+}
+
+func (c *decryptCircuit) Define(api frontend.API) error {
+	out, err := gposeidon.Decrypt(api, c.Key, c.Nonce, c.Ciphertext[:], c.Tag, len(c.Plaintext))
+	if err != nil {
+		return err
+	}
+	for i := range out {
+		api.AssertIsEqual(out[i], c.Plaintext[i])
+	}
+	return nil
+}
+
+func TestDecryptCircuitMatchesNative(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	key := testEncryptionKey()
+	var nonce fr.Element
+	nonce.SetUint64(77)
+	plaintext := make([]fr.Element, 5)
+	for i := range plaintext {
+		plaintext[i].SetUint64(uint64(i + 1))
+	}
+	ciphertext, tag, err := Encrypt(key, nonce, plaintext)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	witness := decryptCircuit{
+		Key:   [2]frontend.Variable{key[0], key[1]},
+		Nonce: nonce,
+		Tag:   tag,
+	}
+	for i := range ciphertext {
+		witness.Ciphertext[i] = ciphertext[i]
+	}
+	for i := range plaintext {
+		witness.Plaintext[i] = plaintext[i]
+	}
+	assert.ProverSucceeded(
+		&decryptCircuit{},
+		&witness,
+		test.WithCurves(ecc.BLS12_377),
+		test.WithBackends(backend.GROTH16),
+	)
+
+	bad := witness
+	bad.Tag = 0
+	assert.ProverFailed(
+		&decryptCircuit{},
+		&bad,
+		test.WithCurves(ecc.BLS12_377),
+		test.WithBackends(backend.GROTH16),
+	)
+}
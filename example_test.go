@@ -0,0 +1,344 @@
+package poseidon377_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/backend/plonk"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/frontend/cs/scs"
+	"github.com/consensys/gnark/logger"
+	"github.com/consensys/gnark/std/algebra/native/sw_bls12377"
+	"github.com/consensys/gnark/std/math/emulated"
+	stdgroth16 "github.com/consensys/gnark/std/recursion/groth16"
+	"github.com/consensys/gnark/test/unsafekzg"
+
+	poseidon "github.com/vocdoni/poseidon377"
+	emposeidon "github.com/vocdoni/poseidon377/gnark/emulated/poseidon377"
+	gposeidon "github.com/vocdoni/poseidon377/gnark/poseidon377"
+)
+
+// The examples use unsafe setups (groth16.Setup, unsafekzg.NewSRS); production keys must come
+// from an MPC ceremony.
+
+var exampleDomain = poseidon.DomainFromLEBytes([]byte("example.preimage"))
+
+// preimageCircuit proves knowledge of X such that Hash(exampleDomain, X...) = Digest.
+type preimageCircuit struct {
+	X      [2]frontend.Variable
+	Digest frontend.Variable `gnark:",public"`
+}
+
+func (c *preimageCircuit) Define(api frontend.API) error {
+	out, err := gposeidon.Hash(api, exampleDomain, c.X[0], c.X[1])
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(out, c.Digest)
+	return nil
+}
+
+func preimageAssignment() *preimageCircuit {
+	var a, b fr.Element
+	a.SetUint64(42)
+	b.SetUint64(7)
+	digest, err := poseidon.Hash2(exampleDomain, a, b)
+	if err != nil {
+		panic(err)
+	}
+	return &preimageCircuit{X: [2]frontend.Variable{a, b}, Digest: digest}
+}
+
+const merkleDepth = 4
+
+var merkleDomain = poseidon.DomainFromLEBytes([]byte("example.merkle"))
+
+// merkleCircuit proves that Leaf is in the binary Poseidon tree with the public Root. Right[i] is
+// 1 when the node at level i is the right child.
+type merkleCircuit struct {
+	Leaf     frontend.Variable
+	Siblings [merkleDepth]frontend.Variable
+	Right    [merkleDepth]frontend.Variable
+	Root     frontend.Variable `gnark:",public"`
+}
+
+func (c *merkleCircuit) Define(api frontend.API) error {
+	node := c.Leaf
+	for i := 0; i < merkleDepth; i++ {
+		api.AssertIsBoolean(c.Right[i])
+		left := api.Select(c.Right[i], c.Siblings[i], node)
+		right := api.Select(c.Right[i], node, c.Siblings[i])
+		var err error
+		node, err = gposeidon.Hash(api, merkleDomain, left, right)
+		if err != nil {
+			return err
+		}
+	}
+	api.AssertIsEqual(node, c.Root)
+	return nil
+}
+
+// merkleAssignment builds a tree over 2^merkleDepth leaves and opens the leaf at index.
+func merkleAssignment(index int) *merkleCircuit {
+	level := make([]fr.Element, 1<<merkleDepth)
+	for i := range level {
+		level[i].SetUint64(uint64(1000 + i))
+	}
+	assignment := &merkleCircuit{Leaf: level[index]}
+	for d := 0; d < merkleDepth; d++ {
+		assignment.Siblings[d] = level[index^1]
+		assignment.Right[d] = index & 1
+		next := make([]fr.Element, len(level)/2)
+		for i := range next {
+			h, err := poseidon.Hash2(merkleDomain, level[2*i], level[2*i+1])
+			if err != nil {
+				panic(err)
+			}
+			next[i] = h
+		}
+		level, index = next, index/2
+	}
+	assignment.Root = level[0]
+	return assignment
+}
+
+func proveGroth16(circuit, assignment frontend.Circuit) error {
+	ccs, err := frontend.Compile(ecc.BLS12_377.ScalarField(), r1cs.NewBuilder, circuit)
+	if err != nil {
+		return err
+	}
+	pk, vk, err := groth16.Setup(ccs)
+	if err != nil {
+		return err
+	}
+	w, err := frontend.NewWitness(assignment, ecc.BLS12_377.ScalarField())
+	if err != nil {
+		return err
+	}
+	proof, err := groth16.Prove(ccs, pk, w)
+	if err != nil {
+		return err
+	}
+	public, err := w.Public()
+	if err != nil {
+		return err
+	}
+	return groth16.Verify(proof, vk, public)
+}
+
+func provePlonk(circuit, assignment frontend.Circuit) error {
+	ccs, err := frontend.Compile(ecc.BLS12_377.ScalarField(), scs.NewBuilder, circuit)
+	if err != nil {
+		return err
+	}
+	srs, srsLagrange, err := unsafekzg.NewSRS(ccs)
+	if err != nil {
+		return err
+	}
+	pk, vk, err := plonk.Setup(ccs, srs, srsLagrange)
+	if err != nil {
+		return err
+	}
+	w, err := frontend.NewWitness(assignment, ecc.BLS12_377.ScalarField())
+	if err != nil {
+		return err
+	}
+	proof, err := plonk.Prove(ccs, pk, w)
+	if err != nil {
+		return err
+	}
+	public, err := w.Public()
+	if err != nil {
+		return err
+	}
+	return plonk.Verify(proof, vk, public)
+}
+
+// Proves knowledge of a Poseidon preimage with Groth16 and PLONK on BLS12-377.
+func Example_preimage() {
+	logger.Disable()
+
+	if err := proveGroth16(&preimageCircuit{}, preimageAssignment()); err != nil {
+		panic(err)
+	}
+	fmt.Println("groth16: preimage proof verified")
+
+	if err := provePlonk(&preimageCircuit{}, preimageAssignment()); err != nil {
+		panic(err)
+	}
+	fmt.Println("plonk: preimage proof verified")
+
+	// A wrong digest has no valid witness.
+	bad := preimageAssignment()
+	bad.Digest = 1
+	fmt.Println("groth16: wrong digest rejected:", proveGroth16(&preimageCircuit{}, bad) != nil)
+
+	// Output:
+	// groth16: preimage proof verified
+	// plonk: preimage proof verified
+	// groth16: wrong digest rejected: true
+}
+
+// Proves Merkle membership in a Poseidon tree with Groth16 and PLONK on BLS12-377.
+func Example_merkleMembership() {
+	logger.Disable()
+
+	if err := proveGroth16(&merkleCircuit{}, merkleAssignment(5)); err != nil {
+		panic(err)
+	}
+	fmt.Println("groth16: membership proof verified")
+
+	if err := provePlonk(&merkleCircuit{}, merkleAssignment(10)); err != nil {
+		panic(err)
+	}
+	fmt.Println("plonk: membership proof verified")
+
+	// Output:
+	// groth16: membership proof verified
+	// plonk: membership proof verified
+}
+
+// recursionCircuit verifies a BLS12-377 Groth16 Merkle membership proof inside a BW6-761 circuit
+// and binds its public root to an epoch with the emulated gadget:
+// Binding = Hash(bindingDomain, root, Epoch) over the BLS12-377 scalar field.
+type recursionCircuit struct {
+	Proof        stdgroth16.Proof[sw_bls12377.G1Affine, sw_bls12377.G2Affine]
+	VerifyingKey stdgroth16.VerifyingKey[sw_bls12377.G1Affine, sw_bls12377.G2Affine, sw_bls12377.GT] `gnark:"-"`
+	InnerWitness stdgroth16.Witness[sw_bls12377.ScalarField]
+
+	Epoch   emulated.Element[emposeidon.FrParams] `gnark:",public"`
+	Binding emulated.Element[emposeidon.FrParams] `gnark:",public"`
+}
+
+var bindingDomain = poseidon.DomainFromLEBytes([]byte("example.binding"))
+
+func (c *recursionCircuit) Define(api frontend.API) error {
+	verifier, err := stdgroth16.NewVerifier[sw_bls12377.ScalarField, sw_bls12377.G1Affine, sw_bls12377.G2Affine, sw_bls12377.GT](api)
+	if err != nil {
+		return err
+	}
+	if err := verifier.AssertProof(c.VerifyingKey, c.Proof, c.InnerWitness); err != nil {
+		return err
+	}
+
+	field, err := emulated.NewField[emposeidon.FrParams](api)
+	if err != nil {
+		return err
+	}
+	// In-circuit constants come from field.NewElement; emulated.ValueOf is for assignments only.
+	domain := field.NewElement(bindingDomain)
+	root := c.InnerWitness.Public[0]
+	binding, err := emposeidon.Hash(api, *domain, root, c.Epoch)
+	if err != nil {
+		return err
+	}
+	field.AssertIsEqual(&binding, &c.Binding)
+	return nil
+}
+
+func proveInnerMerkle() (constraint.ConstraintSystem, groth16.VerifyingKey, groth16.Proof, *merkleCircuit, error) {
+	ccs, err := frontend.Compile(ecc.BLS12_377.ScalarField(), r1cs.NewBuilder, &merkleCircuit{})
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	pk, vk, err := groth16.Setup(ccs)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	assignment := merkleAssignment(3)
+	w, err := frontend.NewWitness(assignment, ecc.BLS12_377.ScalarField())
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	// The inner proof must use the hash-to-field of the outer curve so it can be checked in-circuit.
+	proof, err := groth16.Prove(ccs, pk, w, stdgroth16.GetNativeProverOptions(ecc.BW6_761.ScalarField(), ecc.BLS12_377.ScalarField()))
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	return ccs, vk, proof, assignment, nil
+}
+
+// Verifies a BLS12-377 Groth16 proof recursively in a BW6-761 Groth16 proof whose statement also
+// hashes the inner public root with the emulated Poseidon gadget. The outer proof takes minutes,
+// so the example has no Output comment and runs through TestRecursiveBW6 instead.
+func Example_recursiveBW6() {
+	logger.Disable()
+
+	innerCcs, innerVK, innerProof, inner, err := proveInnerMerkle()
+	if err != nil {
+		panic(err)
+	}
+	innerWitness, err := frontend.NewWitness(&merkleCircuit{Root: inner.Root}, ecc.BLS12_377.ScalarField(), frontend.PublicOnly())
+	if err != nil {
+		panic(err)
+	}
+
+	var epoch fr.Element
+	epoch.SetUint64(7)
+	binding, err := poseidon.Hash2(bindingDomain, inner.Root.(fr.Element), epoch)
+	if err != nil {
+		panic(err)
+	}
+
+	circuitVK, err := stdgroth16.ValueOfVerifyingKeyFixed[sw_bls12377.G1Affine, sw_bls12377.G2Affine, sw_bls12377.GT](innerVK)
+	if err != nil {
+		panic(err)
+	}
+	circuitWitness, err := stdgroth16.ValueOfWitness[sw_bls12377.ScalarField](innerWitness)
+	if err != nil {
+		panic(err)
+	}
+	circuitProof, err := stdgroth16.ValueOfProof[sw_bls12377.G1Affine, sw_bls12377.G2Affine](innerProof)
+	if err != nil {
+		panic(err)
+	}
+
+	outerCircuit := &recursionCircuit{
+		InnerWitness: stdgroth16.PlaceholderWitness[sw_bls12377.ScalarField](innerCcs),
+		VerifyingKey: circuitVK,
+	}
+	outerAssignment := &recursionCircuit{
+		Proof:        circuitProof,
+		InnerWitness: circuitWitness,
+		Epoch:        emulated.ValueOf[emposeidon.FrParams](epoch),
+		Binding:      emulated.ValueOf[emposeidon.FrParams](binding),
+	}
+
+	ccs, err := frontend.Compile(ecc.BW6_761.ScalarField(), r1cs.NewBuilder, outerCircuit)
+	if err != nil {
+		panic(err)
+	}
+	pk, vk, err := groth16.Setup(ccs)
+	if err != nil {
+		panic(err)
+	}
+	w, err := frontend.NewWitness(outerAssignment, ecc.BW6_761.ScalarField())
+	if err != nil {
+		panic(err)
+	}
+	proof, err := groth16.Prove(ccs, pk, w)
+	if err != nil {
+		panic(err)
+	}
+	public, err := w.Public()
+	if err != nil {
+		panic(err)
+	}
+	if err := groth16.Verify(proof, vk, public); err != nil {
+		panic(err)
+	}
+	fmt.Println("bw6-761: recursive proof verified")
+}
+
+// TestRecursiveBW6 runs Example_recursiveBW6, which panics on any failure.
+func TestRecursiveBW6(t *testing.T) {
+	if testing.Short() {
+		t.Skip("BW6-761 recursion takes minutes")
+	}
+	Example_recursiveBW6()
+}
@@ -0,0 +1,289 @@
+package poseidon377
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+
+	emposeidon "github.com/vocdoni/poseidon377/gnark/emulated/poseidon377"
+	gposeidon "github.com/vocdoni/poseidon377/gnark/poseidon377"
+	"github.com/vocdoni/poseidon377/reference"
+)
+
+// The fuzz targets decode their input as
+//
+//	selector byte | domain bytes (length byte + bytes) | element*
+//
+// where every element is a mode byte followed, for raw elements, by 32 big-endian bytes. Raw
+// values may exceed the modulus (non-canonical encodings); the other modes pick edge values.
+// Missing bytes read as zero, so every input decodes.
+
+type fuzzReader struct{ data []byte }
+
+func (r *fuzzReader) next(n int) []byte {
+	out := make([]byte, n)
+	copied := copy(out, r.data)
+	r.data = r.data[copied:]
+	return out
+}
+
+func (r *fuzzReader) byte() byte { return r.next(1)[0] }
+
+// domain reads up to 64 bytes and returns DomainFromLEBytes of them with the reference value.
+func (r *fuzzReader) domain() (fr.Element, *big.Int) {
+	raw := r.next(int(r.byte() % 65))
+	return DomainFromLEBytes(raw), reference.DomainFromLEBytes(raw)
+}
+
+// element returns the decoded field element, its reduced reference value and its 32-byte
+// big-endian encoding before reduction.
+func (r *fuzzReader) element() (fr.Element, *big.Int, [32]byte) {
+	mod := fr.Modulus()
+	var v *big.Int
+	switch r.byte() % 8 {
+	case 0:
+		v = big.NewInt(0)
+	case 1:
+		v = big.NewInt(1)
+	case 2:
+		v = new(big.Int).Sub(mod, big.NewInt(1))
+	case 3:
+		v = new(big.Int).Set(mod)
+	case 4:
+		v = new(big.Int).Add(mod, big.NewInt(1))
+	case 5:
+		v = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	default:
+		v = new(big.Int).SetBytes(r.next(32))
+	}
+	var enc [32]byte
+	v.FillBytes(enc[:])
+
+	var e fr.Element
+	e.SetBytes(enc[:])
+	return e, new(big.Int).Mod(v, mod), enc
+}
+
+func (r *fuzzReader) elements(n int) ([]fr.Element, []*big.Int) {
+	es := make([]fr.Element, n)
+	refs := make([]*big.Int, n)
+	for i := range es {
+		es[i], refs[i], _ = r.element()
+	}
+	return es, refs
+}
+
+func fuzzSeeds(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0, 0})
+	// Rate 3 with an empty domain and the edge values 0, 1, p-1.
+	f.Add([]byte{2, 0, 0, 1, 2})
+	// Non-canonical p, p+1 and 2^256-1 under a 16-byte domain.
+	f.Add(append([]byte{5, 16}, append([]byte("Penumbra_TestVec"), 3, 4, 5, 3, 4, 5)...))
+	raw := []byte{6, 4, 'a', 'b', 'c', 'd'}
+	for i := 0; i < 7; i++ {
+		raw = append(raw, 7)
+		for j := 0; j < 32; j++ {
+			raw = append(raw, byte(i*32+j)|0x80)
+		}
+	}
+	f.Add(raw)
+}
+
+func FuzzHash(f *testing.F) {
+	fuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		r := &fuzzReader{data: data}
+		rate := int(r.byte()%maxRate) + 1
+		domain, domainRef := r.domain()
+		if got := domain.BigInt(new(big.Int)); got.Cmp(domainRef) != 0 {
+			t.Fatalf("domain mismatch: %s vs reference %s", got, domainRef)
+		}
+
+		inputs := make([]fr.Element, rate)
+		refs := make([]*big.Int, rate)
+		for i := range inputs {
+			var enc [32]byte
+			inputs[i], refs[i], enc = r.element()
+			if got := inputs[i].BigInt(new(big.Int)); got.Cmp(refs[i]) != 0 {
+				t.Fatalf("input %d: SetBytes gave %s, reference %s", i, got, refs[i])
+			}
+			// Strict decoding accepts exactly the canonical encodings.
+			_, err := DigestFromBytes(enc[:])
+			canonical := new(big.Int).SetBytes(enc[:]).Cmp(fr.Modulus()) < 0
+			if canonical != (err == nil) {
+				t.Fatalf("input %d: canonical=%v but DigestFromBytes error %v", i, canonical, err)
+			}
+		}
+
+		got, err := Hash(domain, inputs...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := reference.Hash(domainRef, refs...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.BigInt(new(big.Int)).Cmp(want) != 0 {
+			t.Fatalf("rate %d: native %s, reference %s", rate, got.BigInt(new(big.Int)), want)
+		}
+	})
+}
+
+func FuzzMultiHash(f *testing.F) {
+	fuzzSeeds(f)
+	f.Add([]byte{48, 0})
+	f.Add([]byte{49, 0})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		r := &fuzzReader{data: data}
+		n := int(r.byte()%64) + 1
+		domain, domainRef := r.domain()
+		inputs, refs := r.elements(n)
+
+		got, err := MultiHash(domain, inputs...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := reference.MultiHash(domainRef, refs...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.BigInt(new(big.Int)).Cmp(want) != 0 {
+			t.Fatalf("multihash %d: native %s, reference %s", n, got.BigInt(new(big.Int)), want)
+		}
+
+		gotV2, err := MultiHashV2(domain, inputs...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantV2, err := reference.MultiHashV2(domainRef, refs...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gotV2.BigInt(new(big.Int)).Cmp(wantV2) != 0 {
+			t.Fatalf("multihash v2 %d: native %s, reference %s", n, gotV2.BigInt(new(big.Int)), wantV2)
+		}
+	})
+}
+
+type fuzzCircuit struct {
+	Domain   frontend.Variable
+	Inputs   []frontend.Variable
+	Expected frontend.Variable `gnark:",public"`
+
+	multi bool
+}
+
+func (c *fuzzCircuit) Define(api frontend.API) error {
+	var (
+		out frontend.Variable
+		err error
+	)
+	if c.multi {
+		out, err = gposeidon.MultiHash(api, c.Domain, c.Inputs...)
+	} else {
+		out, err = gposeidon.Hash(api, c.Domain, c.Inputs...)
+	}
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(out, c.Expected)
+	return nil
+}
+
+// FuzzCircuit checks that the native gadget is satisfied by the native output. Inputs are
+// assigned from their non-canonical encodings when the fuzzer produces them, exercising the
+// reduction of witness values.
+func FuzzCircuit(f *testing.F) {
+	fuzzSeeds(f)
+	f.Add([]byte{0x87, 0})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		r := &fuzzReader{data: data}
+		sel := r.byte()
+		multi := sel&0x80 != 0
+		n := int(sel%maxRate) + 1
+		if multi {
+			n = int(sel%24) + 1
+		}
+		domain, _ := r.domain()
+
+		inputs := make([]fr.Element, n)
+		assignment := &fuzzCircuit{Domain: domain, Inputs: make([]frontend.Variable, n)}
+		for i := range inputs {
+			var enc [32]byte
+			inputs[i], _, enc = r.element()
+			assignment.Inputs[i] = new(big.Int).SetBytes(enc[:])
+		}
+		var (
+			expected fr.Element
+			err      error
+		)
+		if multi {
+			expected, err = MultiHash(domain, inputs...)
+		} else {
+			expected, err = Hash(domain, inputs...)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		assignment.Expected = expected
+
+		circuit := &fuzzCircuit{Inputs: make([]frontend.Variable, n), multi: multi}
+		if err := test.IsSolved(circuit, assignment, ecc.BLS12_377.ScalarField()); err != nil {
+			t.Fatalf("multi=%v n=%d: %v", multi, n, err)
+		}
+	})
+}
+
+type fuzzEmulatedCircuit struct {
+	Domain   emulated.Element[emposeidon.FrParams]
+	Inputs   []emulated.Element[emposeidon.FrParams]
+	Expected emulated.Element[emposeidon.FrParams] `gnark:",public"`
+}
+
+func (c *fuzzEmulatedCircuit) Define(api frontend.API) error {
+	field, err := emulated.NewField[emposeidon.FrParams](api)
+	if err != nil {
+		return err
+	}
+	out, err := emposeidon.Hash(api, c.Domain, c.Inputs...)
+	if err != nil {
+		return err
+	}
+	field.AssertIsEqual(&out, &c.Expected)
+	return nil
+}
+
+// FuzzEmulatedHash checks the emulated gadget on a BW6-761 host against the native output. Each
+// execution solves a 35k–130k constraint circuit, so fuzz it with a long -fuzztime.
+func FuzzEmulatedHash(f *testing.F) {
+	fuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		r := &fuzzReader{data: data}
+		rate := int(r.byte()%maxRate) + 1
+		domain, _ := r.domain()
+		inputs, _ := r.elements(rate)
+
+		expected, err := Hash(domain, inputs...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assignment := &fuzzEmulatedCircuit{
+			Domain:   valueOf(domain),
+			Inputs:   make([]emulated.Element[emposeidon.FrParams], rate),
+			Expected: valueOf(expected),
+		}
+		for i := range inputs {
+			assignment.Inputs[i] = valueOf(inputs[i])
+		}
+		circuit := &fuzzEmulatedCircuit{Inputs: make([]emulated.Element[emposeidon.FrParams], rate)}
+		if err := test.IsSolved(circuit, assignment, ecc.BW6_761.ScalarField()); err != nil {
+			t.Fatalf("rate %d: %v", rate, err)
+		}
+	})
+}
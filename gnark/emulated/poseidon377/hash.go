@@ -7,6 +7,7 @@ import (
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/std/math/emulated"
 
+	domainpkg "github.com/vocdoni/poseidon377/internal/domain"
 	"github.com/vocdoni/poseidon377/internal/params"
 )
 
@@ -27,10 +28,20 @@ func Hash(api frontend.API, domain emulated.Element[FrParams], inputs ...emulate
 		var zero emulated.Element[FrParams]
 		return zero, err
 	}
+	return HashWithParameters(api, p, domain, inputs...)
+}
+
+// HashWithParameters is Hash with a custom parameter set, such as one returned by
+// poseidon377.LoadParameters. The set is validated and must have a state size of len(inputs)+1.
+func HashWithParameters(api frontend.API, p *params.Parameters, domain emulated.Element[FrParams], inputs ...emulated.Element[FrParams]) (emulated.Element[FrParams], error) {
 	if err := params.Validate(p); err != nil {
 		var zero emulated.Element[FrParams]
 		return zero, err
 	}
+	if len(inputs)+1 != p.StateSize {
+		var zero emulated.Element[FrParams]
+		return zero, fmt.Errorf("poseidon377: expected %d limbs, got %d", p.StateSize, len(inputs)+1)
+	}
 
 	field, err := emulated.NewField[FrParams](api)
 	if err != nil {
@@ -48,8 +59,86 @@ func Hash(api frontend.API, domain emulated.Element[FrParams], inputs ...emulate
 	return *out, nil
 }
 
+// HashN computes the native HashN(domain, outputs, inputs...) over emulated elements. Only the
+// returned limbs are reduced, so outputs=1 costs the same as Hash.
+func HashN(api frontend.API, domain emulated.Element[FrParams], outputs int, inputs ...emulated.Element[FrParams]) ([]emulated.Element[FrParams], error) {
+	rate := len(inputs)
+	if rate < 1 {
+		return nil, fmt.Errorf("poseidon377: need at least 1 limb")
+	}
+	if outputs < 1 || outputs > rate {
+		return nil, fmt.Errorf("poseidon377: outputs must be in 1..%d, got %d", rate, outputs)
+	}
+	p, err := nativeParams(rate)
+	if err != nil {
+		return nil, err
+	}
+	field, err := emulated.NewField[FrParams](api)
+	if err != nil {
+		return nil, err
+	}
+
+	state := make([]emulated.Element[FrParams], rate+1)
+	state[0] = domain
+	copy(state[1:], inputs)
+	permute(field, p, state)
+	// Reduce into a fresh slice: deferred multiplication checks keep pointers to their operands.
+	out := make([]emulated.Element[FrParams], outputs)
+	for i := range out {
+		out[i] = *field.Reduce(&state[1+i])
+	}
+	return out, nil
+}
+
+// Permute computes the native Permute(len(state)-1, state) over emulated elements and returns the
+// permuted state, with every limb reduced. The input slice is left unchanged.
+func Permute(api frontend.API, state []emulated.Element[FrParams]) ([]emulated.Element[FrParams], error) {
+	if len(state) < 2 {
+		return nil, fmt.Errorf("poseidon377: need at least 2 limbs, got %d", len(state))
+	}
+	p, err := nativeParams(len(state) - 1)
+	if err != nil {
+		return nil, err
+	}
+	field, err := emulated.NewField[FrParams](api)
+	if err != nil {
+		return nil, err
+	}
+	permuted := make([]emulated.Element[FrParams], len(state))
+	copy(permuted, state)
+	permute(field, p, permuted)
+	// Reduce into a fresh slice: deferred multiplication checks keep pointers to their operands.
+	out := make([]emulated.Element[FrParams], len(state))
+	for i := range permuted {
+		out[i] = *field.Reduce(&permuted[i])
+	}
+	return out, nil
+}
+
 // MultiHash hashes an arbitrary number of emulated elements (up to MaxMultiHashInputs) by chunking with the highest available rate (7).
 func MultiHash(api frontend.API, domain emulated.Element[FrParams], inputs ...emulated.Element[FrParams]) (emulated.Element[FrParams], error) {
+	return multiHash(api, domain, domain, inputs)
+}
+
+// MultiHashV2 mirrors the native MultiHashV2: the root chunk uses capacity
+// Hash(LengthDomain, domain, len(inputs)).
+func MultiHashV2(api frontend.API, domain emulated.Element[FrParams], inputs ...emulated.Element[FrParams]) (emulated.Element[FrParams], error) {
+	field, err := emulated.NewField[FrParams](api)
+	if err != nil {
+		var zero emulated.Element[FrParams]
+		return zero, err
+	}
+	lengthDomain := constElement(field, domainpkg.Length)
+	length := field.NewElement(len(inputs))
+	rootDomain, err := Hash(api, lengthDomain, domain, *length)
+	if err != nil {
+		var zero emulated.Element[FrParams]
+		return zero, err
+	}
+	return multiHash(api, domain, rootDomain, inputs)
+}
+
+func multiHash(api frontend.API, domain, rootDomain emulated.Element[FrParams], inputs []emulated.Element[FrParams]) (emulated.Element[FrParams], error) {
 	var zero emulated.Element[FrParams]
 	if len(inputs) == 0 {
 		return zero, fmt.Errorf("poseidon377: need at least 1 limb")
@@ -74,7 +163,7 @@ func MultiHash(api frontend.API, domain emulated.Element[FrParams], inputs ...em
 		current = next
 	}
 
-	return Hash(api, domain, current...)
+	return Hash(api, rootDomain, current...)
 }
 
 // permute mutates the state in place using the optimized Penumbra schedule.
@@ -0,0 +1,31 @@
+package poseidon377
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+
+	"github.com/vocdoni/poseidon377/internal/domain"
+)
+
+// Commit computes the native Commit(blinding, values...) inside a gnark circuit.
+func Commit(api frontend.API, blinding frontend.Variable, values ...frontend.Variable) (frontend.Variable, error) {
+	if len(values) == 0 {
+		var zero frontend.Variable
+		return zero, fmt.Errorf("poseidon377: need at least 1 value to commit")
+	}
+	inputs := make([]frontend.Variable, 0, len(values)+1)
+	inputs = append(inputs, blinding)
+	inputs = append(inputs, values...)
+	return MultiHashV2(api, domain.Commitment, inputs...)
+}
+
+// AssertCommitmentOpens constrains commitment to open to (blinding, values...).
+func AssertCommitmentOpens(api frontend.API, commitment, blinding frontend.Variable, values ...frontend.Variable) error {
+	out, err := Commit(api, blinding, values...)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(out, commitment)
+	return nil
+}
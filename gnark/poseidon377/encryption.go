@@ -0,0 +1,45 @@
+package poseidon377
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+
+	"github.com/vocdoni/poseidon377/internal/domain"
+)
+
+const encryptionRate = 3
+
+// Decrypt proves that ciphertext decrypts under (key, nonce) to the returned plaintext of the given
+// length, mirroring the native Decrypt: the tag and the zero padding are asserted and the nonce is
+// range-checked to 128 bits.
+func Decrypt(api frontend.API, key [2]frontend.Variable, nonce frontend.Variable, ciphertext []frontend.Variable, tag frontend.Variable, length int) ([]frontend.Variable, error) {
+	padded := (length + encryptionRate - 1) / encryptionRate * encryptionRate
+	if length < 0 || len(ciphertext) != padded {
+		return nil, fmt.Errorf("poseidon377: ciphertext length %d does not match plaintext length %d", len(ciphertext), length)
+	}
+	gadget, err := newCircuitPermutation(encryptionRate)
+	if err != nil {
+		return nil, err
+	}
+
+	api.ToBinary(nonce, 128)
+	lengthTerm := new(big.Int).Lsh(big.NewInt(int64(length)), 128)
+	state := []frontend.Variable{domain.Encryption, key[0], key[1], api.Add(nonce, lengthTerm)}
+
+	plaintext := make([]frontend.Variable, len(ciphertext))
+	for i := 0; i < len(ciphertext); i += encryptionRate {
+		state = gadget.permute(api, state)
+		for j := 0; j < encryptionRate; j++ {
+			plaintext[i+j] = api.Sub(ciphertext[i+j], state[j+1])
+			state[j+1] = ciphertext[i+j]
+		}
+	}
+	for i := length; i < len(plaintext); i++ {
+		api.AssertIsEqual(plaintext[i], 0)
+	}
+	state = gadget.permute(api, state)
+	api.AssertIsEqual(state[1], tag)
+	return plaintext[:length], nil
+}
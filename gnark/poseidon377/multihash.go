@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/consensys/gnark/frontend"
+
+	domainpkg "github.com/vocdoni/poseidon377/internal/domain"
 )
 
 const (
@@ -14,6 +16,21 @@ const (
 // MultiHash hashes an arbitrary-length list of field elements by chunking with the highest available rate (7).
 // Domain is placed in the capacity slot on every chunk. Supports up to MaxMultiHashInputs inputs.
 func MultiHash(api frontend.API, domain frontend.Variable, inputs ...frontend.Variable) (frontend.Variable, error) {
+	return multiHash(api, domain, domain, inputs)
+}
+
+// MultiHashV2 mirrors the native MultiHashV2: the root chunk uses capacity
+// Hash(LengthDomain, domain, len(inputs)).
+func MultiHashV2(api frontend.API, domain frontend.Variable, inputs ...frontend.Variable) (frontend.Variable, error) {
+	rootDomain, err := Hash(api, domainpkg.Length, domain, len(inputs))
+	if err != nil {
+		var zero frontend.Variable
+		return zero, err
+	}
+	return multiHash(api, domain, rootDomain, inputs)
+}
+
+func multiHash(api frontend.API, domain, rootDomain frontend.Variable, inputs []frontend.Variable) (frontend.Variable, error) {
 	if len(inputs) == 0 {
 		var zero frontend.Variable
 		return zero, fmt.Errorf("poseidon377: need at least 1 limb")
@@ -43,5 +60,5 @@ func MultiHash(api frontend.API, domain frontend.Variable, inputs ...frontend.Va
 		current = next
 	}
 
-	return Hash(api, domain, current...)
+	return Hash(api, rootDomain, current...)
 }
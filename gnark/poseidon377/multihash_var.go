@@ -0,0 +1,121 @@
+package poseidon377
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+
+	domainpkg "github.com/vocdoni/poseidon377/internal/domain"
+)
+
+// MultiHashVar computes the native MultiHash(domain, inputs[:length]...) for a runtime length in
+// [1, len(inputs)]; elements past length are ignored. The length is one-hot encoded once, so the rate
+// of every chunk and the depth of the root become linear combinations of the encoding. Each chunk is
+// hashed at every rate some length can give it and the matching digest is selected, which costs
+// roughly five times a fixed-length MultiHash over len(inputs) elements.
+func MultiHashVar(api frontend.API, domain frontend.Variable, inputs []frontend.Variable, length frontend.Variable) (frontend.Variable, error) {
+	return multiHashVar(api, domain, domain, inputs, length)
+}
+
+// MultiHashV2Var is MultiHashVar for the native MultiHashV2 (root capacity
+// Hash(LengthDomain, domain, length)).
+func MultiHashV2Var(api frontend.API, domain frontend.Variable, inputs []frontend.Variable, length frontend.Variable) (frontend.Variable, error) {
+	rootDomain, err := Hash(api, domainpkg.Length, domain, length)
+	if err != nil {
+		var zero frontend.Variable
+		return zero, err
+	}
+	return multiHashVar(api, domain, rootDomain, inputs, length)
+}
+
+func multiHashVar(api frontend.API, domain, rootDomain frontend.Variable, inputs []frontend.Variable, length frontend.Variable) (frontend.Variable, error) {
+	n := len(inputs)
+	if n == 0 {
+		var zero frontend.Variable
+		return zero, fmt.Errorf("poseidon377: need at least 1 limb")
+	}
+	if n > MaxMultiHashInputs {
+		var zero frontend.Variable
+		return zero, fmt.Errorf("poseidon377: too many inputs (%d > %d)", n, MaxMultiHashInputs)
+	}
+
+	// sel[i] = 1 iff length == i+1; exactly one must be set, which bounds length to [1, n].
+	sel := make([]frontend.Variable, n)
+	var count frontend.Variable = 0
+	for i := range sel {
+		sel[i] = api.IsZero(api.Sub(length, i+1))
+		count = api.Add(count, sel[i])
+	}
+	api.AssertIsEqual(count, 1)
+
+	// sizes[i][d] is the size of level d in the native tree over i+1 inputs; the last level is the root.
+	sizes := make([][]int, n)
+	for i := range sizes {
+		size := i + 1
+		sizes[i] = []int{size}
+		for size > maxRate {
+			size = (size + maxRate - 1) / maxRate
+			sizes[i] = append(sizes[i], size)
+		}
+	}
+	indicator := func(match func(levels []int) bool) frontend.Variable {
+		var sum frontend.Variable
+		for i := range sizes {
+			if !match(sizes[i]) {
+				continue
+			}
+			if sum == nil {
+				sum = sel[i]
+			} else {
+				sum = api.Add(sum, sel[i])
+			}
+		}
+		return sum
+	}
+
+	var out frontend.Variable = 0
+	level := make([]frontend.Variable, n)
+	copy(level, inputs)
+	for depth := 0; ; depth++ {
+		// Root candidates for the lengths whose tree ends at this depth.
+		for r := 1; r <= min(maxRate, len(level)); r++ {
+			ind := indicator(func(levels []int) bool {
+				return len(levels) == depth+1 && levels[depth] == r
+			})
+			if ind == nil {
+				continue
+			}
+			h, err := Hash(api, rootDomain, level[:r]...)
+			if err != nil {
+				var zero frontend.Variable
+				return zero, err
+			}
+			out = api.Add(out, api.Mul(ind, h))
+		}
+		if len(level) <= maxRate {
+			return out, nil
+		}
+
+		// Chunk k of the next level takes the rate it has in each tree that continues past this depth.
+		next := make([]frontend.Variable, 0, (len(level)+maxRate-1)/maxRate)
+		for start := 0; start < len(level); start += maxRate {
+			var chunk frontend.Variable = 0
+			for r := 1; r <= min(maxRate, len(level)-start); r++ {
+				ind := indicator(func(levels []int) bool {
+					return len(levels) > depth+1 && min(levels[depth]-start, maxRate) == r
+				})
+				if ind == nil {
+					continue
+				}
+				h, err := Hash(api, domain, level[start:start+r]...)
+				if err != nil {
+					var zero frontend.Variable
+					return zero, err
+				}
+				chunk = api.Add(chunk, api.Mul(ind, h))
+			}
+			next = append(next, chunk)
+		}
+		level = next
+	}
+}
@@ -44,6 +44,49 @@ func Hash(api frontend.API, domain frontend.Variable, inputs ...frontend.Variabl
 	return gadget.hash(api, domain, inputs)
 }
 
+// HashWithParameters is Hash with a custom parameter set, such as one returned by
+// poseidon377.LoadParameters. The set is validated and must have a state size of len(inputs)+1.
+func HashWithParameters(api frontend.API, p *params.Parameters, domain frontend.Variable, inputs ...frontend.Variable) (frontend.Variable, error) {
+	if err := params.Validate(p); err != nil {
+		var zero frontend.Variable
+		return zero, err
+	}
+	return (&circuitPermutation{params: p}).hash(api, domain, inputs)
+}
+
+// HashN computes the native HashN(domain, outputs, inputs...) inside a gnark circuit.
+func HashN(api frontend.API, domain frontend.Variable, outputs int, inputs ...frontend.Variable) ([]frontend.Variable, error) {
+	rate := len(inputs)
+	if rate < 1 {
+		return nil, fmt.Errorf("poseidon377: need at least 1 limb")
+	}
+	if outputs < 1 || outputs > rate {
+		return nil, fmt.Errorf("poseidon377: outputs must be in 1..%d, got %d", rate, outputs)
+	}
+	gadget, err := newCircuitPermutation(rate)
+	if err != nil {
+		return nil, err
+	}
+	state := make([]frontend.Variable, rate+1)
+	state[0] = domain
+	copy(state[1:], inputs)
+	state = gadget.permute(api, state)
+	return state[1 : 1+outputs], nil
+}
+
+// Permute computes the native Permute(len(state)-1, state) inside a gnark circuit and returns the
+// permuted state. The input slice is left unchanged.
+func Permute(api frontend.API, state []frontend.Variable) ([]frontend.Variable, error) {
+	if len(state) < 2 {
+		return nil, fmt.Errorf("poseidon377: need at least 2 limbs, got %d", len(state))
+	}
+	gadget, err := newCircuitPermutation(len(state) - 1)
+	if err != nil {
+		return nil, err
+	}
+	return gadget.permute(api, append([]frontend.Variable(nil), state...)), nil
+}
+
 func (p *circuitPermutation) hash(api frontend.API, domain frontend.Variable, inputs []frontend.Variable) (frontend.Variable, error) {
 	if len(inputs)+1 != p.params.StateSize {
 		var zero frontend.Variable
@@ -5,6 +5,8 @@ go 1.25.5
 require (
 	github.com/consensys/gnark v0.14.1-0.20251203003358-cce547909fed
 	github.com/consensys/gnark-crypto v0.19.3-0.20251115174214-022ec58e8c19
+	github.com/rs/zerolog v1.34.0
+	golang.org/x/crypto v0.45.0
 )
 
 require (
@@ -19,10 +21,8 @@ require (
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/rogpeppe/go-internal v1.14.1 // indirect
 	github.com/ronanh/intcomp v1.1.1 // indirect
-	github.com/rs/zerolog v1.34.0 // indirect
 	github.com/stretchr/testify v1.11.1 // indirect
 	github.com/x448/float16 v0.8.4 // indirect
-	golang.org/x/crypto v0.45.0 // indirect
 	golang.org/x/exp v0.0.0-20250819193227-8b4c13bb791b // indirect
 	golang.org/x/sync v0.16.0 // indirect
 	golang.org/x/sys v0.38.0 // indirect
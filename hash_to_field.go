@@ -0,0 +1,28 @@
+package poseidon377
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+// HashToField maps an arbitrary-length message to count field elements following RFC 9380
+// hash_to_field with expand_message_xmd over SHA-256. Each element is obtained by reducing
+// L = 48 uniform bytes (ceil((253+128)/8)) modulo r, so the bias is below 2^-128, unlike
+// DomainFromLEBytes which reduces the raw input bytes.
+//
+// dst is the domain separation tag (1 to 255 bytes, RFC 9380 recommends at least 16) and must be
+// unique per protocol and use. Up to 170 elements can be derived per call.
+func HashToField(dst, msg []byte, count int) ([]fr.Element, error) {
+	if count < 1 {
+		return nil, fmt.Errorf("poseidon377: need at least 1 output element")
+	}
+	if len(dst) == 0 {
+		return nil, fmt.Errorf("poseidon377: empty domain separation tag")
+	}
+	out, err := fr.Hash(msg, dst, count)
+	if err != nil {
+		return nil, fmt.Errorf("poseidon377: hash to field: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,75 @@
+package poseidon377
+
+import "testing"
+
+// Expected values were computed with an independent Python implementation of RFC 9380
+// expand_message_xmd (SHA-256) and hash_to_field with L = 48.
+func TestHashToFieldVectors(t *testing.T) {
+	dst := []byte("POSEIDON377-V01-CS02-with-expander-SHA256-128")
+	long := make([]byte, 200)
+	for i := range long {
+		long[i] = 'a'
+	}
+
+	cases := []struct {
+		name     string
+		msg      []byte
+		expected []string
+	}{
+		{
+			name:     "empty",
+			msg:      []byte{},
+			expected: []string{"5302402222565647117922753115821168490926173224497442021162515346398377743096"},
+		},
+		{
+			name: "abc",
+			msg:  []byte("abc"),
+			expected: []string{
+				"1124046743899218081080990971977494226827267719355526660516391052459001359100",
+				"3933596422678494633431932864258014396432321722627378643059739633531721466244",
+			},
+		},
+		{
+			name: "a*200",
+			msg:  long,
+			expected: []string{
+				"6073376731036141661310171236848618225908627730264932007412183082990813776868",
+				"6595814615997296946756353327277757959876509391073882530022496151711684239190",
+				"6253442878652658913509959762865121387426242304832506216234593829897690274908",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := HashToField(dst, tc.msg, len(tc.expected))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i, s := range tc.expected {
+				expected := mustElement(t, s)
+				if !out[i].Equal(&expected) {
+					t.Fatalf("element %d mismatch\nexpected %s\ngot      %s", i, expected.String(), out[i].String())
+				}
+			}
+		})
+	}
+}
+
+func TestHashToFieldErrors(t *testing.T) {
+	if _, err := HashToField(nil, []byte("msg"), 1); err == nil {
+		t.Fatalf("expected error for empty dst")
+	}
+	if _, err := HashToField([]byte("dst"), []byte("msg"), 0); err == nil {
+		t.Fatalf("expected error for zero count")
+	}
+	if _, err := HashToField(make([]byte, 256), []byte("msg"), 1); err == nil {
+		t.Fatalf("expected error for oversized dst")
+	}
+	if _, err := HashToField([]byte("dst"), []byte("msg"), 171); err == nil {
+		t.Fatalf("expected error for too many elements")
+	}
+	if _, err := HashToField([]byte("dst"), []byte("msg"), 170); err != nil {
+		t.Fatalf("170 elements should be supported: %v", err)
+	}
+}
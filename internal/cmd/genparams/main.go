@@ -0,0 +1,110 @@
+// Command genparams writes internal/params/params.go with every constant as a canonical decimal
+// string, parsed once at package initialization.
+//
+// With -in it reads a JSON array of parameter sets in the canonical format of params.Export
+// (e.g. converted from Penumbra's poseidon-parameters crate); without it, it re-emits the
+// compiled-in sets, which is how the drift test checks that params.go is up to date.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+
+	"github.com/vocdoni/poseidon377/internal/params"
+)
+
+func main() {
+	input := flag.String("in", "", "JSON array of canonical parameter sets (default: the compiled-in sets)")
+	output := flag.String("o", "params.go", "output file")
+	flag.Parse()
+
+	sets, err := load(*input)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(sets)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func load(path string) ([]*params.Canonical, error) {
+	if path == "" {
+		return builtin(), nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var sets []*params.Canonical
+	if err := json.Unmarshal(data, &sets); err != nil {
+		return nil, fmt.Errorf("genparams: %s: %w", path, err)
+	}
+	return sets, nil
+}
+
+func builtin() []*params.Canonical {
+	var sets []*params.Canonical
+	for _, p := range params.AllParameters {
+		sets = append(sets, params.Export(p))
+	}
+	return sets
+}
+
+func generate(sets []*params.Canonical) ([]byte, error) {
+	sort.Slice(sets, func(i, j int) bool { return sets[i].Rate < sets[j].Rate })
+	var b bytes.Buffer
+	b.WriteString("// Code generated by internal/cmd/genparams. DO NOT EDIT.\n\npackage params\n")
+	for i, c := range sets {
+		if i > 0 && sets[i-1].Rate == c.Rate {
+			return nil, fmt.Errorf("genparams: duplicate rate %d", c.Rate)
+		}
+		if _, err := c.Import(); err != nil {
+			return nil, fmt.Errorf("genparams: rate %d: %w", c.Rate, err)
+		}
+		o := c.OptimizedMDS
+		fmt.Fprintf(&b, "\nvar Rate%d = Parameters{\n", c.Rate)
+		fmt.Fprintf(&b, "M: %d,\nStateSize: %d,\nFullRounds: %d,\nPartialRounds: %d,\n", c.M, c.StateSize, c.FullRounds, c.PartialRounds)
+		fmt.Fprintf(&b, "Alpha: Alpha{Exponent: %d, Inverse: %t},\n", c.Alpha, c.AlphaInverse)
+		writeElements(&b, "Arc", c.Arc)
+		writeElements(&b, "OptimizedArc", c.OptimizedArc)
+		writeElements(&b, "MDS", c.MDS)
+		b.WriteString("OptimizedMDS: OptimizedMDS{\n")
+		writeElements(&b, "MHat", o.MHat)
+		writeElements(&b, "V", o.V)
+		writeElements(&b, "W", o.W)
+		writeElements(&b, "MPrime", o.MPrime)
+		writeElements(&b, "MDoublePrime", o.MDoublePrime)
+		writeElements(&b, "MInverse", o.MInverse)
+		writeElements(&b, "MHatInverse", o.MHatInverse)
+		fmt.Fprintf(&b, "M00: mustElement(%q),\n", o.M00)
+		writeElements(&b, "MI", o.MI)
+		writeElements(&b, "VCollection", o.VCollection)
+		writeElements(&b, "WHatCollection", o.WHatCollection)
+		b.WriteString("},\n}\n")
+	}
+
+	b.WriteString("\n// AllParameters maps each rate to its parameter set.\nvar AllParameters = map[int]*Parameters{\n")
+	for _, c := range sets {
+		fmt.Fprintf(&b, "%d: &Rate%d,\n", c.Rate, c.Rate)
+	}
+	b.WriteString("}\n")
+	return format.Source(b.Bytes())
+}
+
+func writeElements(b *bytes.Buffer, name string, values []string) {
+	fmt.Fprintf(b, "%s: mustElements(\n", name)
+	for _, v := range values {
+		fmt.Fprintf(b, "%q,\n", v)
+	}
+	b.WriteString("),\n")
+}
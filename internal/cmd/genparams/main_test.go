@@ -0,0 +1,21 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestParamsUpToDate(t *testing.T) {
+	committed, err := os.ReadFile("../../params/params.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	generated, err := generate(builtin())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(generated, committed) {
+		t.Fatalf("internal/params/params.go is stale, run `go generate ./internal/params`")
+	}
+}
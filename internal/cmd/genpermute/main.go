@@ -0,0 +1,283 @@
+// Command genpermute writes permute_unrolled.go: one permutation per built-in rate with every
+// round written out and the linear layers unrolled over the state width. The constants of
+// internal/params are held in fixed-size arrays indexed with constants, so the generated code has
+// no row*width index arithmetic and no allocations; the only bounds check is the slice-to-array
+// conversion when Hash enters permuteN.
+//
+// The generated functions follow permuteOptimized: full rounds, the dense M_i layer with its zero
+// and one coefficients dropped, sparse partial rounds and full rounds again. Constants are written
+// as canonical decimals and parsed at init, as internal/params does, rather than inlined as
+// Montgomery limbs.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"math/big"
+	"os"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+
+	"github.com/vocdoni/poseidon377/internal/params"
+)
+
+func main() {
+	output := flag.String("o", "permute_unrolled.go", "output file")
+	flag.Parse()
+	src, err := generate()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generate() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(`// Code generated by internal/cmd/genpermute. DO NOT EDIT.
+
+package poseidon377
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+
+	"github.com/vocdoni/poseidon377/internal/params"
+)
+
+// unrolledElements parses the canonical decimal constants below at init.
+func unrolledElements(ss ...string) []fr.Element {
+	es, err := params.Elements(ss)
+	if err != nil {
+		panic(err)
+	}
+	return es
+}
+
+`)
+	b.WriteString("// unrolledPermutations maps each built-in rate to its generated permutation.\n")
+	b.WriteString("var unrolledPermutations = map[int]func([]fr.Element){\n")
+	for rate := 1; rate <= len(params.AllParameters); rate++ {
+		fmt.Fprintf(&b, "%d: func(s []fr.Element) { permute%d((*[%d]fr.Element)(s)) },\n", rate, rate, rate+1)
+	}
+	b.WriteString("}\n")
+
+	for rate := 1; rate <= len(params.AllParameters); rate++ {
+		p, ok := params.AllParameters[rate]
+		if !ok {
+			return nil, fmt.Errorf("genpermute: missing rate %d", rate)
+		}
+		if err := params.Validate(p); err != nil {
+			return nil, err
+		}
+		if p.Alpha.Exponent != 17 {
+			return nil, fmt.Errorf("genpermute: rate %d: unsupported alpha %d", rate, p.Alpha.Exponent)
+		}
+		writeRate(&b, rate, p)
+	}
+	return format.Source(b.Bytes())
+}
+
+func writeRate(b *bytes.Buffer, rate int, p *params.Parameters) {
+	t := p.StateSize
+	rF := p.FullRounds / 2
+	rP := p.PartialRounds
+	arc := p.OptimizedArc
+	row := func(r int) []fr.Element { return arc[r*t : (r+1)*t] }
+	name := fmt.Sprintf("permute%d", rate)
+	c := name + "Constants"
+
+	// Constants, in the order the rounds consume them.
+	fmt.Fprintf(b, "\nvar %s = struct {\n", c)
+	fmt.Fprintf(b, "arcFirst [%d][%d]fr.Element // rows 0..%d: first full rounds and first partial round\n", rF+1, t, rF)
+	fmt.Fprintf(b, "arcPartial [%d]fr.Element // state[0] constant of partial rounds 2..%d\n", rP-1, rP)
+	fmt.Fprintf(b, "arcLast [%d][%d]fr.Element // last full rounds\n", rF, t)
+	fmt.Fprintf(b, "mds, mi [%d][%d]fr.Element\n", t, t)
+	fmt.Fprintf(b, "v, wHat [%d][%d]fr.Element // sparse matrices of partial rounds 1..%d\n", rP, t-1, rP)
+	b.WriteString("m00 fr.Element\n}{\n")
+
+	b.WriteString("arcFirst: ")
+	var rows [][]fr.Element
+	for r := 0; r <= rF; r++ {
+		rows = append(rows, row(r))
+	}
+	writeMatrix(b, rows)
+	var partial []fr.Element
+	for r := rF + 1; r < rF+rP; r++ {
+		partial = append(partial, row(r)[0])
+	}
+	b.WriteString("arcPartial: ")
+	writeVector(b, partial)
+	b.WriteString(",\narcLast: ")
+	rows = nil
+	for r := rF + rP; r < p.FullRounds+rP; r++ {
+		rows = append(rows, row(r))
+	}
+	writeMatrix(b, rows)
+	b.WriteString("mds: ")
+	writeMatrix(b, split(p.MDS, t))
+	b.WriteString("mi: ")
+	writeMatrix(b, split(p.OptimizedMDS.MI, t))
+	// Partial round k uses the sparse matrix with collection index rP-1-k.
+	var v, wHat [][]fr.Element
+	vs := split(p.OptimizedMDS.VCollection, t-1)
+	ws := split(p.OptimizedMDS.WHatCollection, t-1)
+	for k := 0; k < rP; k++ {
+		v = append(v, vs[rP-1-k])
+		wHat = append(wHat, ws[rP-1-k])
+	}
+	b.WriteString("v: ")
+	writeMatrix(b, v)
+	b.WriteString("wHat: ")
+	writeMatrix(b, wHat)
+	fmt.Fprintf(b, "m00: unrolledElements(%q)[0],\n}\n", p.OptimizedMDS.M00.BigInt(new(big.Int)).String())
+
+	// Permutation, with every round written out.
+	fmt.Fprintf(b, "\n// %s is the rate-%d permutation (width %d).\n", name, rate, t)
+	fmt.Fprintf(b, "func %s(s *[%d]fr.Element) {\n", name, t)
+	fmt.Fprintf(b, "k := &%s\n", c)
+	for r := 0; r < rF; r++ {
+		fmt.Fprintf(b, "\n// Full round %d.\n", r+1)
+		writeAddRow(b, t, fmt.Sprintf("k.arcFirst[%d]", r))
+		writeFullSBox(b, t)
+		fmt.Fprintf(b, "%sMDS(s)\n", name)
+	}
+	fmt.Fprintf(b, "\n// First partial round: the constants of row %d go through M_i.\n", rF)
+	writeAddRow(b, t, fmt.Sprintf("k.arcFirst[%d]", rF))
+	fmt.Fprintf(b, "%sMI(s)\n", name)
+	for r := 0; r < rP-1; r++ {
+		fmt.Fprintf(b, "\n// Partial round %d.\n", r+1)
+		b.WriteString("exp17(&s[0])\n")
+		fmt.Fprintf(b, "s[0].Add(&s[0], &k.arcPartial[%d])\n", r)
+		fmt.Fprintf(b, "%sSparse(s, &k.v[%d], &k.wHat[%d])\n", name, r, r)
+	}
+	fmt.Fprintf(b, "\n// Partial round %d.\n", rP)
+	b.WriteString("exp17(&s[0])\n")
+	fmt.Fprintf(b, "%sSparse(s, &k.v[%d], &k.wHat[%d])\n", name, rP-1, rP-1)
+	for r := 0; r < rF; r++ {
+		fmt.Fprintf(b, "\n// Full round %d.\n", rF+r+1)
+		writeAddRow(b, t, fmt.Sprintf("k.arcLast[%d]", r))
+		writeFullSBox(b, t)
+		fmt.Fprintf(b, "%sMDS(s)\n", name)
+	}
+	b.WriteString("}\n")
+
+	// MDS layer.
+	fmt.Fprintf(b, "\nfunc %sMDS(s *[%d]fr.Element) {\n", name, t)
+	fmt.Fprintf(b, "k := &%s\n", c)
+	writeTempDecl(b, t, true)
+	for i := 0; i < t; i++ {
+		for j := 0; j < t; j++ {
+			if j == 0 {
+				fmt.Fprintf(b, "n%d.Mul(&k.mds[%d][%d], &s[%d])\n", i, i, j, j)
+				continue
+			}
+			fmt.Fprintf(b, "prod.Mul(&k.mds[%d][%d], &s[%d])\nn%d.Add(&n%d, &prod)\n", i, j, j, i, i)
+		}
+	}
+	writeStore(b, t)
+
+	// M_i layer without its zero and one coefficients.
+	var mi bytes.Buffer
+	usesProd := false
+	var one fr.Element
+	one.SetOne()
+	for i := 0; i < t; i++ {
+		first := true
+		for j := 0; j < t; j++ {
+			m := p.OptimizedMDS.MI[i*t+j]
+			switch {
+			case m.IsZero():
+				continue
+			case m.Equal(&one) && first:
+				fmt.Fprintf(&mi, "n%d = s[%d]\n", i, j)
+			case m.Equal(&one):
+				fmt.Fprintf(&mi, "n%d.Add(&n%d, &s[%d])\n", i, i, j)
+			case first:
+				fmt.Fprintf(&mi, "n%d.Mul(&k.mi[%d][%d], &s[%d])\n", i, i, j, j)
+			default:
+				fmt.Fprintf(&mi, "prod.Mul(&k.mi[%d][%d], &s[%d])\nn%d.Add(&n%d, &prod)\n", i, j, j, i, i)
+				usesProd = true
+			}
+			first = false
+		}
+	}
+	fmt.Fprintf(b, "\nfunc %sMI(s *[%d]fr.Element) {\n", name, t)
+	fmt.Fprintf(b, "k := &%s\n", c)
+	writeTempDecl(b, t, usesProd)
+	b.Write(mi.Bytes())
+	writeStore(b, t)
+
+	// Sparse partial-round layer.
+	fmt.Fprintf(b, "\nfunc %sSparse(s *[%d]fr.Element, v, wHat *[%d]fr.Element) {\n", name, t, t-1)
+	b.WriteString("var n0, prod fr.Element\n")
+	fmt.Fprintf(b, "n0.Mul(&%s.m00, &s[0])\n", c)
+	for i := 0; i < t-1; i++ {
+		fmt.Fprintf(b, "prod.Mul(&wHat[%d], &s[%d])\nn0.Add(&n0, &prod)\n", i, i+1)
+		fmt.Fprintf(b, "prod.Mul(&v[%d], &s[0])\ns[%d].Add(&s[%d], &prod)\n", i, i+1, i+1)
+	}
+	b.WriteString("s[0] = n0\n}\n")
+}
+
+func writeAddRow(b *bytes.Buffer, t int, row string) {
+	for i := 0; i < t; i++ {
+		fmt.Fprintf(b, "s[%d].Add(&s[%d], &%s[%d])\n", i, i, row, i)
+	}
+}
+
+func writeFullSBox(b *bytes.Buffer, t int) {
+	for i := 0; i < t; i++ {
+		fmt.Fprintf(b, "exp17(&s[%d])\n", i)
+	}
+}
+
+func writeTempDecl(b *bytes.Buffer, t int, prod bool) {
+	b.WriteString("var n0")
+	for i := 1; i < t; i++ {
+		fmt.Fprintf(b, ", n%d", i)
+	}
+	if prod {
+		b.WriteString(", prod")
+	}
+	b.WriteString(" fr.Element\n")
+}
+
+func writeStore(b *bytes.Buffer, t int) {
+	for i := 0; i < t; i++ {
+		fmt.Fprintf(b, "s[%d] = n%d\n", i, i)
+	}
+	b.WriteString("}\n")
+}
+
+func split(es []fr.Element, n int) [][]fr.Element {
+	var out [][]fr.Element
+	for i := 0; i < len(es); i += n {
+		out = append(out, es[i:i+n])
+	}
+	return out
+}
+
+func writeMatrix(b *bytes.Buffer, rows [][]fr.Element) {
+	fmt.Fprintf(b, "[%d][%d]fr.Element{\n", len(rows), len(rows[0]))
+	for _, r := range rows {
+		writeVector(b, r)
+		b.WriteString(",\n")
+	}
+	b.WriteString("},\n")
+}
+
+// writeVector emits es as an array converted from unrolledElements over canonical decimals, so the
+// generated file does not depend on the Montgomery form of fr.Element.
+func writeVector(b *bytes.Buffer, es []fr.Element) {
+	fmt.Fprintf(b, "[%d]fr.Element(unrolledElements(", len(es))
+	for i := range es {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(b, "%q", es[i].BigInt(new(big.Int)).String())
+	}
+	b.WriteString("))")
+}
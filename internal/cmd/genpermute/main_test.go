@@ -0,0 +1,21 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestUnrolledUpToDate(t *testing.T) {
+	committed, err := os.ReadFile("../../../permute_unrolled.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	generated, err := generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(generated, committed) {
+		t.Fatalf("permute_unrolled.go is stale, run `go generate github.com/vocdoni/poseidon377`")
+	}
+}
@@ -0,0 +1,37 @@
+// Package domain holds domain separators shared by the native and gnark implementations.
+package domain
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+// Labels of the built-in domains, interpreted as little-endian bytes.
+const (
+	CommitmentLabel = "poseidon377.commitment"
+	EncryptionLabel = "poseidon377.encryption"
+	LengthLabel     = "poseidon377.multihash.v2"
+)
+
+var (
+	// Commitment separates Poseidon commitments from plain hashes.
+	Commitment = FromLEBytes([]byte(CommitmentLabel))
+	// Encryption occupies the capacity slot of the sponge authenticated encryption.
+	Encryption = FromLEBytes([]byte(EncryptionLabel))
+	// Length is the capacity of Hash_2(Length, d, n), which derives the MultiHashV2 root capacity
+	// for domain d and n inputs.
+	Length = FromLEBytes([]byte(LengthLabel))
+)
+
+// FromLEBytes mirrors decaf377::Fq::from_le_bytes_mod_order.
+func FromLEBytes(data []byte) fr.Element {
+	reversed := make([]byte, len(data))
+	for i := range data {
+		reversed[len(data)-1-i] = data[i]
+	}
+	bi := new(big.Int).SetBytes(reversed)
+	var out fr.Element
+	out.SetBigInt(bi)
+	return out
+}
@@ -0,0 +1,89 @@
+// Package jsconst generates js/src/constants.ts, the parameter tables of the TypeScript port, from
+// the Go parameter sets.
+package jsconst
+
+//go:generate go run ../../cmd/poseidon377 jsconstants -o ../../js/src/constants.ts
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+
+	"github.com/vocdoni/poseidon377/internal/params"
+)
+
+// Generate returns the contents of constants.ts for params.AllParameters. Tables are keyed by
+// rate; matrices are row-major, ARC is the optimized round constants and SparseV/SparseWHat are
+// the sparse partial-round matrices, as consumed by js/src/index.ts.
+func Generate() ([]byte, error) {
+	m00 := map[string]string{}
+	tables := []struct {
+		name string
+		rows map[string][]string
+		get  func(*params.Parameters) []fr.Element
+	}{
+		{"MDS", map[string][]string{}, func(p *params.Parameters) []fr.Element { return p.MDS }},
+		{"MI", map[string][]string{}, func(p *params.Parameters) []fr.Element { return p.OptimizedMDS.MI }},
+		{"ARC", map[string][]string{}, func(p *params.Parameters) []fr.Element { return p.OptimizedArc }},
+		{"SparseV", map[string][]string{}, func(p *params.Parameters) []fr.Element { return p.OptimizedMDS.VCollection }},
+		{"SparseWHat", map[string][]string{}, func(p *params.Parameters) []fr.Element { return p.OptimizedMDS.WHatCollection }},
+	}
+
+	var fullRounds, partialRounds int
+	for rate := 1; rate <= len(params.AllParameters); rate++ {
+		p, ok := params.AllParameters[rate]
+		if !ok {
+			return nil, fmt.Errorf("jsconst: missing rate %d", rate)
+		}
+		if err := params.Validate(p); err != nil {
+			return nil, err
+		}
+		// The TypeScript port uses one round count for every rate.
+		if rate == 1 {
+			fullRounds, partialRounds = p.FullRounds, p.PartialRounds
+		} else if p.FullRounds != fullRounds || p.PartialRounds != partialRounds {
+			return nil, fmt.Errorf("jsconst: rate %d has %d/%d rounds, rate 1 has %d/%d", rate, p.FullRounds, p.PartialRounds, fullRounds, partialRounds)
+		}
+		key := strconv.Itoa(rate)
+		m00[key] = params.Decimal(p.OptimizedMDS.M00)
+		for _, t := range tables {
+			t.rows[key] = params.Decimals(t.get(p))
+		}
+	}
+
+	var b bytes.Buffer
+	if err := writeTable(&b, "M00", "Record<number, string>", m00); err != nil {
+		return nil, err
+	}
+	for _, t := range tables {
+		if err := writeTable(&b, t.name, "Record<number, string[]>", t.rows); err != nil {
+			return nil, err
+		}
+	}
+	fmt.Fprintf(&b, "export const FULL_ROUNDS = %d;\nexport const PARTIAL_ROUNDS = %d;\n", fullRounds, partialRounds)
+	return b.Bytes(), nil
+}
+
+// Write writes the output of Generate to w.
+func Write(w io.Writer) error {
+	src, err := Generate()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+// writeTable emits the table as a JSON object literal (two-space indent, sorted keys).
+func writeTable(b *bytes.Buffer, name, typ string, v any) error {
+	body, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(b, "export const %s: %s = %s;\n", name, typ, body)
+	return nil
+}
@@ -0,0 +1,21 @@
+package jsconst
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestConstantsUpToDate(t *testing.T) {
+	committed, err := os.ReadFile("../../js/src/constants.ts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	generated, err := Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(generated, committed) {
+		t.Fatalf("js/src/constants.ts is stale, run `go generate ./internal/jsconst`")
+	}
+}
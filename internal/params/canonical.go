@@ -0,0 +1,222 @@
+package params
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+// Canonical is a parameter set with every field element written as a canonical decimal string,
+// independent of gnark-crypto's Montgomery representation. Matrices are row-major. Field names
+// follow Penumbra's poseidon-parameters crate so dumps can be compared with its constants.
+type Canonical struct {
+	Rate          int    `json:"rate"`
+	M             int    `json:"M"`
+	StateSize     int    `json:"t"`
+	FullRounds    int    `json:"full_rounds"`
+	PartialRounds int    `json:"partial_rounds"`
+	Alpha         uint32 `json:"alpha"`
+	AlphaInverse  bool   `json:"alpha_inverse,omitempty"`
+
+	Arc          []string           `json:"arc"`
+	MDS          []string           `json:"mds"`
+	OptimizedArc []string           `json:"optimized_arc"`
+	OptimizedMDS CanonicalOptimized `json:"optimized_mds"`
+}
+
+// CanonicalOptimized is OptimizedMDS in canonical form.
+type CanonicalOptimized struct {
+	MHat           []string `json:"M_hat"`
+	V              []string `json:"v"`
+	W              []string `json:"w"`
+	MPrime         []string `json:"M_prime"`
+	MDoublePrime   []string `json:"M_doubleprime"`
+	MInverse       []string `json:"M_inverse"`
+	MHatInverse    []string `json:"M_hat_inverse"`
+	M00            string   `json:"M00"`
+	MI             []string `json:"M_i"`
+	VCollection    []string `json:"v_collection"`
+	WHatCollection []string `json:"w_hat_collection"`
+}
+
+// Export converts p to canonical form.
+func Export(p *Parameters) *Canonical {
+	return &Canonical{
+		Rate:          p.StateSize - 1,
+		M:             p.M,
+		StateSize:     p.StateSize,
+		FullRounds:    p.FullRounds,
+		PartialRounds: p.PartialRounds,
+		Alpha:         p.Alpha.Exponent,
+		AlphaInverse:  p.Alpha.Inverse,
+		Arc:           Decimals(p.Arc),
+		MDS:           Decimals(p.MDS),
+		OptimizedArc:  Decimals(p.OptimizedArc),
+		OptimizedMDS: CanonicalOptimized{
+			MHat:           Decimals(p.OptimizedMDS.MHat),
+			V:              Decimals(p.OptimizedMDS.V),
+			W:              Decimals(p.OptimizedMDS.W),
+			MPrime:         Decimals(p.OptimizedMDS.MPrime),
+			MDoublePrime:   Decimals(p.OptimizedMDS.MDoublePrime),
+			MInverse:       Decimals(p.OptimizedMDS.MInverse),
+			MHatInverse:    Decimals(p.OptimizedMDS.MHatInverse),
+			M00:            Decimal(p.OptimizedMDS.M00),
+			MI:             Decimals(p.OptimizedMDS.MI),
+			VCollection:    Decimals(p.OptimizedMDS.VCollection),
+			WHatCollection: Decimals(p.OptimizedMDS.WHatCollection),
+		},
+	}
+}
+
+// Import parses and validates a canonical parameter set.
+func (c *Canonical) Import() (*Parameters, error) {
+	if c.StateSize != c.Rate+1 {
+		return nil, fmt.Errorf("poseidon377: rate %d does not match state size %d", c.Rate, c.StateSize)
+	}
+	p := &Parameters{
+		M:             c.M,
+		StateSize:     c.StateSize,
+		FullRounds:    c.FullRounds,
+		PartialRounds: c.PartialRounds,
+		Alpha:         Alpha{Exponent: c.Alpha, Inverse: c.AlphaInverse},
+	}
+	o := c.OptimizedMDS
+	for _, f := range []struct {
+		dst *[]fr.Element
+		src []string
+	}{
+		{&p.Arc, c.Arc},
+		{&p.MDS, c.MDS},
+		{&p.OptimizedArc, c.OptimizedArc},
+		{&p.OptimizedMDS.MHat, o.MHat},
+		{&p.OptimizedMDS.V, o.V},
+		{&p.OptimizedMDS.W, o.W},
+		{&p.OptimizedMDS.MPrime, o.MPrime},
+		{&p.OptimizedMDS.MDoublePrime, o.MDoublePrime},
+		{&p.OptimizedMDS.MInverse, o.MInverse},
+		{&p.OptimizedMDS.MHatInverse, o.MHatInverse},
+		{&p.OptimizedMDS.MI, o.MI},
+		{&p.OptimizedMDS.VCollection, o.VCollection},
+		{&p.OptimizedMDS.WHatCollection, o.WHatCollection},
+	} {
+		es, err := Elements(f.src)
+		if err != nil {
+			return nil, err
+		}
+		*f.dst = es
+	}
+	m00, err := Element(o.M00)
+	if err != nil {
+		return nil, err
+	}
+	p.OptimizedMDS.M00 = m00
+	if err := Validate(p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// WriteJSON writes c as indented JSON.
+func (c *Canonical) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(c)
+}
+
+// WriteTOML writes c as a TOML document with the optimized matrices in an [optimized_mds] table.
+func (c *Canonical) WriteTOML(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "rate = %d\nM = %d\nt = %d\nfull_rounds = %d\npartial_rounds = %d\nalpha = %d\nalpha_inverse = %t\n",
+		c.Rate, c.M, c.StateSize, c.FullRounds, c.PartialRounds, c.Alpha, c.AlphaInverse)
+	tomlArray(&b, "arc", c.Arc)
+	tomlArray(&b, "mds", c.MDS)
+	tomlArray(&b, "optimized_arc", c.OptimizedArc)
+	o := c.OptimizedMDS
+	b.WriteString("\n[optimized_mds]\n")
+	tomlArray(&b, "M_hat", o.MHat)
+	tomlArray(&b, "v", o.V)
+	tomlArray(&b, "w", o.W)
+	tomlArray(&b, "M_prime", o.MPrime)
+	tomlArray(&b, "M_doubleprime", o.MDoublePrime)
+	tomlArray(&b, "M_inverse", o.MInverse)
+	tomlArray(&b, "M_hat_inverse", o.MHatInverse)
+	fmt.Fprintf(&b, "M00 = %q\n", o.M00)
+	tomlArray(&b, "M_i", o.MI)
+	tomlArray(&b, "v_collection", o.VCollection)
+	tomlArray(&b, "w_hat_collection", o.WHatCollection)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func tomlArray(b *strings.Builder, key string, values []string) {
+	fmt.Fprintf(b, "%s = [\n", key)
+	for _, v := range values {
+		fmt.Fprintf(b, "  %q,\n", v)
+	}
+	b.WriteString("]\n")
+}
+
+// Decimal returns the canonical decimal form of e. fr.Element.String is not used because it
+// prints values close to r as small negatives.
+func Decimal(e fr.Element) string {
+	return e.BigInt(new(big.Int)).String()
+}
+
+// Decimals returns the canonical decimal form of es.
+func Decimals(es []fr.Element) []string {
+	out := make([]string, len(es))
+	for i := range es {
+		out[i] = Decimal(es[i])
+	}
+	return out
+}
+
+// Element parses a canonical decimal or 0x-prefixed hex constant. Values outside [0, r) are
+// rejected rather than reduced.
+func Element(s string) (fr.Element, error) {
+	base, digits := 10, s
+	if len(s) > 2 && (s[:2] == "0x" || s[:2] == "0X") {
+		base, digits = 16, s[2:]
+	}
+	v, ok := new(big.Int).SetString(digits, base)
+	if !ok || v.Sign() < 0 || v.Cmp(fr.Modulus()) >= 0 {
+		return fr.Element{}, fmt.Errorf("poseidon377: %q is not a canonical field element", s)
+	}
+	var e fr.Element
+	e.SetBigInt(v)
+	return e, nil
+}
+
+// Elements parses a list of canonical constants.
+func Elements(ss []string) ([]fr.Element, error) {
+	out := make([]fr.Element, len(ss))
+	for i, s := range ss {
+		e, err := Element(s)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = e
+	}
+	return out, nil
+}
+
+// mustElements parses the constants of the generated parameter sets at init.
+func mustElements(ss ...string) []fr.Element {
+	es, err := Elements(ss)
+	if err != nil {
+		panic(err)
+	}
+	return es
+}
+
+func mustElement(s string) fr.Element {
+	e, err := Element(s)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
@@ -0,0 +1,79 @@
+package params
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+func TestCanonicalRoundTrip(t *testing.T) {
+	for rate, p := range AllParameters {
+		var buf bytes.Buffer
+		if err := Export(p).WriteJSON(&buf); err != nil {
+			t.Fatal(err)
+		}
+		var c Canonical
+		if err := json.Unmarshal(buf.Bytes(), &c); err != nil {
+			t.Fatal(err)
+		}
+		got, err := c.Import()
+		if err != nil {
+			t.Fatalf("rate %d: %v", rate, err)
+		}
+		gotJSON, _ := json.Marshal(Export(got))
+		wantJSON, _ := json.Marshal(Export(p))
+		if !bytes.Equal(gotJSON, wantJSON) {
+			t.Fatalf("rate %d: round trip changed the parameters", rate)
+		}
+		if got.OptimizedMDS.M00 != p.OptimizedMDS.M00 || got.Arc[len(got.Arc)-1] != p.Arc[len(p.Arc)-1] {
+			t.Fatalf("rate %d: elements differ after round trip", rate)
+		}
+	}
+}
+
+func TestCanonicalRejects(t *testing.T) {
+	c := Export(AllParameters[2])
+	c.Rate = 3
+	if _, err := c.Import(); err == nil {
+		t.Fatal("expected an error for a rate/state size mismatch")
+	}
+
+	c = Export(AllParameters[2])
+	c.MDS = c.MDS[1:]
+	if _, err := c.Import(); err == nil {
+		t.Fatal("expected a validation error for a short MDS")
+	}
+
+	for _, s := range []string{fr.Modulus().String(), "-1", "abc", "0x", ""} {
+		if _, err := Element(s); err == nil {
+			t.Fatalf("Element(%q): expected an error", s)
+		}
+	}
+	if e, err := Element("0x10"); err != nil || Decimal(e) != "16" {
+		t.Fatalf("Element(0x10) = %s, %v", Decimal(e), err)
+	}
+}
+
+func TestCanonicalTOML(t *testing.T) {
+	c := Export(AllParameters[1])
+	var buf bytes.Buffer
+	if err := c.WriteTOML(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"rate = 1\n",
+		"t = 2\n",
+		"alpha = 17\n",
+		"arc = [\n  \"" + c.Arc[0] + "\",\n",
+		"\n[optimized_mds]\n",
+		"M00 = \"" + c.OptimizedMDS.M00 + "\"\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("TOML output is missing %q", want)
+		}
+	}
+}
@@ -1,5 +1,9 @@
+// Package params holds the Poseidon377 parameter sets. params.go is generated with the constants
+// in canonical decimal form; Export and Canonical.Import convert any set to and from that form.
 package params
 
+//go:generate go run ../cmd/genparams -o params.go
+
 import "github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
 
 // Alpha captures the Poseidon S-box exponent.
@@ -38,8 +42,3 @@ type OptimizedMDS struct {
 	VCollection    []fr.Element
 	WHatCollection []fr.Element
 }
-
-// FromMontgomery builds an fr.Element from Montgomery-form limbs.
-func FromMontgomery(limbs [4]uint64) fr.Element {
-	return fr.Element{limbs[0], limbs[1], limbs[2], limbs[3]}
-}
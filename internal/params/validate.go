@@ -7,9 +7,18 @@ func Validate(p *Parameters) error {
 	if p.Alpha.Inverse {
 		return fmt.Errorf("poseidon377: unsupported inverse alpha")
 	}
+	if p.Alpha.Exponent != 17 {
+		return fmt.Errorf("poseidon377: unsupported alpha %d (only x^17 is implemented)", p.Alpha.Exponent)
+	}
 	if p.FullRounds%2 != 0 {
 		return fmt.Errorf("poseidon377: full rounds must be even, got %d", p.FullRounds)
 	}
+	if p.FullRounds < 2 || p.PartialRounds < 1 {
+		return fmt.Errorf("poseidon377: need at least 2 full rounds and 1 partial round, got %d and %d", p.FullRounds, p.PartialRounds)
+	}
+	if p.StateSize < 2 {
+		return fmt.Errorf("poseidon377: state size must be at least 2, got %d", p.StateSize)
+	}
 	width := p.StateSize
 	expectedRounds := (p.FullRounds + p.PartialRounds) * width
 	if len(p.OptimizedArc) != expectedRounds {
@@ -0,0 +1,212 @@
+// Package testvectors generates and loads the versioned JSON vector suite in testdata/vectors.json,
+// shared by the Go, gnark, emulated and JS implementations.
+package testvectors
+
+//go:generate go run ../../cmd/poseidon377 vectors -format suite -o ../../testdata/vectors.json
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+
+	poseidon "github.com/vocdoni/poseidon377"
+)
+
+// Version is bumped whenever existing vectors change meaning. Version 2 derives the multihash_v2
+// root capacity as Hash2(LengthDomain, domain, n) instead of domain + n*2^64.
+const Version = 2
+
+const (
+	penumbraDomain = "Penumbra_TestVec"
+	penumbraSeed   = "7553885614632219548127688026174585776320152166623257619763178041781456016062"
+)
+
+// MultiHashSizes covers single-chunk, exact-chunk, chunk+1 and multi-level trees.
+var MultiHashSizes = []int{1, 2, 7, 8, 14, 16, 49, 50, 64, 256}
+
+// Suite is the top-level JSON document. All field elements are decimal strings.
+type Suite struct {
+	Version     int      `json:"version"`
+	Modulus     string   `json:"modulus"`
+	Hash        []Vector `json:"hash"`
+	MultiHash   []Vector `json:"multihash"`
+	MultiHashV2 []Vector `json:"multihash_v2"`
+}
+
+// Vector is one input/output pair. DomainLabel, when set, is the string that DomainFromLEBytes
+// turns into Domain.
+type Vector struct {
+	Name        string   `json:"name"`
+	DomainLabel string   `json:"domain_label,omitempty"`
+	Domain      string   `json:"domain"`
+	Inputs      []string `json:"inputs"`
+	Output      string   `json:"output"`
+}
+
+// Elements parses the domain and inputs of the vector.
+func (v Vector) Elements() (domain fr.Element, inputs []fr.Element, output fr.Element, err error) {
+	if domain, err = parse(v.Domain); err != nil {
+		return
+	}
+	inputs = make([]fr.Element, len(v.Inputs))
+	for i, s := range v.Inputs {
+		if inputs[i], err = parse(s); err != nil {
+			return
+		}
+	}
+	output, err = parse(v.Output)
+	return
+}
+
+func parse(s string) (fr.Element, error) {
+	bi, ok := new(big.Int).SetString(s, 10)
+	if !ok || bi.Sign() < 0 || bi.Cmp(fr.Modulus()) >= 0 {
+		return fr.Element{}, fmt.Errorf("testvectors: invalid field element %q", s)
+	}
+	var e fr.Element
+	e.SetBigInt(bi)
+	return e, nil
+}
+
+// Load decodes a suite and checks its version.
+func Load(r io.Reader) (*Suite, error) {
+	var s Suite
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return nil, fmt.Errorf("testvectors: %w", err)
+	}
+	if s.Version != Version {
+		return nil, fmt.Errorf("testvectors: unsupported version %d (want %d)", s.Version, Version)
+	}
+	return &s, nil
+}
+
+// Write encodes the suite as indented JSON.
+func Write(w io.Writer, s *Suite) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s)
+}
+
+// Generate computes the suite with the native implementation.
+func Generate() (*Suite, error) {
+	s := &Suite{Version: Version, Modulus: fr.Modulus().String()}
+	labelled := poseidon.DomainFromLEBytes([]byte(penumbraDomain))
+
+	var zero, one, pMinus1, seed fr.Element
+	one.SetOne()
+	pMinus1.Neg(&one)
+	if _, err := seed.SetString(penumbraSeed); err != nil {
+		return nil, err
+	}
+
+	// Penumbra chain: rate-N inputs are the seed followed by the outputs of rates 1..N-1.
+	chain := []fr.Element{seed}
+	for rate := 1; rate <= 7; rate++ {
+		v, err := hashVector(fmt.Sprintf("penumbra-rate%d", rate), penumbraDomain, labelled, chain[:rate])
+		if err != nil {
+			return nil, err
+		}
+		out, _ := parse(v.Output)
+		chain = append(chain, out)
+		s.Hash = append(s.Hash, v)
+	}
+
+	for rate := 1; rate <= 7; rate++ {
+		edges := []struct {
+			name   string
+			domain fr.Element
+			fill   func(i int) fr.Element
+		}{
+			{"zeros", zero, func(int) fr.Element { return zero }},
+			{"p-1", pMinus1, func(int) fr.Element { return pMinus1 }},
+			{"sequence", one, func(i int) fr.Element { return fr.NewElement(uint64(i + 1)) }},
+		}
+		for _, edge := range edges {
+			inputs := make([]fr.Element, rate)
+			for i := range inputs {
+				inputs[i] = edge.fill(i)
+			}
+			v, err := hashVector(fmt.Sprintf("%s-rate%d", edge.name, rate), "", edge.domain, inputs)
+			if err != nil {
+				return nil, err
+			}
+			s.Hash = append(s.Hash, v)
+		}
+	}
+
+	var err error
+	if s.MultiHash, err = multiHashVectors(poseidon.MultiHash, labelled, pMinus1); err != nil {
+		return nil, err
+	}
+	if s.MultiHashV2, err = multiHashVectors(poseidon.MultiHashV2, labelled, pMinus1); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+type multiHashFunc func(fr.Element, ...fr.Element) (fr.Element, error)
+
+func multiHashVectors(fn multiHashFunc, labelled, pMinus1 fr.Element) ([]Vector, error) {
+	var out []Vector
+	for _, n := range MultiHashSizes {
+		inputs := make([]fr.Element, n)
+		for i := range inputs {
+			inputs[i].SetUint64(uint64(i + 1))
+		}
+		v, err := multiHashVector(fn, fmt.Sprintf("sequence-%d", n), penumbraDomain, labelled, inputs)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	for _, n := range []int{8, 50} {
+		inputs := make([]fr.Element, n)
+		for i := range inputs {
+			inputs[i] = pMinus1
+		}
+		v, err := multiHashVector(fn, fmt.Sprintf("p-1-%d", n), "", pMinus1, inputs)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+func hashVector(name, label string, domain fr.Element, inputs []fr.Element) (Vector, error) {
+	out, err := poseidon.Hash(domain, inputs...)
+	if err != nil {
+		return Vector{}, err
+	}
+	return newVector(name, label, domain, inputs, out), nil
+}
+
+func multiHashVector(fn multiHashFunc, name, label string, domain fr.Element, inputs []fr.Element) (Vector, error) {
+	out, err := fn(domain, inputs...)
+	if err != nil {
+		return Vector{}, err
+	}
+	return newVector(name, label, domain, inputs, out), nil
+}
+
+func newVector(name, label string, domain fr.Element, inputs []fr.Element, out fr.Element) Vector {
+	v := Vector{
+		Name:        name,
+		DomainLabel: label,
+		Domain:      decimal(domain),
+		Inputs:      make([]string, len(inputs)),
+		Output:      decimal(out),
+	}
+	for i := range inputs {
+		v.Inputs[i] = decimal(inputs[i])
+	}
+	return v
+}
+
+// decimal avoids fr.Element.String, which prints values close to r as small negatives.
+func decimal(e fr.Element) string {
+	return e.BigInt(new(big.Int)).String()
+}
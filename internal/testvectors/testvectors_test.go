@@ -0,0 +1,205 @@
+package testvectors
+
+import (
+	"bytes"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+
+	poseidon "github.com/vocdoni/poseidon377"
+	emposeidon "github.com/vocdoni/poseidon377/gnark/emulated/poseidon377"
+	gposeidon "github.com/vocdoni/poseidon377/gnark/poseidon377"
+)
+
+const suitePath = "../../testdata/vectors.json"
+
+func loadSuite(t *testing.T) *Suite {
+	t.Helper()
+	f, err := os.Open(suitePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	s, err := Load(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestSuiteUpToDate(t *testing.T) {
+	committed, err := os.ReadFile(suitePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := Write(&buf, s); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), committed) {
+		t.Fatalf("testdata/vectors.json is stale, run `go generate ./internal/testvectors`")
+	}
+}
+
+func TestNative(t *testing.T) {
+	s := loadSuite(t)
+	check := func(v Vector, fn func(fr.Element, ...fr.Element) (fr.Element, error)) {
+		domain, inputs, expected, err := v.Elements()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.DomainLabel != "" {
+			if labelled := poseidon.DomainFromLEBytes([]byte(v.DomainLabel)); !labelled.Equal(&domain) {
+				t.Fatalf("%s: domain label does not match domain", v.Name)
+			}
+		}
+		out, err := fn(domain, inputs...)
+		if err != nil {
+			t.Fatalf("%s: %v", v.Name, err)
+		}
+		if !out.Equal(&expected) {
+			t.Fatalf("%s mismatch\nexpected %s\ngot      %s", v.Name, expected.String(), out.String())
+		}
+	}
+	for _, v := range s.Hash {
+		check(v, poseidon.Hash)
+	}
+	for _, v := range s.MultiHash {
+		check(v, poseidon.MultiHash)
+	}
+	for _, v := range s.MultiHashV2 {
+		check(v, poseidon.MultiHashV2)
+	}
+}
+
+// kind selects the function under test; circuits cannot hold func fields (the test engine clones them).
+type kind int
+
+const (
+	kindHash kind = iota
+	kindMultiHash
+	kindMultiHashV2
+)
+
+type gnarkCircuit struct {
+	Domain   frontend.Variable
+	Inputs   []frontend.Variable
+	Expected frontend.Variable `gnark:",public"`
+
+	kind kind
+}
+
+func (c *gnarkCircuit) Define(api frontend.API) error {
+	hash := gposeidon.Hash
+	switch c.kind {
+	case kindMultiHash:
+		hash = gposeidon.MultiHash
+	case kindMultiHashV2:
+		hash = gposeidon.MultiHashV2
+	}
+	out, err := hash(api, c.Domain, c.Inputs...)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(out, c.Expected)
+	return nil
+}
+
+func TestGnark(t *testing.T) {
+	s := loadSuite(t)
+	run := func(v Vector, k kind) {
+		domain, inputs, expected, err := v.Elements()
+		if err != nil {
+			t.Fatal(err)
+		}
+		witness := &gnarkCircuit{Domain: domain, Inputs: make([]frontend.Variable, len(inputs)), Expected: expected}
+		for i := range inputs {
+			witness.Inputs[i] = inputs[i]
+		}
+		circuit := &gnarkCircuit{Inputs: make([]frontend.Variable, len(inputs)), kind: k}
+		if err := test.IsSolved(circuit, witness, ecc.BLS12_377.ScalarField()); err != nil {
+			t.Fatalf("%s: %v", v.Name, err)
+		}
+	}
+	for _, v := range s.Hash {
+		run(v, kindHash)
+	}
+	for _, v := range s.MultiHash {
+		run(v, kindMultiHash)
+	}
+	for _, v := range s.MultiHashV2 {
+		run(v, kindMultiHashV2)
+	}
+}
+
+type emulatedCircuit struct {
+	Domain   emulated.Element[emposeidon.FrParams]
+	Inputs   []emulated.Element[emposeidon.FrParams]
+	Expected emulated.Element[emposeidon.FrParams] `gnark:",public"`
+
+	kind kind
+}
+
+func (c *emulatedCircuit) Define(api frontend.API) error {
+	field, err := emulated.NewField[emposeidon.FrParams](api)
+	if err != nil {
+		return err
+	}
+	hash := emposeidon.Hash
+	switch c.kind {
+	case kindMultiHash:
+		hash = emposeidon.MultiHash
+	case kindMultiHashV2:
+		hash = emposeidon.MultiHashV2
+	}
+	out, err := hash(api, c.Domain, c.Inputs...)
+	if err != nil {
+		return err
+	}
+	field.AssertIsEqual(&out, &c.Expected)
+	return nil
+}
+
+func TestEmulated(t *testing.T) {
+	s := loadSuite(t)
+	valueOf := func(e fr.Element) emulated.Element[emposeidon.FrParams] {
+		return emulated.ValueOf[emposeidon.FrParams](e.BigInt(new(big.Int)))
+	}
+	run := func(v Vector, k kind) {
+		domain, inputs, expected, err := v.Elements()
+		if err != nil {
+			t.Fatal(err)
+		}
+		witness := &emulatedCircuit{
+			Domain:   valueOf(domain),
+			Inputs:   make([]emulated.Element[emposeidon.FrParams], len(inputs)),
+			Expected: valueOf(expected),
+		}
+		for i := range inputs {
+			witness.Inputs[i] = valueOf(inputs[i])
+		}
+		circuit := &emulatedCircuit{Inputs: make([]emulated.Element[emposeidon.FrParams], len(inputs)), kind: k}
+		if err := test.IsSolved(circuit, witness, ecc.BW6_761.ScalarField()); err != nil {
+			t.Fatalf("%s: %v", v.Name, err)
+		}
+	}
+	for _, v := range s.Hash {
+		run(v, kindHash)
+	}
+	for _, v := range s.MultiHash {
+		run(v, kindMultiHash)
+	}
+	for _, v := range s.MultiHashV2 {
+		run(v, kindMultiHashV2)
+	}
+}
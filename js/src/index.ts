@@ -143,6 +143,7 @@ export class Poseidon377 {
 export async function buildPoseidon(singleThread: boolean = false): Promise<{
     hash: (inputs: any[], domain?: any) => any;
     multiHash: (inputs: any[], domain?: any) => any;
+    multiHashV2: (inputs: any[], domain?: any) => any;
     F: any;
     domainFromLEBytes: (data: Uint8Array) => any;
 }> {
@@ -155,6 +156,9 @@ export async function buildPoseidon(singleThread: boolean = false): Promise<{
         instances[r] = new Poseidon377(F, r);
     }
 
+    // Capacity of the hash that derives the MultiHashV2 root capacity (docs/multihash.md).
+    const lengthDomain = domainFromLEBytes(new TextEncoder().encode("poseidon377.multihash.v2"));
+
     function hash(inputs: any[], domain: any = 0) {
         const rate = inputs.length;
         if (rate < 1 || rate > 7) {
@@ -187,6 +191,34 @@ export async function buildPoseidon(singleThread: boolean = false): Promise<{
         return currentLevel[0];
     }
 
+    // Length-binding tree (see docs/multihash.md): same chunking as multiHash, but the root chunk
+    // uses capacity hash([domain, len(inputs)], lengthDomain). Empty and oversized inputs are
+    // rejected as in Go.
+    function multiHashV2(inputs: any[], domain: any = 0) {
+        const maxRate = 7;
+        const maxInputs = 256;
+        if (inputs.length === 0) {
+            throw new Error("multiHashV2: need at least 1 input");
+        }
+        if (inputs.length > maxInputs) {
+            throw new Error(`multiHashV2: too many inputs (${inputs.length} > ${maxInputs})`);
+        }
+
+        const dom = F.e(domain);
+        const rootDom = hash([dom, F.e(inputs.length)], lengthDomain);
+        let currentLevel = inputs.map(x => F.e(x));
+
+        while (currentLevel.length > maxRate) {
+            const nextLevel = [];
+            for (let i = 0; i < currentLevel.length; i += maxRate) {
+                nextLevel.push(hash(currentLevel.slice(i, i + maxRate), dom));
+            }
+            currentLevel = nextLevel;
+        }
+
+        return hash(currentLevel, rootDom);
+    }
+
     function domainFromLEBytes(data: Uint8Array): any {
         let bi = 0n;
         for (let i = 0; i < data.length; i++) {
@@ -195,5 +227,5 @@ export async function buildPoseidon(singleThread: boolean = false): Promise<{
         return F.e(bi);
     }
 
-    return { hash, multiHash, F, domainFromLEBytes };
+    return { hash, multiHash, multiHashV2, F, domainFromLEBytes };
 }
\ No newline at end of file
@@ -1,6 +1,10 @@
 import { expect } from "chai";
+import { readFileSync } from "fs";
 import { buildPoseidon } from "../src/index.js";
 
+// Shared with the Go implementation, regenerate with `go generate ./internal/testvectors`.
+const suite = JSON.parse(readFileSync(new URL("../../testdata/vectors.json", import.meta.url), "utf8"));
+
 describe("Poseidon377", function () {
     let poseidon: any;
 
@@ -48,4 +52,24 @@ describe("Poseidon377", function () {
         const expected = "5764845866250656314303187921704945420217061658264314081928253972326618949319";
         expect(poseidon.F.toString(hash, 10)).to.equal(expected);
     });
-});
\ No newline at end of file
+
+    it("should match the shared vector suite", async () => {
+        expect(suite.version).to.equal(2);
+        for (const v of suite.hash) {
+            const h = poseidon.hash(v.inputs, v.domain);
+            expect(poseidon.F.toString(h, 10)).to.equal(v.output, v.name);
+        }
+        for (const v of suite.multihash) {
+            const h = poseidon.multiHash(v.inputs, v.domain);
+            expect(poseidon.F.toString(h, 10)).to.equal(v.output, v.name);
+        }
+        for (const v of suite.multihash_v2) {
+            const h = poseidon.multiHashV2(v.inputs, v.domain);
+            expect(poseidon.F.toString(h, 10)).to.equal(v.output, v.name);
+        }
+    });
+
+    it("should reject empty multiHashV2 input", async () => {
+        expect(() => poseidon.multiHashV2([], 0)).to.throw();
+    });
+});
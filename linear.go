@@ -0,0 +1,186 @@
+package poseidon377
+
+import (
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+
+	"github.com/vocdoni/poseidon377/internal/params"
+)
+
+// linearLayer holds the per-rate tables used by the optimized permutation: the MDS matrix, the
+// M_i matrix with trivial coefficients removed, and the round constants laid out so that the
+// constants of round r+1 are added while mixing round r.
+//
+// The Cauchy MDS matrix has no zero or one entries, so a full-round mix still costs t²
+// multiplications; merging the round constants into it only removes a separate pass over the
+// state. The multiplications saved are the 2t-1 trivial entries of M_i, once per permutation.
+type linearLayer struct {
+	width int
+	mds   []fr.Element // row-major t×t
+	mi    [][]linearTerm
+}
+
+// linearTerm is a non-zero matrix coefficient; one marks coefficients equal to 1, which are
+// added without a multiplication. M_i has the form [[1, 0], [0, M']], so 2t-1 of its t² entries
+// are skipped or reduced to additions.
+type linearTerm struct {
+	col   int
+	one   bool
+	coeff fr.Element
+}
+
+var linearLayers sync.Map // *params.Parameters -> *linearLayer
+
+func linearLayerFor(p *params.Parameters) *linearLayer {
+	if l, ok := linearLayers.Load(p); ok {
+		return l.(*linearLayer)
+	}
+	l, _ := linearLayers.LoadOrStore(p, newLinearLayer(p))
+	return l.(*linearLayer)
+}
+
+func newLinearLayer(p *params.Parameters) *linearLayer {
+	t := p.StateSize
+	return &linearLayer{
+		width: t,
+		mds:   p.MDS,
+		mi:    linearTerms(p.OptimizedMDS.MI, t),
+	}
+}
+
+// linearTerms splits the row-major t×t matrix m into rows of its non-zero coefficients.
+func linearTerms(m []fr.Element, t int) [][]linearTerm {
+	var one fr.Element
+	one.SetOne()
+
+	rows := make([][]linearTerm, t)
+	for i := 0; i < t; i++ {
+		for j := 0; j < t; j++ {
+			c := m[i*t+j]
+			if c.IsZero() {
+				continue
+			}
+			rows[i] = append(rows[i], linearTerm{col: j, one: c.Equal(&one), coeff: c})
+		}
+	}
+	return rows
+}
+
+// multiplications returns the number of field multiplications one product with rows costs.
+func multiplications(rows [][]linearTerm) int {
+	n := 0
+	for _, row := range rows {
+		for k := range row {
+			if !row[k].one {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// mulTerms writes rows·state to out.
+func mulTerms(rows [][]linearTerm, state []fr.Element, out []fr.Element) {
+	for i, row := range rows {
+		var sum, prod fr.Element
+		for k := range row {
+			term := &row[k]
+			if term.one {
+				sum.Add(&sum, &state[term.col])
+				continue
+			}
+			prod.Mul(&term.coeff, &state[term.col])
+			sum.Add(&sum, &prod)
+		}
+		out[i] = sum
+	}
+}
+
+// mixMDSArc sets state = MDS·state + arc, where arc is the next round's constant row (nil for
+// the last round).
+func (l *linearLayer) mixMDSArc(state []fr.Element, arc []fr.Element) {
+	t := l.width
+	var out [maxRate + 1]fr.Element
+	for i := 0; i < t; i++ {
+		row := l.mds[i*t : (i+1)*t]
+		var sum, prod fr.Element
+		if arc != nil {
+			sum = arc[i]
+		}
+		for j := 0; j < t; j++ {
+			prod.Mul(&row[j], &state[j])
+			sum.Add(&sum, &prod)
+		}
+		out[i] = sum
+	}
+	copy(state, out[:t])
+}
+
+// mixMI sets state = M_i·state, skipping zero coefficients and multiplications by one.
+func (l *linearLayer) mixMI(state []fr.Element) {
+	t := l.width
+	var out [maxRate + 1]fr.Element
+	mulTerms(l.mi, state, out[:t])
+	copy(state, out[:t])
+}
+
+// sparseMatMulInPlace is sparseMatMul without the temporary state.
+func (p *permutation) sparseMatMulInPlace(state []fr.Element, round int) {
+	subSize := p.params.StateSize - 1
+	v := p.params.OptimizedMDS.VCollection[round*subSize : (round+1)*subSize]
+	wHat := p.params.OptimizedMDS.WHatCollection[round*subSize : (round+1)*subSize]
+
+	var newZero, prod fr.Element
+	newZero.Mul(&p.params.OptimizedMDS.M00, &state[0])
+	for i := 0; i < subSize; i++ {
+		prod.Mul(&wHat[i], &state[i+1])
+		newZero.Add(&newZero, &prod)
+
+		prod.Mul(&v[i], &state[0])
+		state[i+1].Add(&state[i+1], &prod)
+	}
+	state[0] = newZero
+}
+
+// permuteOptimized is permuteGeneric with the round constants of each full round merged into the
+// preceding MDS mix, the specialised M_i layer and no per-round allocations.
+func (p *permutation) permuteOptimized(state []fr.Element) {
+	t := p.params.StateSize
+	rF := p.params.FullRounds / 2
+	arc := p.params.OptimizedArc
+	l := p.linear
+
+	// First half of full rounds; the last mix also adds the first partial round's constants.
+	addArcRow(state, arc, 0, t)
+	for r := 0; r < rF; r++ {
+		fullSBox(state, p.params.Alpha)
+		l.mixMDSArc(state, arc[(r+1)*t:(r+2)*t])
+	}
+	round := rF
+
+	l.mixMI(state)
+
+	for r := 0; r < p.params.PartialRounds-1; r++ {
+		partialSBox(state, p.params.Alpha)
+		round++
+		state[0].Add(&state[0], &arc[round*t])
+		p.sparseMatMulInPlace(state, p.params.PartialRounds-r-1)
+	}
+
+	partialSBox(state, p.params.Alpha)
+	p.sparseMatMulInPlace(state, 0)
+	round++
+
+	// Second half of full rounds.
+	addArcRow(state, arc, round, t)
+	for r := 0; r < rF; r++ {
+		fullSBox(state, p.params.Alpha)
+		round++
+		if r == rF-1 {
+			l.mixMDSArc(state, nil)
+		} else {
+			l.mixMDSArc(state, arc[round*t:(round+1)*t])
+		}
+	}
+}
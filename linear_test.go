@@ -0,0 +1,164 @@
+package poseidon377
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+func randomState(t int) []fr.Element {
+	state := make([]fr.Element, t)
+	for i := range state {
+		state[i].MustSetRandom()
+	}
+	return state
+}
+
+func TestLinearLayerMatchesGeneric(t *testing.T) {
+	for rate := 1; rate <= maxRate; rate++ {
+		perm, err := newPermutation(rate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		width := perm.params.StateSize
+		arc := perm.params.OptimizedArc
+
+		for row := 1; row < len(arc)/width; row++ {
+			state := randomState(width)
+			expected := append([]fr.Element(nil), state...)
+			perm.mixLayerMDS(expected)
+			addArcRow(expected, arc, row, width)
+
+			perm.linear.mixMDSArc(state, arc[row*width:(row+1)*width])
+			assertStatesEqual(t, fmt.Sprintf("rate %d mds+arc row %d", rate, row), expected, state)
+		}
+
+		state := randomState(width)
+		expected := append([]fr.Element(nil), state...)
+		perm.mixLayerMDS(expected)
+		perm.linear.mixMDSArc(state, nil)
+		assertStatesEqual(t, fmt.Sprintf("rate %d mds", rate), expected, state)
+
+		state = randomState(width)
+		expected = append([]fr.Element(nil), state...)
+		perm.mixLayerMI(expected)
+		perm.linear.mixMI(state)
+		assertStatesEqual(t, fmt.Sprintf("rate %d mi", rate), expected, state)
+
+		for round := 0; round < perm.params.PartialRounds; round++ {
+			state = randomState(width)
+			expected = append([]fr.Element(nil), state...)
+			perm.sparseMatMul(expected, round)
+			perm.sparseMatMulInPlace(state, round)
+			assertStatesEqual(t, fmt.Sprintf("rate %d sparse round %d", rate, round), expected, state)
+		}
+	}
+}
+
+// The dense mix costs t² multiplications. The MDS matrix has no trivial entries, so the full
+// rounds keep all of them; the M_i table must drop every zero and one coefficient, which for
+// M_i = [[1, 0], [0, M']] leaves at most (t-1)².
+func TestLinearLayerMultiplications(t *testing.T) {
+	var one fr.Element
+	one.SetOne()
+	nontrivial := func(m []fr.Element) int {
+		n := 0
+		for i := range m {
+			if !m[i].IsZero() && !m[i].Equal(&one) {
+				n++
+			}
+		}
+		return n
+	}
+
+	for rate := 1; rate <= maxRate; rate++ {
+		perm, err := newPermutation(rate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		width := perm.params.StateSize
+		if got := nontrivial(perm.params.MDS); got != width*width {
+			t.Fatalf("rate %d: mds has %d non-trivial entries, the full-round mix assumes %d", rate, got, width*width)
+		}
+		got := multiplications(perm.linear.mi)
+		if want := nontrivial(perm.params.OptimizedMDS.MI); got != want {
+			t.Fatalf("rate %d: mi mix costs %d multiplications, want %d", rate, got, want)
+		}
+		if got > (width-1)*(width-1) {
+			t.Fatalf("rate %d: mi mix costs %d multiplications, dense mix costs %d", rate, got, width*width)
+		}
+	}
+}
+
+func TestPermuteOptimizedMatchesGeneric(t *testing.T) {
+	for rate := 1; rate <= maxRate; rate++ {
+		perm, err := newPermutation(rate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 8; i++ {
+			state := randomState(perm.params.StateSize)
+			expected := append([]fr.Element(nil), state...)
+			perm.permuteGeneric(expected)
+			perm.permuteOptimized(state)
+			assertStatesEqual(t, fmt.Sprintf("rate %d permutation", rate), expected, state)
+		}
+	}
+}
+
+func TestPermuteUnrolledMatchesGeneric(t *testing.T) {
+	for rate := 1; rate <= maxRate; rate++ {
+		perm, err := newPermutation(rate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if perm.unrolled == nil {
+			t.Fatalf("rate %d: no generated permutation", rate)
+		}
+		for i := 0; i < 8; i++ {
+			state := randomState(perm.params.StateSize)
+			expected := append([]fr.Element(nil), state...)
+			perm.permuteGeneric(expected)
+			perm.unrolled(state)
+			assertStatesEqual(t, fmt.Sprintf("rate %d unrolled permutation", rate), expected, state)
+		}
+	}
+}
+
+func assertStatesEqual(t *testing.T, name string, expected, got []fr.Element) {
+	t.Helper()
+	for i := range expected {
+		if !got[i].Equal(&expected[i]) {
+			t.Fatalf("%s: limb %d mismatch\nexpected %s\ngot      %s", name, i, expected[i].String(), got[i].String())
+		}
+	}
+}
+
+func BenchmarkPermute(b *testing.B) {
+	for rate := 1; rate <= maxRate; rate++ {
+		perm, err := newPermutation(rate)
+		if err != nil {
+			b.Fatal(err)
+		}
+		state := randomState(perm.params.StateSize)
+		b.Run(fmt.Sprintf("generic/rate=%d", rate), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				perm.permuteGeneric(state)
+			}
+		})
+		b.Run(fmt.Sprintf("optimized/rate=%d", rate), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				perm.permuteOptimized(state)
+			}
+		})
+		b.Run(fmt.Sprintf("unrolled/rate=%d", rate), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				perm.unrolled(state)
+			}
+		})
+	}
+}
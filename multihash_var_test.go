@@ -0,0 +1,127 @@
+package poseidon377
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/test"
+
+	gposeidon "github.com/vocdoni/poseidon377/gnark/poseidon377"
+)
+
+type multiVarCircuit struct {
+	Domain   frontend.Variable
+	Inputs   []frontend.Variable
+	Length   frontend.Variable
+	Expected frontend.Variable `gnark:",public"`
+
+	v2 bool
+}
+
+func (c *multiVarCircuit) Define(api frontend.API) error {
+	hash := gposeidon.MultiHashVar
+	if c.v2 {
+		hash = gposeidon.MultiHashV2Var
+	}
+	out, err := hash(api, c.Domain, c.Inputs, c.Length)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(out, c.Expected)
+	return nil
+}
+
+func TestMultiHashVarMatchesNative(t *testing.T) {
+	domain := DomainFromLEBytes([]byte("Penumbra_TestVec"))
+
+	for _, maxLen := range []int{7, 20, 60} {
+		inputs := make([]fr.Element, maxLen)
+		for i := range inputs {
+			inputs[i].SetUint64(uint64(i + 1))
+		}
+		for _, v2 := range []bool{false, true} {
+			native := MultiHash
+			if v2 {
+				native = MultiHashV2
+			}
+			for length := 1; length <= maxLen; length++ {
+				expected, err := native(domain, inputs[:length]...)
+				if err != nil {
+					t.Fatal(err)
+				}
+				witness := &multiVarCircuit{
+					Domain:   domain,
+					Inputs:   make([]frontend.Variable, maxLen),
+					Length:   length,
+					Expected: expected,
+				}
+				for i := range inputs {
+					witness.Inputs[i] = inputs[i]
+				}
+				circuit := &multiVarCircuit{Inputs: make([]frontend.Variable, maxLen), v2: v2}
+				if err := test.IsSolved(circuit, witness, ecc.BLS12_377.ScalarField()); err != nil {
+					t.Fatalf("max=%d length=%d v2=%v: %v", maxLen, length, v2, err)
+				}
+			}
+		}
+	}
+}
+
+func TestMultiHashVarRejectsLength(t *testing.T) {
+	domain := DomainFromLEBytes([]byte("Penumbra_TestVec"))
+	inputs := make([]fr.Element, 10)
+	for i := range inputs {
+		inputs[i].SetUint64(uint64(i + 1))
+	}
+	expected, err := MultiHash(domain, inputs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, length := range []int{0, 11} {
+		witness := &multiVarCircuit{
+			Domain:   domain,
+			Inputs:   make([]frontend.Variable, len(inputs)),
+			Length:   length,
+			Expected: expected,
+		}
+		for i := range inputs {
+			witness.Inputs[i] = inputs[i]
+		}
+		circuit := &multiVarCircuit{Inputs: make([]frontend.Variable, len(inputs))}
+		if err := test.IsSolved(circuit, witness, ecc.BLS12_377.ScalarField()); err == nil {
+			t.Fatalf("length %d accepted", length)
+		}
+	}
+}
+
+// expectedVarConstraints pins the r1cs cost of MultiHashVar and MultiHashV2Var per circuit
+// capacity; update it deliberately, as expectedConstraints in constraints_test.go.
+var expectedVarConstraints = map[string]int{
+	"multihash-var/inputs=16":    6598,
+	"multihash-var/inputs=64":    27106,
+	"multihash-v2-var/inputs=16": 6868,
+	"multihash-v2-var/inputs=64": 27376,
+}
+
+func TestMultiHashVarConstraints(t *testing.T) {
+	for _, v2 := range []bool{false, true} {
+		for _, n := range []int{16, 64} {
+			name := fmt.Sprintf("multihash-var/inputs=%d", n)
+			if v2 {
+				name = fmt.Sprintf("multihash-v2-var/inputs=%d", n)
+			}
+			ccs, err := frontend.Compile(ecc.BLS12_377.ScalarField(), r1cs.NewBuilder, &multiVarCircuit{Inputs: make([]frontend.Variable, n), v2: v2})
+			if err != nil {
+				t.Fatalf("compile %s: %v", name, err)
+			}
+			if got, want := ccs.GetNbConstraints(), expectedVarConstraints[name]; got != want {
+				t.Fatalf("%s: expected %d constraints, got %d", name, want, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package poseidon377
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+
+	"github.com/vocdoni/poseidon377/internal/params"
+)
+
+// Parameters is a Poseidon parameter set over the BLS12-377 scalar field. Obtain custom sets with
+// LoadParameters; the gnark gadgets accept them through HashWithParameters.
+type Parameters = params.Parameters
+
+// Hasher hashes with a parameter set loaded at runtime. It is meant for experimenting with
+// alternative instances; the package-level functions always use the built-in Penumbra sets.
+type Hasher struct {
+	params *params.Parameters
+	perm   *permutation
+}
+
+// LoadParameters reads one parameter set in the canonical JSON format written by
+// `poseidon377 params` (decimal constants, keys as in Penumbra's poseidon-parameters crate) and
+// checks it with params.Validate. Rates 1..7 with the x^17 S-box are supported.
+//
+// Validation covers the shape of the set, not its security: the caller is responsible for the
+// round numbers and for the matrices being consistent with each other.
+func LoadParameters(r io.Reader) (*Hasher, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	var c params.Canonical
+	if err := dec.Decode(&c); err != nil {
+		return nil, fmt.Errorf("poseidon377: parameters: %w", err)
+	}
+	if c.Rate < 1 || c.Rate > maxRate {
+		return nil, fmt.Errorf("poseidon377: unsupported rate %d", c.Rate)
+	}
+	p, err := c.Import()
+	if err != nil {
+		return nil, err
+	}
+	// Loaded sets get their own linear layer instead of an entry in the shared cache, and never
+	// use the generated permutations, which embed the built-in constants.
+	return &Hasher{params: p, perm: &permutation{params: p, linear: newLinearLayer(p)}}, nil
+}
+
+// Rate returns the number of inputs taken by Hash.
+func (h *Hasher) Rate() int { return h.params.StateSize - 1 }
+
+// Parameters returns the parameter set, to be passed to the gnark gadgets. It must not be
+// modified.
+func (h *Hasher) Parameters() *Parameters { return h.params }
+
+// Hash permutes [domain, inputs...] and returns state[1]. It takes exactly Rate inputs.
+func (h *Hasher) Hash(domain fr.Element, inputs ...fr.Element) (fr.Element, error) {
+	if len(inputs) != h.Rate() {
+		return fr.Element{}, fmt.Errorf("poseidon377: expected %d inputs, got %d", h.Rate(), len(inputs))
+	}
+	state := make([]fr.Element, h.params.StateSize)
+	state[0] = domain
+	copy(state[1:], inputs)
+	h.perm.permute(state)
+	return state[1], nil
+}
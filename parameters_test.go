@@ -0,0 +1,189 @@
+package poseidon377
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+
+	emposeidon "github.com/vocdoni/poseidon377/gnark/emulated/poseidon377"
+	gposeidon "github.com/vocdoni/poseidon377/gnark/poseidon377"
+	"github.com/vocdoni/poseidon377/internal/params"
+)
+
+type customParamsCircuit struct {
+	Domain   frontend.Variable
+	Inputs   []frontend.Variable
+	Expected frontend.Variable `gnark:",public"`
+
+	params *Parameters
+}
+
+func (c *customParamsCircuit) Define(api frontend.API) error {
+	out, err := gposeidon.HashWithParameters(api, c.params, c.Domain, c.Inputs...)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(out, c.Expected)
+	return nil
+}
+
+type customParamsEmulatedCircuit struct {
+	Domain   emulated.Element[emposeidon.FrParams]
+	Inputs   []emulated.Element[emposeidon.FrParams]
+	Expected emulated.Element[emposeidon.FrParams] `gnark:",public"`
+
+	params *Parameters
+}
+
+func (c *customParamsEmulatedCircuit) Define(api frontend.API) error {
+	field, err := emulated.NewField[emposeidon.FrParams](api)
+	if err != nil {
+		return err
+	}
+	out, err := emposeidon.HashWithParameters(api, c.params, c.Domain, c.Inputs...)
+	if err != nil {
+		return err
+	}
+	field.AssertIsEqual(&out, &c.Expected)
+	return nil
+}
+
+func canonicalJSON(t *testing.T, c *params.Canonical) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := c.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// checkGadget asserts that the native gadget with h's parameters reproduces h.Hash.
+func checkGadget(t *testing.T, h *Hasher, domain fr.Element, inputs []fr.Element, expected fr.Element) {
+	t.Helper()
+	assignment := &customParamsCircuit{Domain: domain, Inputs: make([]frontend.Variable, len(inputs)), Expected: expected}
+	for i := range inputs {
+		assignment.Inputs[i] = inputs[i]
+	}
+	circuit := &customParamsCircuit{Inputs: make([]frontend.Variable, len(inputs)), params: h.Parameters()}
+	if err := test.IsSolved(circuit, assignment, ecc.BLS12_377.ScalarField()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadParametersRoundTrip(t *testing.T) {
+	domain := DomainFromLEBytes([]byte("load.params"))
+	for rate := 1; rate <= maxRate; rate++ {
+		t.Run(fmt.Sprintf("rate=%d", rate), func(t *testing.T) {
+			h, err := LoadParameters(bytes.NewReader(canonicalJSON(t, params.Export(params.AllParameters[rate]))))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if h.Rate() != rate {
+				t.Fatalf("rate %d, want %d", h.Rate(), rate)
+			}
+			inputs := randomState(rate)
+			got, err := h.Hash(domain, inputs...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := Hash(domain, inputs...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(&want) {
+				t.Fatalf("loaded parameters hash to %s, built-in to %s", got.String(), want.String())
+			}
+			checkGadget(t, h, domain, inputs, want)
+		})
+	}
+}
+
+// TestLoadParametersCustom loads a set that differs from the built-in one and checks that the
+// native hasher and both gadgets agree on it.
+func TestLoadParametersCustom(t *testing.T) {
+	c := params.Export(params.AllParameters[1])
+	c.OptimizedArc[0] = "1"
+	h, err := LoadParameters(bytes.NewReader(canonicalJSON(t, c)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := DomainFromLEBytes([]byte("load.params"))
+	var x fr.Element
+	x.SetUint64(42)
+	got, err := h.Hash(domain, x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	builtin, err := Hash(domain, x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Equal(&builtin) {
+		t.Fatal("changing a round constant did not change the output")
+	}
+	checkGadget(t, h, domain, []fr.Element{x}, got)
+
+	assignment := &customParamsEmulatedCircuit{
+		Domain:   valueOf(domain),
+		Inputs:   []emulated.Element[emposeidon.FrParams]{valueOf(x)},
+		Expected: valueOf(got),
+	}
+	circuit := &customParamsEmulatedCircuit{Inputs: make([]emulated.Element[emposeidon.FrParams], 1), params: h.Parameters()}
+	if err := test.IsSolved(circuit, assignment, ecc.BW6_761.ScalarField()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadParametersErrors(t *testing.T) {
+	valid := func() map[string]any {
+		var m map[string]any
+		if err := json.Unmarshal(canonicalJSON(t, params.Export(params.AllParameters[2])), &m); err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+	cases := map[string]func(m map[string]any){
+		"unknown field":   func(m map[string]any) { m["extra"] = 1 },
+		"rate too large":  func(m map[string]any) { m["rate"], m["t"] = 8, 9 },
+		"rate mismatch":   func(m map[string]any) { m["rate"] = 1 },
+		"alpha":           func(m map[string]any) { m["alpha"] = 5 },
+		"odd full rounds": func(m map[string]any) { m["full_rounds"] = 7 },
+		"short mds":       func(m map[string]any) { m["mds"] = m["mds"].([]any)[1:] },
+		"non-canonical": func(m map[string]any) {
+			m["mds"].([]any)[0] = fr.Modulus().String()
+		},
+		"not a number": func(m map[string]any) {
+			m["optimized_mds"].(map[string]any)["M00"] = "0xzz"
+		},
+	}
+	for name, mutate := range cases {
+		m := valid()
+		mutate(m)
+		data, err := json.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadParameters(bytes.NewReader(data)); err == nil {
+			t.Fatalf("%s: expected an error", name)
+		}
+	}
+	if _, err := LoadParameters(strings.NewReader("{")); err == nil {
+		t.Fatal("expected an error for truncated JSON")
+	}
+
+	h, err := LoadParameters(bytes.NewReader(canonicalJSON(t, params.Export(params.AllParameters[2]))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.Hash(fr.Element{}, fr.Element{}); err == nil {
+		t.Fatal("expected an error for the wrong number of inputs")
+	}
+}
@@ -0,0 +1,85 @@
+// Package penumbra reproduces the Poseidon-based derivations of the Penumbra protocol
+// (note commitments, nullifiers, value generator inputs and tiered commitment tree hashes).
+//
+// Domain separators follow Penumbra: Fq::from_le_bytes_mod_order(blake2b(label)), with the
+// 64-byte BLAKE2b digest reduced through poseidon377.DomainFromLEBytes. Curve operations on
+// decaf377 (diversified generators, balance commitment points) are out of scope: callers
+// pass the already-compressed field elements. Balance commitments are therefore not computed;
+// ValueGeneratorInput is their only Poseidon step.
+//
+// The derivations have not been checked against known-answer vectors from Penumbra's Rust
+// implementation yet; see the README.
+package penumbra
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"golang.org/x/crypto/blake2b"
+
+	poseidon "github.com/vocdoni/poseidon377"
+)
+
+var (
+	// NoteCommitDomain separates note commitments (hash_6).
+	NoteCommitDomain = domainSep("penumbra.notecommit")
+	// NullifierDomain separates nullifier derivation (hash_3).
+	NullifierDomain = domainSep("penumbra.nullifier")
+	// ValueGeneratorDomain separates the asset-specific value generator input (hash_1).
+	ValueGeneratorDomain = domainSep("penumbra.value.generator")
+	// TCTDomain separates leaf and node hashes of the tiered commitment tree.
+	TCTDomain = domainSep("penumbra.tct")
+)
+
+func domainSep(label string) fr.Element {
+	sum := blake2b.Sum512([]byte(label))
+	return poseidon.DomainFromLEBytes(sum[:])
+}
+
+// Note holds the field-element view of a Penumbra note as absorbed by the note commitment.
+type Note struct {
+	Blinding             fr.Element // note blinding derived from the rseed
+	Amount               fr.Element // u128 amount lifted into Fq
+	AssetID              fr.Element
+	DiversifiedGenerator fr.Element // B_d.vartime_compress_to_field()
+	TransmissionKeyS     fr.Element // s-coordinate of the transmission key
+	ClueKey              [32]byte
+}
+
+// NoteCommitment computes hash_6(NoteCommitDomain, blinding, amount, asset_id, B_d, pk_d_s, ck_d).
+func NoteCommitment(n Note) (fr.Element, error) {
+	clueKey := poseidon.DomainFromLEBytes(n.ClueKey[:])
+	return poseidon.Hash6(NoteCommitDomain,
+		n.Blinding,
+		n.Amount,
+		n.AssetID,
+		n.DiversifiedGenerator,
+		n.TransmissionKeyS,
+		clueKey,
+	)
+}
+
+// Nullifier computes hash_3(NullifierDomain, nk, commitment, position).
+func Nullifier(nk fr.Element, position uint64, commitment fr.Element) (fr.Element, error) {
+	var pos fr.Element
+	pos.SetUint64(position)
+	return poseidon.Hash3(NullifierDomain, nk, commitment, pos)
+}
+
+// ValueGeneratorInput computes hash_1(ValueGeneratorDomain, asset_id), the field element that
+// Penumbra maps to the curve with encode_to_curve to obtain the asset value generator used in
+// balance commitments.
+func ValueGeneratorInput(assetID fr.Element) (fr.Element, error) {
+	return poseidon.Hash1(ValueGeneratorDomain, assetID)
+}
+
+// TCTLeaf computes the tiered commitment tree leaf hash hash_1(TCTDomain, commitment).
+func TCTLeaf(commitment fr.Element) (fr.Element, error) {
+	return poseidon.Hash1(TCTDomain, commitment)
+}
+
+// TCTNode computes the tiered commitment tree node hash hash_4(TCTDomain + height, a, b, c, d).
+func TCTNode(height uint8, a, b, c, d fr.Element) (fr.Element, error) {
+	var domain fr.Element
+	domain.SetUint64(uint64(height))
+	domain.Add(&domain, &TCTDomain)
+	return poseidon.Hash4(domain, a, b, c, d)
+}
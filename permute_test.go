@@ -0,0 +1,249 @@
+package poseidon377
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+
+	emposeidon "github.com/vocdoni/poseidon377/gnark/emulated/poseidon377"
+	gposeidon "github.com/vocdoni/poseidon377/gnark/poseidon377"
+	"github.com/vocdoni/poseidon377/reference"
+)
+
+func TestPermuteMatchesHashAndReference(t *testing.T) {
+	for rate := 1; rate <= maxRate; rate++ {
+		state := randomState(rate + 1)
+		in := append([]fr.Element(nil), state...)
+		if err := Permute(rate, state); err != nil {
+			t.Fatal(err)
+		}
+		h, err := Hash(in[0], in[1:]...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !state[1].Equal(&h) {
+			t.Fatalf("rate %d: state[1] %s, Hash %s", rate, state[1].String(), h.String())
+		}
+
+		p, err := reference.ParametersFor(rate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bigIn := make([]*big.Int, len(in))
+		for i := range in {
+			bigIn[i] = in[i].BigInt(new(big.Int))
+		}
+		want, err := p.Permute(bigIn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range state {
+			if state[i].BigInt(new(big.Int)).Cmp(want[i]) != 0 {
+				t.Fatalf("rate %d limb %d: %s, reference %s", rate, i, state[i].String(), want[i])
+			}
+		}
+	}
+}
+
+func TestPermuteErrors(t *testing.T) {
+	if err := Permute(0, make([]fr.Element, 1)); err == nil {
+		t.Fatal("expected an error for rate 0")
+	}
+	if err := Permute(maxRate+1, make([]fr.Element, maxRate+2)); err == nil {
+		t.Fatal("expected an error for an unsupported rate")
+	}
+	if err := Permute(2, make([]fr.Element, 2)); err == nil {
+		t.Fatal("expected an error for a short state")
+	}
+}
+
+type permuteCircuit struct {
+	State    []frontend.Variable
+	Expected []frontend.Variable `gnark:",public"`
+}
+
+func (c *permuteCircuit) Define(api frontend.API) error {
+	out, err := gposeidon.Permute(api, c.State)
+	if err != nil {
+		return err
+	}
+	for i := range out {
+		api.AssertIsEqual(out[i], c.Expected[i])
+	}
+	return nil
+}
+
+func TestCircuitPermuteMatchesNative(t *testing.T) {
+	for rate := 1; rate <= maxRate; rate++ {
+		t.Run(fmt.Sprintf("rate=%d", rate), func(t *testing.T) {
+			in := randomState(rate + 1)
+			out := append([]fr.Element(nil), in...)
+			if err := Permute(rate, out); err != nil {
+				t.Fatal(err)
+			}
+			assignment := &permuteCircuit{State: make([]frontend.Variable, rate+1), Expected: make([]frontend.Variable, rate+1)}
+			for i := range in {
+				assignment.State[i], assignment.Expected[i] = in[i], out[i]
+			}
+			circuit := &permuteCircuit{State: make([]frontend.Variable, rate+1), Expected: make([]frontend.Variable, rate+1)}
+			if err := test.IsSolved(circuit, assignment, ecc.BLS12_377.ScalarField()); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+type emuPermuteCircuit struct {
+	State    []emulated.Element[emposeidon.FrParams]
+	Expected []emulated.Element[emposeidon.FrParams] `gnark:",public"`
+}
+
+func (c *emuPermuteCircuit) Define(api frontend.API) error {
+	field, err := emulated.NewField[emposeidon.FrParams](api)
+	if err != nil {
+		return err
+	}
+	out, err := emposeidon.Permute(api, c.State)
+	if err != nil {
+		return err
+	}
+	for i := range out {
+		field.AssertIsEqual(&out[i], &c.Expected[i])
+	}
+	return nil
+}
+
+func TestEmulatedPermuteMatchesNative(t *testing.T) {
+	in := randomState(2)
+	out := append([]fr.Element(nil), in...)
+	if err := Permute(1, out); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &emuPermuteCircuit{
+		State:    []emulated.Element[emposeidon.FrParams]{valueOf(in[0]), valueOf(in[1])},
+		Expected: []emulated.Element[emposeidon.FrParams]{valueOf(out[0]), valueOf(out[1])},
+	}
+	circuit := &emuPermuteCircuit{
+		State:    make([]emulated.Element[emposeidon.FrParams], 2),
+		Expected: make([]emulated.Element[emposeidon.FrParams], 2),
+	}
+	if err := test.IsSolved(circuit, assignment, ecc.BW6_761.ScalarField()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHashNMatchesPermute(t *testing.T) {
+	domain := DomainFromLEBytes([]byte("hashn"))
+	for rate := 1; rate <= maxRate; rate++ {
+		inputs := randomState(rate)
+		state := append([]fr.Element{domain}, inputs...)
+		if err := Permute(rate, state); err != nil {
+			t.Fatal(err)
+		}
+		for outputs := 1; outputs <= rate; outputs++ {
+			got, err := HashN(domain, outputs, inputs...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != outputs {
+				t.Fatalf("rate %d: %d outputs, want %d", rate, len(got), outputs)
+			}
+			for i := range got {
+				if !got[i].Equal(&state[1+i]) {
+					t.Fatalf("rate %d outputs %d: limb %d differs", rate, outputs, i)
+				}
+			}
+		}
+	}
+	if _, err := HashN(domain, 0, fr.Element{}); err == nil {
+		t.Fatal("expected an error for zero outputs")
+	}
+	if _, err := HashN(domain, 3, fr.Element{}, fr.Element{}); err == nil {
+		t.Fatal("expected an error for more outputs than inputs")
+	}
+}
+
+type hashNCircuit struct {
+	Domain   frontend.Variable
+	Inputs   []frontend.Variable
+	Expected []frontend.Variable `gnark:",public"`
+}
+
+func (c *hashNCircuit) Define(api frontend.API) error {
+	out, err := gposeidon.HashN(api, c.Domain, len(c.Expected), c.Inputs...)
+	if err != nil {
+		return err
+	}
+	for i := range out {
+		api.AssertIsEqual(out[i], c.Expected[i])
+	}
+	return nil
+}
+
+func TestCircuitHashNMatchesNative(t *testing.T) {
+	domain := DomainFromLEBytes([]byte("hashn"))
+	inputs := randomState(4)
+	want, err := HashN(domain, 3, inputs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assignment := &hashNCircuit{Domain: domain, Inputs: make([]frontend.Variable, 4), Expected: make([]frontend.Variable, 3)}
+	for i := range inputs {
+		assignment.Inputs[i] = inputs[i]
+	}
+	for i := range want {
+		assignment.Expected[i] = want[i]
+	}
+	circuit := &hashNCircuit{Inputs: make([]frontend.Variable, 4), Expected: make([]frontend.Variable, 3)}
+	if err := test.IsSolved(circuit, assignment, ecc.BLS12_377.ScalarField()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+type emuHashNCircuit struct {
+	Domain   emulated.Element[emposeidon.FrParams]
+	Inputs   []emulated.Element[emposeidon.FrParams]
+	Expected []emulated.Element[emposeidon.FrParams] `gnark:",public"`
+}
+
+func (c *emuHashNCircuit) Define(api frontend.API) error {
+	field, err := emulated.NewField[emposeidon.FrParams](api)
+	if err != nil {
+		return err
+	}
+	out, err := emposeidon.HashN(api, c.Domain, len(c.Expected), c.Inputs...)
+	if err != nil {
+		return err
+	}
+	for i := range out {
+		field.AssertIsEqual(&out[i], &c.Expected[i])
+	}
+	return nil
+}
+
+func TestEmulatedHashNMatchesNative(t *testing.T) {
+	domain := DomainFromLEBytes([]byte("hashn"))
+	inputs := randomState(2)
+	want, err := HashN(domain, 2, inputs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assignment := &emuHashNCircuit{
+		Domain:   valueOf(domain),
+		Inputs:   []emulated.Element[emposeidon.FrParams]{valueOf(inputs[0]), valueOf(inputs[1])},
+		Expected: []emulated.Element[emposeidon.FrParams]{valueOf(want[0]), valueOf(want[1])},
+	}
+	circuit := &emuHashNCircuit{
+		Inputs:   make([]emulated.Element[emposeidon.FrParams], 2),
+		Expected: make([]emulated.Element[emposeidon.FrParams], 2),
+	}
+	if err := test.IsSolved(circuit, assignment, ecc.BW6_761.ScalarField()); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,3585 @@
+// Code generated by internal/cmd/genpermute. DO NOT EDIT.
+
+package poseidon377
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+
+	"github.com/vocdoni/poseidon377/internal/params"
+)
+
+// unrolledElements parses the canonical decimal constants below at init.
+func unrolledElements(ss ...string) []fr.Element {
+	es, err := params.Elements(ss)
+	if err != nil {
+		panic(err)
+	}
+	return es
+}
+
+// unrolledPermutations maps each built-in rate to its generated permutation.
+var unrolledPermutations = map[int]func([]fr.Element){
+	1: func(s []fr.Element) { permute1((*[2]fr.Element)(s)) },
+	2: func(s []fr.Element) { permute2((*[3]fr.Element)(s)) },
+	3: func(s []fr.Element) { permute3((*[4]fr.Element)(s)) },
+	4: func(s []fr.Element) { permute4((*[5]fr.Element)(s)) },
+	5: func(s []fr.Element) { permute5((*[6]fr.Element)(s)) },
+	6: func(s []fr.Element) { permute6((*[7]fr.Element)(s)) },
+	7: func(s []fr.Element) { permute7((*[8]fr.Element)(s)) },
+}
+
+var permute1Constants = struct {
+	arcFirst   [5][2]fr.Element // rows 0..4: first full rounds and first partial round
+	arcPartial [30]fr.Element   // state[0] constant of partial rounds 2..31
+	arcLast    [4][2]fr.Element // last full rounds
+	mds, mi    [2][2]fr.Element
+	v, wHat    [31][1]fr.Element // sparse matrices of partial rounds 1..31
+	m00        fr.Element
+}{
+	arcFirst: [5][2]fr.Element{
+		[2]fr.Element(unrolledElements("5966135783811619788946141430599740285333890476176239487053701755282483259229", "8248386181461625724624937953920458307783934991095828229311948662109703846229")),
+		[2]fr.Element(unrolledElements("7899697948171006775608679894258092311173776732034376699480766172940143332902", "6159903727631813124658505727742008686518139735306098276802832804806992180150")),
+		[2]fr.Element(unrolledElements("6603147245625769955556264626336290925453294494417468042132447023388340299439", "5452037251482288884459408199998243903142580394917869276146144672081622843033")),
+		[2]fr.Element(unrolledElements("2115708492564482930864434704366485100751738113026748722601682745504297806604", "6133269380405971624163032902959596862025161741886995971748777100528132299512")),
+		[2]fr.Element(unrolledElements("1721373298675722117940847155120516423706154044622883045243024016434015697761", "3724565236254516963714184402682376811075312201052785286045653335186936485632")),
+	},
+	arcPartial: [30]fr.Element(unrolledElements("2993926950520793195583012275961325592891236008388086182862520577566512461340", "4844336353504503214469870071526764174731682540454932633018435766858296839637", "2919936031876724957775716111440945766268285492401054580426406992584542459655", "5663978257662262191024807546064736467552196158340325666330925183424594651456", "7448085674097568817272567262403479077043705287324313131373550460383625800172", "1844007775807385366821253113621394119303187242979498570585202448625529672973", "2845375890334376795538685909086245364409288104637072625430989733829905037688", "2964240408032926723856827507283082421025208343378464261229028275456762781251", "230161891578056211559550657807190773174538079462586673639444384125685358297", "2306877002508697181182344810930603763199115753709702823703230141504824250182", "2123836318329453752354985637812824046744828725960282708932659514095027388057", "7013570417631164963729263595330853907104728572996870230906776016200534001914", "5000764729360823861687973987151560403669602887880054408060477297803844381647", "3688012183046632864327681795121813640887482502925323142608857342284872442386", "3146585007675813590062491760536779565347117627054638296154414219427913984786", "7676678864432675901546415291553481115538823096046457940480569006553249093660", "7596645725911019119226480160286640258958855561482821460006382366175556000662", "2481820329715367246078919596300531087379530611060385987231004758392982276094", "8195798223330388456752391304154387344999317395184697681713352179787447701761", "2950101088303282476911765535847716840405588191771345438270963768698720082622", "6089942152437650111763694671952061709483390843818617937525773543116658480118", "968559139731456981305865940073176476284992478108629426225462874517877315869", "4221404144830957915518954956743898127154116380416977979695345847323516205035", "4704501190321898794037565838019368758488215190375880308834609880983882485439", "1528540211343567129336669730723574951290951849444879952791957047609443553699", "3615509814166558686447593855787063258143369710625250820322153815463017494590", "198306532808076479412426644626589954978557884790678002247412851514091644371", "5853689647732500931296921164824864754078960696720787779482381563682376644191", "1960866047741380343536614319063463424777078726178059991360525871235725003606", "3774777296033242440576566454498384754098369995505408300123039122605053797021")),
+	arcLast: [4][2]fr.Element{
+		[2]fr.Element(unrolledElements("4040004656752387138938977569185095852881985565015580941465394860026553994036", "1901308305061675634226724460540357576851952962685703065906892481358446930441")),
+		[2]fr.Element(unrolledElements("2686273196094412130593768635962284067098909691815336855637195251162980171156", "1123632222954904617550891255304448941562187156316217306121520482993732652419")),
+		[2]fr.Element(unrolledElements("2124462098522865366594185315832211935844567922720223671250422450277331767386", "3968009204129455601037289639721574073403531972013488960906721718883864047886")),
+		[2]fr.Element(unrolledElements("1041450153030980593059805000659658173285263988288148401600145800517153364524", "7964222143364674108039807011723483055868039993122214029137966682187499721189")),
+	},
+	mds: [2][2]fr.Element{
+		[2]fr.Element(unrolledElements("4222230874714185212124412469390773265687949667577031913967616727958704619521", "5629641166285580282832549959187697687583932890102709218623488970611606159361")),
+		[2]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970611606159361", "6333346312071277818186618704086159898531924501365547870951425091938056929281")),
+	},
+	mi: [2][2]fr.Element{
+		[2]fr.Element(unrolledElements("1", "0")),
+		[2]fr.Element(unrolledElements("0", "1328466806588844283355748993677225123218781175064554027603160865731566895100")),
+	},
+	v: [31][1]fr.Element{
+		[1]fr.Element(unrolledElements("4586109658594582519223940304496815674750341345137426646115958972947892273147")),
+		[1]fr.Element(unrolledElements("1455515135521589228398111340424169636249566710241578928593368979956750614506")),
+		[1]fr.Element(unrolledElements("5822060542086356913592445361696678544998266840966315714373475919827002458024")),
+		[1]fr.Element(unrolledElements("6399318669488686805872131569223621117241268693557135201623436767473191354014")),
+		[1]fr.Element(unrolledElements("263889429669635950742051460549844874837376768766349322688046702140537698933")),
+		[1]fr.Element(unrolledElements("1055557718678543802968205842199379499349507075065397290752186808562150795732")),
+		[1]fr.Element(unrolledElements("4222230874714175211872823368797517997398028300261589163008747234248603182928")),
+		[1]fr.Element(unrolledElements("8444461749428330423242468536408525458216213865892292824099755481077003492671")),
+		[1]fr.Element(unrolledElements("8444461749428210420223399329289462238737157458106979812593321556555786253561")),
+		[1]fr.Element(unrolledElements("8444461749427730408147122500813209360820931826965727766567585858470917297121")),
+		[1]fr.Element(unrolledElements("8444461749425810359842015186908197849156029302400719582464643066131441471361")),
+		[1]fr.Element(unrolledElements("8444461749418130166621585931288151802496419204140686846052871896773538168321")),
+		[1]fr.Element(unrolledElements("8444461749387409393739868908807967615857978811100555900405787219341924956161")),
+		[1]fr.Element(unrolledElements("8444461749264526302213000818887230869304217238940032117817448509615472107521")),
+		[1]fr.Element(unrolledElements("8444461748772993936105528459204283883089170950297936987464093670709660712961")),
+		[1]fr.Element(unrolledElements("8444461746806864471675639020472495938228985795729556466050674315086415134721")),
+		[1]fr.Element(unrolledElements("8444461738942346613956081265545344158788245177456034380396996892593432821761")),
+		[1]fr.Element(unrolledElements("8444461707484275183077850245836737041025282704361946037782287202621503569921")),
+		[1]fr.Element(unrolledElements("8444461581651989459564926167002308569973432811985592667323448442733786562561")),
+		[1]fr.Element(unrolledElements("8444461078322846565513229851664594685766033242480179185488093403182918533121")),
+		[1]fr.Element(unrolledElements("8444459065006274989306444590313739148936434964458525258146673244979446415361")),
+		[1]fr.Element(unrolledElements("8444451011739988684479303544910317001618041852371909548780992612165557944321")),
+		[1]fr.Element(unrolledElements("8444418798674843465170739363296628412344469404025446711318270080910004060161")),
+		[1]fr.Element(unrolledElements("8444289946414262587936482636841874055250179610639595361467379955887788523521")),
+		[1]fr.Element(unrolledElements("8443774537371939078999455731022856626873020437096189962063819455798926376961")),
+		[1]fr.Element(unrolledElements("8441712901202645043251348107746786913364383742922568364449577455443477790721")),
+		[1]fr.Element(unrolledElements("8433466356525468900258917614642508059329836966228081973992609454021683445761")),
+		[1]fr.Element(unrolledElements("8400480177816764328289195642225392643191649859450136412164737448334506065921")),
+		[1]fr.Element(unrolledElements("8268535462981946040410307752556930978638901432338354164853249425585796546561")),
+		[1]fr.Element(unrolledElements("7740756603642672888894756193883084320427907723891225175607297334590958469121")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970611606159361")),
+	},
+	wHat: [31][1]fr.Element{
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218625026199284415288662")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623873277779808441686")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623585047403656729942")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623512989809618802006")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623494975411109320022")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623490471811481949526")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623489345911575106902")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623489064436598396246")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488994067854218582")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488976475668174166")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488972077621663062")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970978110035286")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970703232128342")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970634512651606")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970617332782422")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970613037815126")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970611964073302")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970611695637846")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970611628528982")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970611611751766")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970611607557462")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970611606508886")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970611606246742")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970611606181206")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970611606164822")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970611606160726")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970611606159702")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970611606159446")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970611606159382")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970611606159366")),
+		[1]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970611606159362")),
+	},
+	m00: unrolledElements("4222230874714185212124412469390773265687949667577031913967616727958704619521")[0],
+}
+
+// permute1 is the rate-1 permutation (width 2).
+func permute1(s *[2]fr.Element) {
+	k := &permute1Constants
+
+	// Full round 1.
+	s[0].Add(&s[0], &k.arcFirst[0][0])
+	s[1].Add(&s[1], &k.arcFirst[0][1])
+	exp17(&s[0])
+	exp17(&s[1])
+	permute1MDS(s)
+
+	// Full round 2.
+	s[0].Add(&s[0], &k.arcFirst[1][0])
+	s[1].Add(&s[1], &k.arcFirst[1][1])
+	exp17(&s[0])
+	exp17(&s[1])
+	permute1MDS(s)
+
+	// Full round 3.
+	s[0].Add(&s[0], &k.arcFirst[2][0])
+	s[1].Add(&s[1], &k.arcFirst[2][1])
+	exp17(&s[0])
+	exp17(&s[1])
+	permute1MDS(s)
+
+	// Full round 4.
+	s[0].Add(&s[0], &k.arcFirst[3][0])
+	s[1].Add(&s[1], &k.arcFirst[3][1])
+	exp17(&s[0])
+	exp17(&s[1])
+	permute1MDS(s)
+
+	// First partial round: the constants of row 4 go through M_i.
+	s[0].Add(&s[0], &k.arcFirst[4][0])
+	s[1].Add(&s[1], &k.arcFirst[4][1])
+	permute1MI(s)
+
+	// Partial round 1.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[0])
+	permute1Sparse(s, &k.v[0], &k.wHat[0])
+
+	// Partial round 2.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[1])
+	permute1Sparse(s, &k.v[1], &k.wHat[1])
+
+	// Partial round 3.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[2])
+	permute1Sparse(s, &k.v[2], &k.wHat[2])
+
+	// Partial round 4.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[3])
+	permute1Sparse(s, &k.v[3], &k.wHat[3])
+
+	// Partial round 5.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[4])
+	permute1Sparse(s, &k.v[4], &k.wHat[4])
+
+	// Partial round 6.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[5])
+	permute1Sparse(s, &k.v[5], &k.wHat[5])
+
+	// Partial round 7.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[6])
+	permute1Sparse(s, &k.v[6], &k.wHat[6])
+
+	// Partial round 8.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[7])
+	permute1Sparse(s, &k.v[7], &k.wHat[7])
+
+	// Partial round 9.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[8])
+	permute1Sparse(s, &k.v[8], &k.wHat[8])
+
+	// Partial round 10.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[9])
+	permute1Sparse(s, &k.v[9], &k.wHat[9])
+
+	// Partial round 11.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[10])
+	permute1Sparse(s, &k.v[10], &k.wHat[10])
+
+	// Partial round 12.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[11])
+	permute1Sparse(s, &k.v[11], &k.wHat[11])
+
+	// Partial round 13.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[12])
+	permute1Sparse(s, &k.v[12], &k.wHat[12])
+
+	// Partial round 14.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[13])
+	permute1Sparse(s, &k.v[13], &k.wHat[13])
+
+	// Partial round 15.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[14])
+	permute1Sparse(s, &k.v[14], &k.wHat[14])
+
+	// Partial round 16.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[15])
+	permute1Sparse(s, &k.v[15], &k.wHat[15])
+
+	// Partial round 17.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[16])
+	permute1Sparse(s, &k.v[16], &k.wHat[16])
+
+	// Partial round 18.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[17])
+	permute1Sparse(s, &k.v[17], &k.wHat[17])
+
+	// Partial round 19.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[18])
+	permute1Sparse(s, &k.v[18], &k.wHat[18])
+
+	// Partial round 20.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[19])
+	permute1Sparse(s, &k.v[19], &k.wHat[19])
+
+	// Partial round 21.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[20])
+	permute1Sparse(s, &k.v[20], &k.wHat[20])
+
+	// Partial round 22.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[21])
+	permute1Sparse(s, &k.v[21], &k.wHat[21])
+
+	// Partial round 23.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[22])
+	permute1Sparse(s, &k.v[22], &k.wHat[22])
+
+	// Partial round 24.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[23])
+	permute1Sparse(s, &k.v[23], &k.wHat[23])
+
+	// Partial round 25.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[24])
+	permute1Sparse(s, &k.v[24], &k.wHat[24])
+
+	// Partial round 26.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[25])
+	permute1Sparse(s, &k.v[25], &k.wHat[25])
+
+	// Partial round 27.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[26])
+	permute1Sparse(s, &k.v[26], &k.wHat[26])
+
+	// Partial round 28.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[27])
+	permute1Sparse(s, &k.v[27], &k.wHat[27])
+
+	// Partial round 29.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[28])
+	permute1Sparse(s, &k.v[28], &k.wHat[28])
+
+	// Partial round 30.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[29])
+	permute1Sparse(s, &k.v[29], &k.wHat[29])
+
+	// Partial round 31.
+	exp17(&s[0])
+	permute1Sparse(s, &k.v[30], &k.wHat[30])
+
+	// Full round 5.
+	s[0].Add(&s[0], &k.arcLast[0][0])
+	s[1].Add(&s[1], &k.arcLast[0][1])
+	exp17(&s[0])
+	exp17(&s[1])
+	permute1MDS(s)
+
+	// Full round 6.
+	s[0].Add(&s[0], &k.arcLast[1][0])
+	s[1].Add(&s[1], &k.arcLast[1][1])
+	exp17(&s[0])
+	exp17(&s[1])
+	permute1MDS(s)
+
+	// Full round 7.
+	s[0].Add(&s[0], &k.arcLast[2][0])
+	s[1].Add(&s[1], &k.arcLast[2][1])
+	exp17(&s[0])
+	exp17(&s[1])
+	permute1MDS(s)
+
+	// Full round 8.
+	s[0].Add(&s[0], &k.arcLast[3][0])
+	s[1].Add(&s[1], &k.arcLast[3][1])
+	exp17(&s[0])
+	exp17(&s[1])
+	permute1MDS(s)
+}
+
+func permute1MDS(s *[2]fr.Element) {
+	k := &permute1Constants
+	var n0, n1, prod fr.Element
+	n0.Mul(&k.mds[0][0], &s[0])
+	prod.Mul(&k.mds[0][1], &s[1])
+	n0.Add(&n0, &prod)
+	n1.Mul(&k.mds[1][0], &s[0])
+	prod.Mul(&k.mds[1][1], &s[1])
+	n1.Add(&n1, &prod)
+	s[0] = n0
+	s[1] = n1
+}
+
+func permute1MI(s *[2]fr.Element) {
+	k := &permute1Constants
+	var n0, n1 fr.Element
+	n0 = s[0]
+	n1.Mul(&k.mi[1][1], &s[1])
+	s[0] = n0
+	s[1] = n1
+}
+
+func permute1Sparse(s *[2]fr.Element, v, wHat *[1]fr.Element) {
+	var n0, prod fr.Element
+	n0.Mul(&permute1Constants.m00, &s[0])
+	prod.Mul(&wHat[0], &s[1])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[0], &s[0])
+	s[1].Add(&s[1], &prod)
+	s[0] = n0
+}
+
+var permute2Constants = struct {
+	arcFirst   [5][3]fr.Element // rows 0..4: first full rounds and first partial round
+	arcPartial [30]fr.Element   // state[0] constant of partial rounds 2..31
+	arcLast    [4][3]fr.Element // last full rounds
+	mds, mi    [3][3]fr.Element
+	v, wHat    [31][2]fr.Element // sparse matrices of partial rounds 1..31
+	m00        fr.Element
+}{
+	arcFirst: [5][3]fr.Element{
+		[3]fr.Element(unrolledElements("308026635595114235070436728341841505234226384644787941764356225291780075012", "686850750308311448868354907988153221833589417264043199872750834851275630399", "5458865526113744175375673481036999502881423789202235030915223710930508573500")),
+		[3]fr.Element(unrolledElements("1216889461603982466063117074419064018993731784023337352219629352951004218382", "4580374146984258872617301222640071415348151863297599652351895199406510624060", "5287771711632967718595430906600106184880872479312192055075552617141548501299")),
+		[3]fr.Element(unrolledElements("3037526442503690560777271665669625925917538366486234291090702161060916614832", "6275277408809697928512465960441767403986852341417079924634963619646806124417", "7335650489313165022076032570688161581492191665821494053773844209042883340886")),
+		[3]fr.Element(unrolledElements("1627952039309156476645184308670263708019542166435650091304574646631569460339", "6094265973203525089006037274771888959193635664689776329087130682272196094008", "6490696528492405721785907440795129872072544933360586449368276289112880330670")),
+		[3]fr.Element(unrolledElements("1838969713611020994526552299650788115168140980815959904769759411371437475085", "7365521796310231646272250819449174044164385234835107597226640093799385836527", "4266909582211852643916125441538650663312164835968891332255520046501981846583")),
+	},
+	arcPartial: [30]fr.Element(unrolledElements("6473115796297849981243440128186045692570441129722141600589697972207019034114", "7535049679285085127254354405132636437113571883339127395673075797846089326269", "1711678548780795616003707362617306495272193644757088959526580968531505618462", "5456562011888877632000781871593158143381683884143302415131680655656746633862", "1100590275025714828384173494392576370133340358806090350940154175156665261451", "5415359566835722991278408989250791364289536917262588979392157746024114936740", "6237615318355732047045724026092897264784149787546511680012880892698097635043", "7287690110851159960161779644886278162787641825862017485041305657999469617051", "4184683370316308163183503102859402453057265574588852109291933738351920553090", "6846229483565935253840094651194242395445970758956587653239277837011265400368", "5247810841038336337815649410419075835732486594789369339851106589783727983017", "1350482418508995195523536351321792673818559940428835801272789219565273566318", "7523100264101708379290907181985497312839644397735317166176618496114665592432", "4448983106201719179525106942047446359242931369996206349886389108444114615153", "4707208831461551505399685025613601458693283200882068784619611629076148674952", "1893915076355037525100039598806518607249813449139768268666694056389174756514", "4935555126031336876623027767955881648701551423712744911952522336886878419229", "5371766517600436247601579461653779625636670466206845343067502388608973070364", "5481881441546333764028962914229780756452153918082739985375577555343757168054", "1954471113564334413243724765183768873213349030771353076726180682874219368325", "1012379812825152708976390969518781267334249550825589446443615461216275102060", "8105256884564391639037022747794417781493071376147456166596716470636290017433", "7594454719104380774454693884796607911348013298003803986262241781266517166736", "1207106880338603787516796756887740147688034490404772260606455454720580612736", "1711528816380925498316570113595822077203881763260257965403143824143404708178", "6803780526999152786445603481545973879655550041369278545895500277499070406237", "1069595050445295607875576111157043567415305784370362199387551310224007588083", "720965608687100347963027562526364503127212351083665218923713731199031929511", "2795527282488695374909894263098162202501248639881007215809149095324952992834", "5776996029529735561474215342129256570760328465625836455153507982986089417043")),
+	arcLast: [4][3]fr.Element{
+		[3]fr.Element(unrolledElements("4098945751589637585349322721645137714901277398725764380413825257514636605490", "896241425392348667316141178956455644228372721471824108535546494759067376393", "3992502368943038378368897680594056578803163431114935312687627167974272071263")),
+		[3]fr.Element(unrolledElements("2916896099606045408059702536614926909593404178656347225235372099792450298246", "120269880148157352408037220674298509372962320809264336091966259007633284713", "7722392890376228197239026921734213343834699657441777356614625170525296088221")),
+		[3]fr.Element(unrolledElements("2433763979138972299522164212362097495457810156842620348670661190742330717004", "983738301417603757808483219105286248075595155162538643758190406964355750696", "209202405659177692545688490614016438006505568346018036573546364833013030573")),
+		[3]fr.Element(unrolledElements("4653243085200282579438307546529783706597045845312243944557671219043378566385", "5337014110345479543678006017350943272815297410632902615031016645483782346794", "6325608705322012724565293795590543306557376953836287094512934948871034460300")),
+	},
+	mds: [3][3]fr.Element{
+		[3]fr.Element(unrolledElements("5629641166285580282832549959187697687583932890102709218623488970611606159361", "6333346312071277818186618704086159898531924501365547870951425091938056929281", "6755569399542696339399059951025237225100719468123251062348186764733927391233")),
+		[3]fr.Element(unrolledElements("6333346312071277818186618704086159898531924501365547870951425091938056929281", "6755569399542696339399059951025237225100719468123251062348186764733927391233", "7037051457856975353540687448984622109479916112628386523279361213264507699201")),
+		[3]fr.Element(unrolledElements("6755569399542696339399059951025237225100719468123251062348186764733927391233", "7037051457856975353540687448984622109479916112628386523279361213264507699201", "7238110070938603220784707090384182741179342287274911852515914390786350776321")),
+	},
+	mi: [3][3]fr.Element{
+		[3]fr.Element(unrolledElements("1", "0", "0")),
+		[3]fr.Element(unrolledElements("0", "1949629285152675843545617098663080067734218406516000484720630379218497119024", "6804287869450188502728877251894011667833647269738979685488937504164506768586")),
+		[3]fr.Element(unrolledElements("0", "6804287869450188502728877251894011667833647269738979685488937504164506768586", "4924677972410444052137834859533533887056104638988047570112284264367323462906")),
+	},
+	v: [31][2]fr.Element{
+		[2]fr.Element(unrolledElements("5071022128698024353704328969062290150953434889342646239331495908377881925507", "6693255166517284828997648575148995228132762333280413628056922174955177338039")),
+		[2]fr.Element(unrolledElements("5423641413288424607953685038034474614916250866879227035894380666558650706489", "5339947227499621659334136903412968998794129758461864571458205047378610379365")),
+		[2]fr.Element(unrolledElements("6866848106706548607973406027549778502924094794042455357599662237562313804108", "4034922553054600299956843142071401810686433044054662599202129020075345500306")),
+		[2]fr.Element(unrolledElements("253682592007646928830077940014609838894754259075882450471369573193104679294", "5429930182230959551399277894398643779092086445716605127704349422689038405523")),
+		[2]fr.Element(unrolledElements("3157555718563096547912913420515626470584526603200061898513493679323978188420", "6177490439198536139733796474250453800042992965769284252547258479887263488701")),
+		[2]fr.Element(unrolledElements("5755209309933016189874925774212014925889507922865001727869892166805345084957", "6972406089801937605078274630770412993614210510669931020875951402227009485147")),
+		[2]fr.Element(unrolledElements("2399946150382908747739038774234831101298084967872051857327701809077994602481", "8006827580739169454065998287601961945926827437312169420207457153369731117450")),
+		[2]fr.Element(unrolledElements("336854029096812027993429905526476232654633984215202609834874902172061160148", "2173205618181559458893761844483049984676689956958378653055034133577121288051")),
+		[2]fr.Element(unrolledElements("1150247642432088610822167776057912070396494949717952541676286767893772570857", "4018611703433714004673508077401981524002369699691298140171798673926803571476")),
+		[2]fr.Element(unrolledElements("4915210873252161209464198783405589015439803485767698988731639345973414911416", "2692125156575612298425733104017074910126385382417289229166800083378019379251")),
+		[2]fr.Element(unrolledElements("6946589575660729336945306434503069991715421729854718213063426896886472567653", "888649550092003034253645125954138427278156434410778889669163505374940213614")),
+		[2]fr.Element(unrolledElements("8211999421064340425786564412490212358375643253327733028988323321151462438475", "6491752900402056237981486495685525526114060469672838159788873028184852762625")),
+		[2]fr.Element(unrolledElements("5113783964580651150234705950117759115776395610693101899076826961517838666717", "4290931721518757222893144616404849166993047845425982286535673675867285731201")),
+		[2]fr.Element(unrolledElements("2496528606721449386189051698615222708459363714993934005466914229246137978768", "7420161260790078619784193809292575769204978801796137400856104399809884252082")),
+		[2]fr.Element(unrolledElements("5810235368489275360193497718463318131258632562055692091713453485327162794432", "2940212148484543630352817966266426574420283614404335225984201119533786975865")),
+		[2]fr.Element(unrolledElements("6174727674896881248296569468370356429499335387410987654977719460923576114060", "2118353752774283390458628132390841478024894901978775880501757191102457712597")),
+		[2]fr.Element(unrolledElements("7928676943426219605662251279626817477332292176417864411672283273169660240232", "5578353168756060859937770433837936622619923441363922683227969852352600374575")),
+		[2]fr.Element(unrolledElements("2373831895095635704808691063414059228351689014169915785080316498970487409780", "2501154639300702666958953707756301133092229565732968388261152560666330354451")),
+		[2]fr.Element(unrolledElements("3380807857207311895170012051085981530446605768510245714837733545767270194182", "5119344892268017700098836955912249614748667561714761555915478665351754682237")),
+		[2]fr.Element(unrolledElements("6372844889021167867780605025670862140219213140371876292914592045615069069286", "5881863876875773590284286324685617389315859374491971675180704055131610890716")),
+		[2]fr.Element(unrolledElements("4809745123609836907545850763372545328262789310318383043966284648165647050571", "376420537967395305483074470608241628171514196263755558574123563405482846675")),
+		[2]fr.Element(unrolledElements("1370866579779179751666779272643589753325456472592344615388576954346012070217", "5257830297410242640228024612230943284008849823398918772699808558393403797659")),
+		[2]fr.Element(unrolledElements("3946720345795184125515244263065812157065434338563598952377867023243117512852", "6866919763492205729081912495695182544024577363339374480652114680551295101496")),
+		[2]fr.Element(unrolledElements("3024684110038166395190112929852402256320176798582723521437291932803784795767", "6539562343639912400065210160521515784606954936835823363846078290626308539726")),
+		[2]fr.Element(unrolledElements("7136760835897019121159862603287197976385321189619646416624219008979984560659", "7895099307266901340899077467413466659983495496921952663087642094863245454003")),
+		[2]fr.Element(unrolledElements("6646874659690829311166122680037231064739229859859168001040087211399091334835", "1066468426039637856564528648552324455121253965270621586756275576531360805988")),
+		[2]fr.Element(unrolledElements("1365968091762727342736198250835604969369618525102366755845979549748608523105", "4464239250316221358717998424094474299688239588415912587484413985026580825120")),
+		[2]fr.Element(unrolledElements("829877404010811150139661070656107124824766433717606571238525484395118485062", "2133278616106368421700301256957373347602451829060634352828506643667063413131")),
+		[2]fr.Element(unrolledElements("5390046319029973741033426553186554601475450031982266534616596434064743159126", "200101191114572496447619547869086533453238430957732351478283876676500967229")),
+		[2]fr.Element(unrolledElements("7740756603642672888894756193883084320427907723891225175607297334590958469121", "7851338840837568215878966996652842667862592119946814106687401582227972161537")),
+		[2]fr.Element(unrolledElements("6333346312071277818186618704086159898531924501365547870951425091938056929281", "6755569399542696339399059951025237225100719468123251062348186764733927391233")),
+	},
+	wHat: [31][2]fr.Element{
+		[2]fr.Element(unrolledElements("3116724973922961579596989815901373019435935204375493364272252301052542449158", "7408015005953812141582432941909634124857610405677854042372032755105703503216")),
+		[2]fr.Element(unrolledElements("8050619445357699317298941741938347747705781620934387820402293787067559182331", "1175624794150639596623235154409626733867747861771129765434940374432552373460")),
+		[2]fr.Element(unrolledElements("117169004877639044713895886366298032243934434117919759399235461968788717568", "4927712729111667381263381597126487754060410647971109009769634940634861561447")),
+		[2]fr.Element(unrolledElements("273080884635330061793616249519578987932061303362404047929570241160413184", "6755245521230109293524816879780224016460642499554143028868320513216975418164")),
+		[2]fr.Element(unrolledElements("636458162558366651544709068381024240250832487808562941538795406753792", "3377783944921751146727794187090631893528337415435646688970672179571402054042")),
+		[2]fr.Element(unrolledElements("1483366341178041010871482556367844330395501088012018107293154410496", "1688892348126377793676816084957060695633216720461087565747048204392874167501")),
+		[2]fr.Element(unrolledElements("3457219706783385041088409499908341650812684295737206993529602048", "5066677049652921936482832907538091876699899873315061068595524259986360657511")),
+		[2]fr.Element(unrolledElements("8057596946334386411674879041713700599665498211472471358439424", "6755569399542686782959743554856251573047041373477808810469054992533217817396")),
+		[2]fr.Element(unrolledElements("18779503200849118059633528660299212891119786999127486758912", "3377784699771348147426737736552879750927293625205233725155347898416850639258")),
+		[2]fr.Element(unrolledElements("43768600343200468322861380583777277220833152500478509056", "1688892349885674084797854725189294859791758532958339894199571359997570632909")),
+		[2]fr.Element(unrolledElements("102009640804352584002053771343310212920741969110499328", "5066677049657022254549173978197506885476956307274619337528306265940633216615")),
+		[2]fr.Element(unrolledElements("237749590698292036528451298835503778513792186712064", "6755569399542696339399059669050406374338731720825453191302792534927377380148")),
+		[2]fr.Element(unrolledElements("554112997864742681140626788593189997790679007232", "3377784699771348169699529974855431702633034239189537545989666816121868622234")),
+		[2]fr.Element(unrolledElements("1291447920060953749444026854566905183953289216", "1688892349885674084849764987754777628312066671762999899135755514685010005197")),
+		[2]fr.Element(unrolledElements("3009923493324871919994295698184953712017408", "5066677049657022254549294963268924349007962160726705701267669151581322733159")),
+		[2]fr.Element(unrolledElements("7015102424913428566276894483329221935104", "6755569399542696339399059951025237216780695387930609262041259663849671373620")),
+		[2]fr.Element(unrolledElements("16349804950578746537557713185135050752", "3377784699771348169699529975512618612530968602948248130271633829462178396570")),
+		[2]fr.Element(unrolledElements("38105804552849157101617152896126976", "1688892349885674084849764987756309306275134672934950547023907326299368361165")),
+		[2]fr.Element(unrolledElements("88811600200070897095949893644288", "5066677049657022254549294963268927918825539495760454883254494243330578685543")),
+		[2]fr.Element(unrolledElements("206989471096405114153334252544", "6755569399542696339399059951025237225100719467877758227097148781170357257012")),
+		[2]fr.Element(unrolledElements("482421677441358903405161472", "3377784699771348169699529975512618612550359734061053371320964998978676051866")),
+		[2]fr.Element(unrolledElements("1124359966874549299758336", "1688892349885674084849764987756309306275179867030811432078078100992865425613")),
+		[2]fr.Element(unrolledElements("2620498609878090547968", "5066677049657022254549294963268927918825539601092438293653186658805240776167")),
+		[2]fr.Element(unrolledElements("6107486184750648384", "6755569399542696339399059951025237225100719468123251062340943188083341801524")),
+		[2]fr.Element(unrolledElements("14234461852531392", "3377784699771348169699529975512618612550359734061625531174076500066719433722")),
+		[2]fr.Element(unrolledElements("33175663129296", "1688892349885674084849764987756309306275179867030812765587046651836612851341")),
+		[2]fr.Element(unrolledElements("77321126448", "5066677049657022254549294963268927918825539601092438296761140073458741446431")),
+		[2]fr.Element(unrolledElements("180209124", "6755569399542696339399059951025237225100719468123251062348186764733713660356")),
+		[2]fr.Element(unrolledElements("420012", "3377784699771348169699529975512618612550359734061625531174093382366963197488")),
+		[2]fr.Element(unrolledElements("981", "1688892349885674084849764987756309306275179867030812765587046691183481846649")),
+		[2]fr.Element(unrolledElements("3", "844446174942837042424882493878154653137589933515406382793523345591740923902")),
+	},
+	m00: unrolledElements("5629641166285580282832549959187697687583932890102709218623488970611606159361")[0],
+}
+
+// permute2 is the rate-2 permutation (width 3).
+func permute2(s *[3]fr.Element) {
+	k := &permute2Constants
+
+	// Full round 1.
+	s[0].Add(&s[0], &k.arcFirst[0][0])
+	s[1].Add(&s[1], &k.arcFirst[0][1])
+	s[2].Add(&s[2], &k.arcFirst[0][2])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	permute2MDS(s)
+
+	// Full round 2.
+	s[0].Add(&s[0], &k.arcFirst[1][0])
+	s[1].Add(&s[1], &k.arcFirst[1][1])
+	s[2].Add(&s[2], &k.arcFirst[1][2])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	permute2MDS(s)
+
+	// Full round 3.
+	s[0].Add(&s[0], &k.arcFirst[2][0])
+	s[1].Add(&s[1], &k.arcFirst[2][1])
+	s[2].Add(&s[2], &k.arcFirst[2][2])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	permute2MDS(s)
+
+	// Full round 4.
+	s[0].Add(&s[0], &k.arcFirst[3][0])
+	s[1].Add(&s[1], &k.arcFirst[3][1])
+	s[2].Add(&s[2], &k.arcFirst[3][2])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	permute2MDS(s)
+
+	// First partial round: the constants of row 4 go through M_i.
+	s[0].Add(&s[0], &k.arcFirst[4][0])
+	s[1].Add(&s[1], &k.arcFirst[4][1])
+	s[2].Add(&s[2], &k.arcFirst[4][2])
+	permute2MI(s)
+
+	// Partial round 1.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[0])
+	permute2Sparse(s, &k.v[0], &k.wHat[0])
+
+	// Partial round 2.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[1])
+	permute2Sparse(s, &k.v[1], &k.wHat[1])
+
+	// Partial round 3.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[2])
+	permute2Sparse(s, &k.v[2], &k.wHat[2])
+
+	// Partial round 4.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[3])
+	permute2Sparse(s, &k.v[3], &k.wHat[3])
+
+	// Partial round 5.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[4])
+	permute2Sparse(s, &k.v[4], &k.wHat[4])
+
+	// Partial round 6.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[5])
+	permute2Sparse(s, &k.v[5], &k.wHat[5])
+
+	// Partial round 7.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[6])
+	permute2Sparse(s, &k.v[6], &k.wHat[6])
+
+	// Partial round 8.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[7])
+	permute2Sparse(s, &k.v[7], &k.wHat[7])
+
+	// Partial round 9.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[8])
+	permute2Sparse(s, &k.v[8], &k.wHat[8])
+
+	// Partial round 10.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[9])
+	permute2Sparse(s, &k.v[9], &k.wHat[9])
+
+	// Partial round 11.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[10])
+	permute2Sparse(s, &k.v[10], &k.wHat[10])
+
+	// Partial round 12.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[11])
+	permute2Sparse(s, &k.v[11], &k.wHat[11])
+
+	// Partial round 13.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[12])
+	permute2Sparse(s, &k.v[12], &k.wHat[12])
+
+	// Partial round 14.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[13])
+	permute2Sparse(s, &k.v[13], &k.wHat[13])
+
+	// Partial round 15.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[14])
+	permute2Sparse(s, &k.v[14], &k.wHat[14])
+
+	// Partial round 16.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[15])
+	permute2Sparse(s, &k.v[15], &k.wHat[15])
+
+	// Partial round 17.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[16])
+	permute2Sparse(s, &k.v[16], &k.wHat[16])
+
+	// Partial round 18.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[17])
+	permute2Sparse(s, &k.v[17], &k.wHat[17])
+
+	// Partial round 19.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[18])
+	permute2Sparse(s, &k.v[18], &k.wHat[18])
+
+	// Partial round 20.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[19])
+	permute2Sparse(s, &k.v[19], &k.wHat[19])
+
+	// Partial round 21.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[20])
+	permute2Sparse(s, &k.v[20], &k.wHat[20])
+
+	// Partial round 22.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[21])
+	permute2Sparse(s, &k.v[21], &k.wHat[21])
+
+	// Partial round 23.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[22])
+	permute2Sparse(s, &k.v[22], &k.wHat[22])
+
+	// Partial round 24.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[23])
+	permute2Sparse(s, &k.v[23], &k.wHat[23])
+
+	// Partial round 25.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[24])
+	permute2Sparse(s, &k.v[24], &k.wHat[24])
+
+	// Partial round 26.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[25])
+	permute2Sparse(s, &k.v[25], &k.wHat[25])
+
+	// Partial round 27.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[26])
+	permute2Sparse(s, &k.v[26], &k.wHat[26])
+
+	// Partial round 28.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[27])
+	permute2Sparse(s, &k.v[27], &k.wHat[27])
+
+	// Partial round 29.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[28])
+	permute2Sparse(s, &k.v[28], &k.wHat[28])
+
+	// Partial round 30.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[29])
+	permute2Sparse(s, &k.v[29], &k.wHat[29])
+
+	// Partial round 31.
+	exp17(&s[0])
+	permute2Sparse(s, &k.v[30], &k.wHat[30])
+
+	// Full round 5.
+	s[0].Add(&s[0], &k.arcLast[0][0])
+	s[1].Add(&s[1], &k.arcLast[0][1])
+	s[2].Add(&s[2], &k.arcLast[0][2])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	permute2MDS(s)
+
+	// Full round 6.
+	s[0].Add(&s[0], &k.arcLast[1][0])
+	s[1].Add(&s[1], &k.arcLast[1][1])
+	s[2].Add(&s[2], &k.arcLast[1][2])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	permute2MDS(s)
+
+	// Full round 7.
+	s[0].Add(&s[0], &k.arcLast[2][0])
+	s[1].Add(&s[1], &k.arcLast[2][1])
+	s[2].Add(&s[2], &k.arcLast[2][2])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	permute2MDS(s)
+
+	// Full round 8.
+	s[0].Add(&s[0], &k.arcLast[3][0])
+	s[1].Add(&s[1], &k.arcLast[3][1])
+	s[2].Add(&s[2], &k.arcLast[3][2])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	permute2MDS(s)
+}
+
+func permute2MDS(s *[3]fr.Element) {
+	k := &permute2Constants
+	var n0, n1, n2, prod fr.Element
+	n0.Mul(&k.mds[0][0], &s[0])
+	prod.Mul(&k.mds[0][1], &s[1])
+	n0.Add(&n0, &prod)
+	prod.Mul(&k.mds[0][2], &s[2])
+	n0.Add(&n0, &prod)
+	n1.Mul(&k.mds[1][0], &s[0])
+	prod.Mul(&k.mds[1][1], &s[1])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mds[1][2], &s[2])
+	n1.Add(&n1, &prod)
+	n2.Mul(&k.mds[2][0], &s[0])
+	prod.Mul(&k.mds[2][1], &s[1])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mds[2][2], &s[2])
+	n2.Add(&n2, &prod)
+	s[0] = n0
+	s[1] = n1
+	s[2] = n2
+}
+
+func permute2MI(s *[3]fr.Element) {
+	k := &permute2Constants
+	var n0, n1, n2, prod fr.Element
+	n0 = s[0]
+	n1.Mul(&k.mi[1][1], &s[1])
+	prod.Mul(&k.mi[1][2], &s[2])
+	n1.Add(&n1, &prod)
+	n2.Mul(&k.mi[2][1], &s[1])
+	prod.Mul(&k.mi[2][2], &s[2])
+	n2.Add(&n2, &prod)
+	s[0] = n0
+	s[1] = n1
+	s[2] = n2
+}
+
+func permute2Sparse(s *[3]fr.Element, v, wHat *[2]fr.Element) {
+	var n0, prod fr.Element
+	n0.Mul(&permute2Constants.m00, &s[0])
+	prod.Mul(&wHat[0], &s[1])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[0], &s[0])
+	s[1].Add(&s[1], &prod)
+	prod.Mul(&wHat[1], &s[2])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[1], &s[0])
+	s[2].Add(&s[2], &prod)
+	s[0] = n0
+}
+
+var permute3Constants = struct {
+	arcFirst   [5][4]fr.Element // rows 0..4: first full rounds and first partial round
+	arcPartial [30]fr.Element   // state[0] constant of partial rounds 2..31
+	arcLast    [4][4]fr.Element // last full rounds
+	mds, mi    [4][4]fr.Element
+	v, wHat    [31][3]fr.Element // sparse matrices of partial rounds 1..31
+	m00        fr.Element
+}{
+	arcFirst: [5][4]fr.Element{
+		[4]fr.Element(unrolledElements("507014295002340130094051641853152875478157627773318139164018329180924405874", "7491635671712014457226444359115925142756691872583683345054285850544197741427", "6428238367987262728380227088231207564575448754570094797343562439968130973414", "417784945642189241683731513330527942532284498692605186769747085266175822763")),
+		[4]fr.Element(unrolledElements("2460473050623699025207425440478059302299840127402356580032311810234352192553", "6739526644189243304596281380207849534959661650376903866949397817731628238273", "597549483098771783017881992848590630624851676141528707360377946657258324767", "4344854910230270044421510722988181256819181450723410913296668891739456698878")),
+		[4]fr.Element(unrolledElements("4470966059082111196154549519927954009195386775161045009249761095179400738385", "3473585183550757121590696749322044239715986752544880348555956596981389357863", "7430297707987557411189895556541208022085393472000735227995498626328989684346", "5069778819917269726812261651516390352533805494152825991716992564077618201433")),
+		[4]fr.Element(unrolledElements("6174290539348443013815085618635508535362670264359346323016750542543831671611", "3653200179921208842362414440137382634990868113411395862128462165430232768762", "738082080048556788240923937267344369599673172037328036389652736930755015716", "6044628729794520171281155572076229146090871806225211668717932778346109567300")),
+		[4]fr.Element(unrolledElements("7736716524450328636992649732099229308498454296838040636411888315240829896039", "7801646052537243021447445675976197401284814870800330493571122440154230993256", "2515366837805709066361634907162810631300608653195753024882428438693187674075", "6187129458703378977398123257836651311870393108870066049693065814338734745990")),
+	},
+	arcPartial: [30]fr.Element(unrolledElements("3794218183072958950105086749522628660482084910777125180518390733591853952242", "6233891665852964025793087211080773874059573525293970992732521677977875000340", "3649102510126802185101419697056558429290624425277045268313219498999658341074", "3939605594032360277268908739148360994367569248494182823773540793386338597192", "5793918311165867953048304979785472147312583909683865747227678849141640757653", "6736260101005961361914915211974065806180794912798753414052544982409125819043", "99386619724773353801924326721821206459385882899600247516417467094446766240", "855909900584723233076257164006665636915929955418582474051940301964800182283", "4825672737213145076208985600293258814550355409397430040527846357405902736613", "2613583445528729236454598029847009952792117905697841625417796499695750020893", "2270157037168350840262996453896931716107157898869695999890879972338543031389", "2275045507759235100651383490228387625693443691412647946383341897216251871765", "7927445715344068644679425610882298644141563967603864536803142600721498066529", "1015774581556338350646595104847685838057300034445346472118215134956921458632", "7113828120114189476904109335340835059793042603907156402258236741699295723140", "5361253968083438698857228199059136416317284553758704726716422432942990049525", "4669842734842482362744949263084814109548439307811166352836742701971034038591", "8231534498321518818466731090921021461348523047592991460718222512976570936103", "1135503381194475580486620329886878775177087746932489420355089838941102677989", "7128553112861516020321124909533387963631215062151513895950207865404619202792", "3748326121951361079351518015876815023283298346355581092893027673947885706879", "7505487510037889890503698600332856432704754925691776140216514366218619637508", "5555310781556720053920075778567203135558942142552165491341682235748268266993", "7192146175350915536788873880560259314661865066222526587984493122394816976702", "4765548992224561125635289821603442792164763449693429019499745617619175713660", "3743934736956678131120534615785984161423931611359776468788528419922144981092", "2561736804193775652910031718497365265916219792268053751622511136515315868830", "709567079199351122676952175219214288330631674102772101121326864605715037719", "7918265567971805942273669013826913668856812049698663746273433111971590741797", "3279978733711205079610878817627312064260384417973838290414881688081024612948")),
+	arcLast: [4][4]fr.Element{
+		[4]fr.Element(unrolledElements("7527592443870070416769159161840175145920858357524990578830014410783569808116", "5092456597786987850309117489482478313382405139827972441408647246472962756834", "6350597027022709417452502607358026831887635557428071029520622433982691427012", "2802963479241809964305385036387099199586209492879271476960143416190639640353")),
+		[4]fr.Element(unrolledElements("8221597608726879712015358263079163240151837220833337984106403382169293661236", "4113355626280577616600236093811592553533103473131581188028563119065229189086", "5372776432646713299575551380876512719814813415800817038026997728135205964237", "4786357963899522354925243599250308006789219843696074990338183430509312871698")),
+		[4]fr.Element(unrolledElements("6136402023627867107328780042258341451909381526863697406971910076649425863577", "403917435174867072656735572934226709672919820691101390561081554994951410328", "2197307939742823984581382962133936215707127960884165372495222916141872574366", "280402617002073624427675333736860754609331352944830300201501643212477955908")),
+		[4]fr.Element(unrolledElements("1341193910735288834156152818207813295161416180387476007289323480480850290318", "6430227820490268278323837184448206513428639661392170572655482468189896623489", "5381106858655589782618523649345652522506825219090054633547753330769140464666", "6815943890781966378087179499267196955390238260135387729266185493827955661877")),
+	},
+	mds: [4][4]fr.Element{
+		[4]fr.Element(unrolledElements("6333346312071277818186618704086159898531924501365547870951425091938056929281", "6755569399542696339399059951025237225100719468123251062348186764733927391233", "7037051457856975353540687448984622109479916112628386523279361213264507699201", "7238110070938603220784707090384182741179342287274911852515914390786350776321")),
+		[4]fr.Element(unrolledElements("6755569399542696339399059951025237225100719468123251062348186764733927391233", "7037051457856975353540687448984622109479916112628386523279361213264507699201", "7238110070938603220784707090384182741179342287274911852515914390786350776321", "7388904030749824121217721821433853214953911918259805849443329273927733084161")),
+		[4]fr.Element(unrolledElements("7037051457856975353540687448984622109479916112628386523279361213264507699201", "7238110070938603220784707090384182741179342287274911852515914390786350776321", "7388904030749824121217721821433853214953911918259805849443329273927733084161", "4691367638571316902360458299323081406319944075085591015519574142176338466134")),
+		[4]fr.Element(unrolledElements("7238110070938603220784707090384182741179342287274911852515914390786350776321", "7388904030749824121217721821433853214953911918259805849443329273927733084161", "4691367638571316902360458299323081406319944075085591015519574142176338466134", "7600015574485533381823942444903391878238309401638657445141710110325668315137")),
+	},
+	mi: [4][4]fr.Element{
+		[4]fr.Element(unrolledElements("1", "0", "0", "0")),
+		[4]fr.Element(unrolledElements("0", "1770913679784267919684061240570529409533240049541964433983305216194466042974", "1866549029176115214562563228199979689352528726144246309942171765298411233074", "4346743796263811048302866586944240315464137261819288172545289769065471383712")),
+		[4]fr.Element(unrolledElements("0", "1866549029176115214562563228199979689352528726144246309942171765298411233074", "7558841494258648836283360155621755771887149020783008047232513736807775269194", "4535120056384022670391711913985727483353733305675961759491847308116962577919")),
+		[4]fr.Element(unrolledElements("0", "4346743796263811048302866586944240315464137261819288172545289769065471383712", "4535120056384022670391711913985727483353733305675961759491847308116962577919", "6276627284159172130419509667948869114690338896084411919577382981726432363660")),
+	},
+	v: [31][3]fr.Element{
+		[3]fr.Element(unrolledElements("4173866183335783201472244194628736576783485048837628675393061737465983108384", "7722932000723324455226456664260648291221473306287563441845985456906430570292", "6594856426287953808893617720912378172793949803669275719479745212507719610277")),
+		[3]fr.Element(unrolledElements("6000226279124146863136727241256982816131788444179224115247746928098400722618", "7908282303686056666698544594350069550453591878182541881359237112975862129485", "5093308510117892085703642542483202569453525014619454757347106210972466294020")),
+		[3]fr.Element(unrolledElements("2619961646306645205581391052995726359765715683944281254302206143737916690178", "4232098761091596546204817900511023602320071513319772077757088132146331349971", "5893996741813770214517823932256109521211578999954946896591904115585491695052")),
+		[3]fr.Element(unrolledElements("8325580809316750396675932766762467417898539258649549722222651695036898066623", "935876647201484443045783718082846974724768327063500795021021311129223445135", "5974524863800626848947952172784466077450257164992828220213582810876839558473")),
+		[3]fr.Element(unrolledElements("8204349001314703297214711404623509303225403466275829075672617857385903588067", "7700899516069850752036253589356545985942429317687927116121979635283175537543", "5044294933436925340418900271847539180520545900803250015351447337961276236452")),
+		[3]fr.Element(unrolledElements("2524955224785662198329591589711724674124095555557836743499679504608493658905", "4302967459015373152759829425128086104313538230989566310193577282421857243983", "7085854677712207318166853710193042340041941540033918378974225578285392933819")),
+		[3]fr.Element(unrolledElements("4840022052693157642279807806490641258088748396347667260846692256128474823366", "2320513981783688370977023477088348323530328530115618603961392545350193826810", "5464621915894148975838316947465227562970124896240483310384384958637202783418")),
+		[3]fr.Element(unrolledElements("3883396810313782286826526743921122893928006622029825695950975537821087389160", "3247903860075715841700417346976305520144095625429508014463116597838387896518", "7713976443013667920827122604772376124752037463132917973907825978429262179301")),
+		[3]fr.Element(unrolledElements("7772327947818743993969536799424541943794222077485149388704018051197958237416", "6091861936708362479115449153105710361288418563209566341998459726848412047463", "5297480874990195902272064724091045043992902989341028640068973757227255598679")),
+		[3]fr.Element(unrolledElements("1040329738684054638944033836258206193595141362098686106182885767432151997470", "6410948495872817231121367331641167551943622914451951024109227826536706053475", "8427589653022398096841334561198668589334175018792376810561267438739330852416")),
+		[3]fr.Element(unrolledElements("2140854186169685348670815058631538454922732099942137485668926898724201674659", "7468652076037969076499254865972634827247643668936025514456667835334012427225", "1757970002590436992134221206272899348034028285789693801345601389872214347600")),
+		[3]fr.Element(unrolledElements("1486396036463796488918910836112179579921345689769531073796275169260909811548", "2944068876626515619975636201343790940955795063905239465828001989234937362357", "2129508534771598658360969479807111088250507042824235641703160169652575627896")),
+		[3]fr.Element(unrolledElements("2771121126924941019197733171990855353004989462795700586008432116507066843083", "7891946787816327042641514602122794384117585976254993077005488594311364949400", "3198144626605300800949161456570029506551585754496305162893273212825538587550")),
+		[3]fr.Element(unrolledElements("2235960321603888158431253152193753210092382095378613695660507138332654702850", "8038232675840346850478414906021807042143478527454501830157679770453874177003", "1958792433797404280604077529184967132537621891289866404211114861458653949012")),
+		[3]fr.Element(unrolledElements("557501750804917719278599042857355157253445559588197504272305070909202142947", "3034616829454605187995721626183737309348405614367904348286546698648524072591", "6840220540939175845358210160992790877841509186318513396074503225536561679543")),
+		[3]fr.Element(unrolledElements("4238685146588912991052277150923591342201137010140128645266349261112913828465", "6643030753404610703732031460459579946840680195722822216243745344807210311119", "2475680996810491813273296295668023475775994019707601554410767671042799240208")),
+		[3]fr.Element(unrolledElements("8019852686115271278325540117810432061362453551296617166084865346777213399915", "4423157693090536003736289868489603415097102968594072262271284571252976367732", "7706259014114251980167953943066693142549450681284425615883026028251594044946")),
+		[3]fr.Element(unrolledElements("6547126942154328147320832443050612487497491870705666391200907681492196529418", "679282140679490816916885632985394982219355753042789927075966984538450992678", "1975639825505718164338386153081112451212146345707795487585389182032710201113")),
+		[3]fr.Element(unrolledElements("6626352097246581455466134744323875054956097790450108755408293744346547543342", "3153206513984021945987159774654328831706100102818705029007782698669073005829", "6562485048611979813357322527660102014079135660024413816929004954275379368969")),
+		[3]fr.Element(unrolledElements("5473866939914824801054396283089499717847009173955743300757985699921346860815", "5234336354114260164427300465913302641345540877335129370065902402214025364959", "1126975122664037400697387419759870796596612128286867910666937144343541879604")),
+		[3]fr.Element(unrolledElements("3417891366961923935460362758841213240150104820792599187657174842229210836976", "2109930449684567405588381218450859449011546053415687711099097241022929718138", "7467840434764573222855383263695640871779849975911853941299539330373675309347")),
+		[3]fr.Element(unrolledElements("2597398556798267401976238549646636908466233983170491393742918965618011459929", "2068234554183111213575590722108642658093927628419051308673851496635944475991", "7442133310898290472737295900345544691226300883425401862652287408611005187797")),
+		[3]fr.Element(unrolledElements("8125311612818390195384634554117840331472763470372509544364555906952801559214", "1058120093155715639328496535505151563553771572720848047656669554775872868679", "3508635590708952723910460369954844233710129661900433523794510313695248221631")),
+		[3]fr.Element(unrolledElements("5846024911947218980385047513337298856881854435537746492673035925112641190641", "3399496119324756229406913700177768543891693359473529460914461193715484462621", "8285525267580751635819188227169197672725284412519278374652722416809297463548")),
+		[3]fr.Element(unrolledElements("6311155675687157278118438099403853555750805989305197351124885481009701940564", "7804525420484563253140210874229577506103020673697061432389267634450181798069", "8121654451264725445562806320020308670749229358947667984745878360010714898527")),
+		[3]fr.Element(unrolledElements("2517931337784671469746065209438950124555697011910302568267146216785362777665", "1871398140745686429249372615212938376575517207318403151829567332458561958868", "3977305820851493523602032776891834601924844986115642713533201821764692325958")),
+		[3]fr.Element(unrolledElements("6087395921021275780628874587899188804568416515344015561012376477127152419750", "5355926780034961154065801377154310921441757660111086558798718807318312050322", "5101629178073013167316837323585703772385116812301151937785443084424112914710")),
+		[3]fr.Element(unrolledElements("3020607076656756078418837235637442188306522617192578641389204937737014650962", "5637220359779161156373647100061456652227512307027696275393780604040622884745", "7668244523520435283525964877159182945565842688382326226602525369239387851908")),
+		[3]fr.Element(unrolledElements("7260868788947148597424134313681494118239763444543928261311440896297503846856", "7084065973824866808145857319606641283427841345827946323453078374104009662566", "7191857281660704661880284238619626408822191886264792206174412875086997153626")),
+		[3]fr.Element(unrolledElements("7811127118221242642430163068372930541522706885017509040840090946723603546113", "5078405468753450546805196109017235622341339461280152274299939008905886389590", "6079789061129447118939993934098936212999870381116874261303105251396621493135")),
+		[3]fr.Element(unrolledElements("6755569399542696339399059951025237225100719468123251062348186764733927391233", "7037051457856975353540687448984622109479916112628386523279361213264507699201", "7238110070938603220784707090384182741179342287274911852515914390786350776321")),
+	},
+	wHat: [31][3]fr.Element{
+		[3]fr.Element(unrolledElements("5881153929590226273974020024739366539228093085910895296970187642591692093565", "3087526099159687808661739667166920879776388348863148098514933760593102720940", "5526153939329367714443368714325933485322875317171130645740974552496562203691")),
+		[3]fr.Element(unrolledElements("6384532249021946732997709377988002515308046142422082092943922461917913306657", "3515611156196107654971290045026105822551599443335223195522444955784753391799", "1138224488543914315238990105003526271580692633890115486567474932377669162114")),
+		[3]fr.Element(unrolledElements("7438767407235580483497807493395600990773278262780472362485131520399115235980", "5080296968760900376797434832877005601939022285199363417189940949817304923085", "6251905300474441622357928415759826694411515968555428464483715738282960204848")),
+		[3]fr.Element(unrolledElements("7170328928050700797526727006832484628166126803784878349848814523758718583922", "7301555459845197983545694905439043755630987774458330557496046097688240447729", "7092363593810921014374819556963677484202399019871535414564199487898138079427")),
+		[3]fr.Element(unrolledElements("2772827049081756680166514702658410840311990125977382881464127786096434083869", "731236289407407592668021529780874110544277150581068361116404642724426427086", "4715581558270174759234469054378631966663443657525350772513895993669197306864")),
+		[3]fr.Element(unrolledElements("6986747642722807193008824234701113994769851278733429354827786822556898831972", "7277802966896614056540208748248219131616055447869084105405369407240413141926", "5379666108398667768550501311321582195912476073271010508490754949821202384893")),
+		[3]fr.Element(unrolledElements("4836923819677594097895695170713226141965257370018415181146945568645162458969", "2338024011441608123521841904929188790818612008008323734889422354188603144855", "2970573662451252164418849682611292619683787341167928072175166200243325985059")),
+		[3]fr.Element(unrolledElements("5381857409779952665628327584043375540976057604310147612953286182936555841786", "5585801386783953702819984194280591127472653787134009001003784777239879575973", "7518256439684693117324139182952967700924072572444328723655399151441539099139")),
+		[3]fr.Element(unrolledElements("2684994569877741008293622266647277205103897185526103072687389663167680974490", "4530823401126709833726476391654984444257531769309813676261832238601758040157", "7346447850308101906027715432789199716019579461200364616077795555030472476421")),
+		[3]fr.Element(unrolledElements("6788207622832042348642126488920616088822687435613155564625070055136494404543", "7345572074363256210583967430006583646430507990591113801668207894210689527725", "5186047010312854035204413482828045021134916673161128169450645601608316659505")),
+		[3]fr.Element(unrolledElements("1170257694699101461637358453390078380415452452664611144560355979722491291392", "2950059549285188013181916931658203370463912526166425394161627113525340552060", "3707999811350219380432741376678614486540365864569586987196860790394724526648")),
+		[3]fr.Element(unrolledElements("3090566134820885578578884580453760958423513249194804769976859720189425678748", "1014956631064737930693609905134287803771633237096924461974788247575178647239", "8163400103508684878783805217025428614561071180858715453129335917580465265931")),
+		[3]fr.Element(unrolledElements("1328660600287037476318890124773758269717433143569852966469740536814738764583", "2799059352114106880989935270105748212377424368024585897469983757644556127822", "3754944908267757077838580510477741735186709775179387743182973860443996144075")),
+		[3]fr.Element(unrolledElements("2146234887323775547765017169111001441946197048628264449963777969392092532866", "6016882273004400191030618355355462941359941008232443084883169315980534923336", "8006919309363258877422299919790609569913200875861317349068808159703442135037")),
+		[3]fr.Element(unrolledElements("6591150173178976090819472555078788691458408107801555095220191242530347129242", "1680294818293804805201741644942769167735966926003269256043494449110552726734", "7789378020387063323150139272617540367093982687550904210604066962883002948462")),
+		[3]fr.Element(unrolledElements("65347871940096062464336677677087215362501571452721124249338201459654656", "5066505145950466159873820805003751929801682558731762266794909044949536630375", "3619164376983759136165998027265134279132696018847361888020397233621204879653")),
+		[3]fr.Element(unrolledElements("6755569400871628389748965640026098152391559369353920566332119369412544508724", "1688892346389793676903865486300311421055760223094655503467542801678357810381", "1206351680713365901974237654437691495950129065612054602850957455273387322807")),
+		[3]fr.Element(unrolledElements("3377784699771375195222198351607422413381921984968660810916631631032839936410", "6755569399542625246226774733078000065414840635412284482803516432087117935412", "6031758392448881237026906155776404016889561912829977465380028085864894112915")),
+		[3]fr.Element(unrolledElements("6755569399542696339948658311081797349951106141828324629477181234635848495924", "6755569399542696337953289914359298130438897264386806281741085093144506348340", "4825406713959068814776071607763904458071309047699968339412917891778783065820")),
+		[3]fr.Element(unrolledElements("1688892349885674084849776164537646094768714342946991065214962639370703391949", "8444461749428370424248795537209894401759823084395407994935701963602051203073", "7238110070938603220784725791623593342662835128913443783224258232472943497802")),
+		[3]fr.Element(unrolledElements("1688892349885674084849764987983603363407242277637089092625547354332839333069", "5066677049657022254549294962671009582096962770933563908296669278685488375399", "2412703356979534406928235697175041058034702272660717672140791842141361331054")),
+		[3]fr.Element(unrolledElements("4622313602710048294285602833020481471250432", "6755569399542696339399059951025225065671229569651381970019676835240870097716", "3619055035469301610392353545192099104747718114858051351660821727823107606821")),
+		[3]fr.Element(unrolledElements("3377784699771348169699529975512618612644360357357220088998231724311466056090", "5066677049657022254549294963268927918578262144560896192731910442067666822759", "1206351678489767203464117848397363790353840983927040626734569653768340336055")),
+		[3]fr.Element(unrolledElements("1688892349885674084849764987756309306275181778652987163206062314875400277197", "3377784699771348169699529975512618612550354705360191840862884110145290869146", "6031758392448836017320589241986818950982788437964548939286223273763996771475")),
+		[3]fr.Element(unrolledElements("3377784699771348169699529975512618612550359734100500798268412440940394420634", "3377784699771348169699529975512618612550359733959360494708978513021606926746", "4825406713959068813856471393589455160786228191581654864230703866938630452956")),
+		[3]fr.Element(unrolledElements("5066677049657022254549294963268927918825539601092439087339117195636575463015", "8444461749428370424248824938781546531375899335154061748245710998393075400705", "7238110070938603220784707090384182741179342287274913175328589826044429636170")),
+		[3]fr.Element(unrolledElements("5066677049657022254549294963268927918825539601092438296777217481754298410599", "6755569399542696339399059951025237225100719468123251062305893634457381237556", "2412703356979534406928235696794727580393114095758303950865539207657098402670")),
+		[3]fr.Element(unrolledElements("326954555854848", "3377784699771348169699529975512618612550359734061625531174092522282388222362", "3619055035469301610392353545192091370589671143637455926257957742460761127205")),
+		[3]fr.Element(unrolledElements("1688892349885674084849764987756309306275179867030812765587046691190130973389", "6755569399542696339399059951025237225100719468123251062348186764716436454964", "1206351678489767203464117848397363790196557047879151975419319065142183688119")),
+		[3]fr.Element(unrolledElements("5066677049657022254549294963268927918825539601092438296761140073550445679015", "1688892349885674084849764987756309306275179867030812765587046691183481492013", "6031758392448836017320589241986818950982785239395759877096595325655292539475")),
+		[3]fr.Element(unrolledElements("1688892349885674084849764987756309306275179867030812765587046691183481847813", "1688892349885674084849764987756309306275179867030812765587046691183481847801", "4825406713959068813856471393589455160786228191516607901677276260524233850884")),
+	},
+	m00: unrolledElements("6333346312071277818186618704086159898531924501365547870951425091938056929281")[0],
+}
+
+// permute3 is the rate-3 permutation (width 4).
+func permute3(s *[4]fr.Element) {
+	k := &permute3Constants
+
+	// Full round 1.
+	s[0].Add(&s[0], &k.arcFirst[0][0])
+	s[1].Add(&s[1], &k.arcFirst[0][1])
+	s[2].Add(&s[2], &k.arcFirst[0][2])
+	s[3].Add(&s[3], &k.arcFirst[0][3])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	permute3MDS(s)
+
+	// Full round 2.
+	s[0].Add(&s[0], &k.arcFirst[1][0])
+	s[1].Add(&s[1], &k.arcFirst[1][1])
+	s[2].Add(&s[2], &k.arcFirst[1][2])
+	s[3].Add(&s[3], &k.arcFirst[1][3])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	permute3MDS(s)
+
+	// Full round 3.
+	s[0].Add(&s[0], &k.arcFirst[2][0])
+	s[1].Add(&s[1], &k.arcFirst[2][1])
+	s[2].Add(&s[2], &k.arcFirst[2][2])
+	s[3].Add(&s[3], &k.arcFirst[2][3])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	permute3MDS(s)
+
+	// Full round 4.
+	s[0].Add(&s[0], &k.arcFirst[3][0])
+	s[1].Add(&s[1], &k.arcFirst[3][1])
+	s[2].Add(&s[2], &k.arcFirst[3][2])
+	s[3].Add(&s[3], &k.arcFirst[3][3])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	permute3MDS(s)
+
+	// First partial round: the constants of row 4 go through M_i.
+	s[0].Add(&s[0], &k.arcFirst[4][0])
+	s[1].Add(&s[1], &k.arcFirst[4][1])
+	s[2].Add(&s[2], &k.arcFirst[4][2])
+	s[3].Add(&s[3], &k.arcFirst[4][3])
+	permute3MI(s)
+
+	// Partial round 1.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[0])
+	permute3Sparse(s, &k.v[0], &k.wHat[0])
+
+	// Partial round 2.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[1])
+	permute3Sparse(s, &k.v[1], &k.wHat[1])
+
+	// Partial round 3.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[2])
+	permute3Sparse(s, &k.v[2], &k.wHat[2])
+
+	// Partial round 4.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[3])
+	permute3Sparse(s, &k.v[3], &k.wHat[3])
+
+	// Partial round 5.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[4])
+	permute3Sparse(s, &k.v[4], &k.wHat[4])
+
+	// Partial round 6.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[5])
+	permute3Sparse(s, &k.v[5], &k.wHat[5])
+
+	// Partial round 7.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[6])
+	permute3Sparse(s, &k.v[6], &k.wHat[6])
+
+	// Partial round 8.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[7])
+	permute3Sparse(s, &k.v[7], &k.wHat[7])
+
+	// Partial round 9.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[8])
+	permute3Sparse(s, &k.v[8], &k.wHat[8])
+
+	// Partial round 10.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[9])
+	permute3Sparse(s, &k.v[9], &k.wHat[9])
+
+	// Partial round 11.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[10])
+	permute3Sparse(s, &k.v[10], &k.wHat[10])
+
+	// Partial round 12.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[11])
+	permute3Sparse(s, &k.v[11], &k.wHat[11])
+
+	// Partial round 13.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[12])
+	permute3Sparse(s, &k.v[12], &k.wHat[12])
+
+	// Partial round 14.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[13])
+	permute3Sparse(s, &k.v[13], &k.wHat[13])
+
+	// Partial round 15.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[14])
+	permute3Sparse(s, &k.v[14], &k.wHat[14])
+
+	// Partial round 16.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[15])
+	permute3Sparse(s, &k.v[15], &k.wHat[15])
+
+	// Partial round 17.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[16])
+	permute3Sparse(s, &k.v[16], &k.wHat[16])
+
+	// Partial round 18.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[17])
+	permute3Sparse(s, &k.v[17], &k.wHat[17])
+
+	// Partial round 19.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[18])
+	permute3Sparse(s, &k.v[18], &k.wHat[18])
+
+	// Partial round 20.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[19])
+	permute3Sparse(s, &k.v[19], &k.wHat[19])
+
+	// Partial round 21.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[20])
+	permute3Sparse(s, &k.v[20], &k.wHat[20])
+
+	// Partial round 22.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[21])
+	permute3Sparse(s, &k.v[21], &k.wHat[21])
+
+	// Partial round 23.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[22])
+	permute3Sparse(s, &k.v[22], &k.wHat[22])
+
+	// Partial round 24.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[23])
+	permute3Sparse(s, &k.v[23], &k.wHat[23])
+
+	// Partial round 25.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[24])
+	permute3Sparse(s, &k.v[24], &k.wHat[24])
+
+	// Partial round 26.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[25])
+	permute3Sparse(s, &k.v[25], &k.wHat[25])
+
+	// Partial round 27.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[26])
+	permute3Sparse(s, &k.v[26], &k.wHat[26])
+
+	// Partial round 28.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[27])
+	permute3Sparse(s, &k.v[27], &k.wHat[27])
+
+	// Partial round 29.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[28])
+	permute3Sparse(s, &k.v[28], &k.wHat[28])
+
+	// Partial round 30.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[29])
+	permute3Sparse(s, &k.v[29], &k.wHat[29])
+
+	// Partial round 31.
+	exp17(&s[0])
+	permute3Sparse(s, &k.v[30], &k.wHat[30])
+
+	// Full round 5.
+	s[0].Add(&s[0], &k.arcLast[0][0])
+	s[1].Add(&s[1], &k.arcLast[0][1])
+	s[2].Add(&s[2], &k.arcLast[0][2])
+	s[3].Add(&s[3], &k.arcLast[0][3])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	permute3MDS(s)
+
+	// Full round 6.
+	s[0].Add(&s[0], &k.arcLast[1][0])
+	s[1].Add(&s[1], &k.arcLast[1][1])
+	s[2].Add(&s[2], &k.arcLast[1][2])
+	s[3].Add(&s[3], &k.arcLast[1][3])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	permute3MDS(s)
+
+	// Full round 7.
+	s[0].Add(&s[0], &k.arcLast[2][0])
+	s[1].Add(&s[1], &k.arcLast[2][1])
+	s[2].Add(&s[2], &k.arcLast[2][2])
+	s[3].Add(&s[3], &k.arcLast[2][3])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	permute3MDS(s)
+
+	// Full round 8.
+	s[0].Add(&s[0], &k.arcLast[3][0])
+	s[1].Add(&s[1], &k.arcLast[3][1])
+	s[2].Add(&s[2], &k.arcLast[3][2])
+	s[3].Add(&s[3], &k.arcLast[3][3])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	permute3MDS(s)
+}
+
+func permute3MDS(s *[4]fr.Element) {
+	k := &permute3Constants
+	var n0, n1, n2, n3, prod fr.Element
+	n0.Mul(&k.mds[0][0], &s[0])
+	prod.Mul(&k.mds[0][1], &s[1])
+	n0.Add(&n0, &prod)
+	prod.Mul(&k.mds[0][2], &s[2])
+	n0.Add(&n0, &prod)
+	prod.Mul(&k.mds[0][3], &s[3])
+	n0.Add(&n0, &prod)
+	n1.Mul(&k.mds[1][0], &s[0])
+	prod.Mul(&k.mds[1][1], &s[1])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mds[1][2], &s[2])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mds[1][3], &s[3])
+	n1.Add(&n1, &prod)
+	n2.Mul(&k.mds[2][0], &s[0])
+	prod.Mul(&k.mds[2][1], &s[1])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mds[2][2], &s[2])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mds[2][3], &s[3])
+	n2.Add(&n2, &prod)
+	n3.Mul(&k.mds[3][0], &s[0])
+	prod.Mul(&k.mds[3][1], &s[1])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mds[3][2], &s[2])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mds[3][3], &s[3])
+	n3.Add(&n3, &prod)
+	s[0] = n0
+	s[1] = n1
+	s[2] = n2
+	s[3] = n3
+}
+
+func permute3MI(s *[4]fr.Element) {
+	k := &permute3Constants
+	var n0, n1, n2, n3, prod fr.Element
+	n0 = s[0]
+	n1.Mul(&k.mi[1][1], &s[1])
+	prod.Mul(&k.mi[1][2], &s[2])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mi[1][3], &s[3])
+	n1.Add(&n1, &prod)
+	n2.Mul(&k.mi[2][1], &s[1])
+	prod.Mul(&k.mi[2][2], &s[2])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mi[2][3], &s[3])
+	n2.Add(&n2, &prod)
+	n3.Mul(&k.mi[3][1], &s[1])
+	prod.Mul(&k.mi[3][2], &s[2])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mi[3][3], &s[3])
+	n3.Add(&n3, &prod)
+	s[0] = n0
+	s[1] = n1
+	s[2] = n2
+	s[3] = n3
+}
+
+func permute3Sparse(s *[4]fr.Element, v, wHat *[3]fr.Element) {
+	var n0, prod fr.Element
+	n0.Mul(&permute3Constants.m00, &s[0])
+	prod.Mul(&wHat[0], &s[1])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[0], &s[0])
+	s[1].Add(&s[1], &prod)
+	prod.Mul(&wHat[1], &s[2])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[1], &s[0])
+	s[2].Add(&s[2], &prod)
+	prod.Mul(&wHat[2], &s[3])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[2], &s[0])
+	s[3].Add(&s[3], &prod)
+	s[0] = n0
+}
+
+var permute4Constants = struct {
+	arcFirst   [5][5]fr.Element // rows 0..4: first full rounds and first partial round
+	arcPartial [30]fr.Element   // state[0] constant of partial rounds 2..31
+	arcLast    [4][5]fr.Element // last full rounds
+	mds, mi    [5][5]fr.Element
+	v, wHat    [31][4]fr.Element // sparse matrices of partial rounds 1..31
+	m00        fr.Element
+}{
+	arcFirst: [5][5]fr.Element{
+		[5]fr.Element(unrolledElements("3431064144647154854906922246941506182222173870772679525880648837636528462927", "5974593340237813855065980531800418392229182384079544474645411722799919145826", "8348426486881635683182335045339260090794248341687902711787330562710527330030", "2956151886560243922621332166670498751051583313292219792564998383355563424069", "7202553704068066642115231966909806322861037568682203181517551877916261334156")),
+		[5]fr.Element(unrolledElements("2216708299265206033085353198252811284979409151554807145353180393005345443076", "159335743353116328456368467219460934058466648656727531382446971993029171408", "6358764046359684972893522865101698862289416136086499847308829648457169884877", "4565669615674819431036641511394858343600542708840534960333061188699120679516", "8134340319422717456174214595572028038604616932665913260921485003742433978940")),
+		[5]fr.Element(unrolledElements("1524401355336014828526302364873243668741090019654727936642087684528900198959", "4002982571574570934955320971167280988768820199359033883158331219862407490167", "268956367875787697972162318645634654752917110456095075021347431474721377550", "5539077933627315832517588545053557934921428562542219829976540617497684332768", "8163159707574771497074276335511382452957675096097737520190925328662359500075")),
+		[5]fr.Element(unrolledElements("4977563953454029124270220726210548316848814835999821114089004208776001409529", "7008664649215929946792472256484126474139232333206086319663168135693982854505", "3318343362844228852737722392858921343864694811396087052570286467757103682210", "4587685140645381977725355169261358248758918671032202397129872095189579561454", "704287003752591898133347460228078707207754728734442590948457672014774127322")),
+		[5]fr.Element(unrolledElements("5586295855761311502524744594863435129218163429541929947376690582426001205171", "8391733011947895525356558103145755651586153996819227600307615142802375273331", "6630469393561382000400289179430524663489916295065821873771533616960029360303", "1109520911259740216914787569407573328462215971781929174269090087208161694995", "6874926153306861648013627560651588058347862924673776521132349794450940419088")),
+	},
+	arcPartial: [30]fr.Element(unrolledElements("3965838222357387993872732798941633989494138401156498885853618223672892943702", "6399330874905612017086413424496465904746021748409190767103673338943760515535", "5558814458953662099006280720401003483793254246900164433098794045435503006412", "2340334005966541365181685234682034352246831723606355760070814227471716001595", "1954675500092055897913532687157276063753498143943701408952567070814727487514", "340540208950639348832923102016109209530735947243286680089215875518687540898", "2357436854552785309870779235624098535300464298885690469173298757257964119382", "3462018934266014178766235487776804985839154608881430751397652918486234970711", "1044063049294168365170078402721462762809354873509453749957547584790816988020", "6813035649556733442610311764016897223557854040794825908924270300103109851523", "4044018067045516913844071009789290879317950625762117310142177153232888356096", "1868653203487115649738079005755236598598236367392937592062280205904849150705", "6726791394075334343472039691698127736015588617148304153991343544724067108670", "1950291999421357730085374423171662827148014629672012328031862523854380590895", "6219059633728751053000297668351499077163462894220216843725670049954008543854", "4780828557940162017578536262533899313797806039539721181698737035082268156790", "5721121878740456744438587389476758019420174562938687752981204125581570963430", "5867561211947410755601595351042042408403697149309194330843428878257208657895", "4252198417541141547075711778276656368556445552839728879545937030444322399346", "2778344699887055989432043464259025126615551124514948100518415041272882049506", "5578322346251698803632685531658794659194908225359318778607226777513305555016", "8232966839681821547401638407529690561966386822119502892256433852491422050653", "4499121225475114685411416095084202597958810236637732747105373511347971882428", "6713605836687446503601801827913011022165776475791754790834016333592596378929", "453347044754577053803100990372953718386538762077770596189181235520081931467", "4968956434668454517577486819898355337525380809048484775120963010080897245093", "5661201739272726272711005441152385472057143981405633370143408027990001543096", "6095650415124499639466126564698105792286651190045476625689204160063245637804", "4839067063734778239074829628109549897049105429856488718728300296695349725417", "7452097189792249619488027327168387314448343754189258074674033543077252341559")),
+	arcLast: [4][5]fr.Element{
+		[5]fr.Element(unrolledElements("1322652077946933817114865278733093858990311234699275468382653833423050745492", "5645215415246962740356127494957015951514599603859670006653165433427164693060", "720189655435077226693228902608508375844861111998842869300519328297727792886", "185200828324752242433717554979366507039533746746071572779724308985924103781", "5062274726469822209812100498705646842753986247467163742638203593135146892394")),
+		[5]fr.Element(unrolledElements("4985147731701280516122195490346401914592823270137114236360234768104112278629", "2887769780031221320189923358950180519938336707834750936438152238605771538185", "4215828315610408367904010758539873734811771712189857070943669192637602517003", "1543632720656628126125982421130157017436958675131518792186811247089700493653", "4832500060396630035991245052563913029405538648780355784888612789791420607598")),
+		[5]fr.Element(unrolledElements("5930257493267574500042037208093725122451773768067833261193177835090030231983", "6502901560147376485847653671976628642790817732181058349910853757232107023811", "3813185417582493541776744320547162746343924349873110184732754873079797171153", "6509768824040100638521305078471095204921310149573479294107390899486522843069", "3605647195366343121022521058580251119532209828115204053018233085119170067569")),
+		[5]fr.Element(unrolledElements("6769819025968586890565365669631295740031055074504963563488334106735520662613", "8078839985317492794953557685153658953919163145558294969245187929666873992250", "1394681604050412530833331610672526944507542631579572154759809162329384560638", "7104554881094887062843081388750064718663992515537917313436084280540113848013", "480898963303594644926304962428204837944253172605670175725141207630587527124")),
+	},
+	mds: [5][5]fr.Element{
+		[5]fr.Element(unrolledElements("6755569399542696339399059951025237225100719468123251062348186764733927391233", "7037051457856975353540687448984622109479916112628386523279361213264507699201", "7238110070938603220784707090384182741179342287274911852515914390786350776321", "7388904030749824121217721821433853214953911918259805849443329273927733084161", "4691367638571316902360458299323081406319944075085591015519574142176338466134")),
+		[5]fr.Element(unrolledElements("7037051457856975353540687448984622109479916112628386523279361213264507699201", "7238110070938603220784707090384182741179342287274911852515914390786350776321", "7388904030749824121217721821433853214953911918259805849443329273927733084161", "4691367638571316902360458299323081406319944075085591015519574142176338466134", "7600015574485533381823942444903391878238309401638657445141710110325668315137")),
+		[5]fr.Element(unrolledElements("7238110070938603220784707090384182741179342287274911852515914390786350776321", "7388904030749824121217721821433853214953911918259805849443329273927733084161", "4691367638571316902360458299323081406319944075085591015519574142176338466134", "7600015574485533381823942444903391878238309401638657445141710110325668315137", "2303035022571373752067861346940421781284336182314744680345972760704747974284")),
+		[5]fr.Element(unrolledElements("7388904030749824121217721821433853214953911918259805849443329273927733084161", "4691367638571316902360458299323081406319944075085591015519574142176338466134", "7600015574485533381823942444903391878238309401638657445141710110325668315137", "2303035022571373752067861346940421781284336182314744680345972760704747974284", "7740756603642672888894756193883084320427907723891225175607297334590958469121")),
+		[5]fr.Element(unrolledElements("4691367638571316902360458299323081406319944075085591015519574142176338466134", "7600015574485533381823942444903391878238309401638657445141710110325668315137", "2303035022571373752067861346940421781284336182314744680345972760704747974284", "7740756603642672888894756193883084320427907723891225175607297334590958469121", "7794887768703111160845069174259889105885445540142212764247907805462223912961")),
+	},
+	mi: [5][5]fr.Element{
+		[5]fr.Element(unrolledElements("1", "0", "0", "0", "0")),
+		[5]fr.Element(unrolledElements("0", "2138384120729133216210238944536639351029529198063656086340090677519769031785", "430925869256598581059979619901622810893480096621472576118402321696822616657", "6905791023730966757334096695699090540988660683321146514989458500760491707946", "2148194301420875315630783057614992035703912353905886284294982957194336680745")),
+		[5]fr.Element(unrolledElements("0", "430925869256598581059979619901622810893480096621472576118402321696822616657", "7096083034150645230759965969905978274946678846515557153417251931105695912391", "5932351284612910830979191152746502185334904741261705765154279133226164981698", "155498876447134131877729347742232404866785860221427568690704209608337650659")),
+		[5]fr.Element(unrolledElements("0", "6905791023730966757334096695699090540988660683321146514989458500760491707946", "5932351284612910830979191152746502185334904741261705765154279133226164981698", "2350518484718685128114115771956300873208158044689806042328875264126064229958", "3801529679297239517487911434821356384842444302894233969474469799744519903456")),
+		[5]fr.Element(unrolledElements("0", "2148194301420875315630783057614992035703912353905886284294982957194336680745", "155498876447134131877729347742232404866785860221427568690704209608337650659", "3801529679297239517487911434821356384842444302894233969474469799744519903456", "161064141789703918112375350481687221800645059701191629113807689376043815676")),
+	},
+	v: [31][4]fr.Element{
+		[4]fr.Element(unrolledElements("2547103479123232769233080593620055920761752853796241065153308233154878575619", "7897284610910869169727762396873354222747288275331012265472520461429230716606", "7856056754821541433644759192730501490727787655708175550478236340526889561672", "1269848960708840219570534036834684287699977498945547712717199954591875972294")),
+		[4]fr.Element(unrolledElements("2120618029047114205224326650800039459864330639346758462629614062092185287850", "12415919677789867157640623442034846236990231817049027160821671860823947950", "3996001719876120888023303636217577274940634204670054920681551945527488294550", "2370506370778361865418536768401442133330395122579027271727633029189437839137")),
+		[4]fr.Element(unrolledElements("416117487422025150973045892837849316215884133246552744666713108400496123110", "4596794245069030174934413249684378841115789128330901973892076209626356163453", "4476635897223083721242202735903588461264722473527033795900430463553313137039", "6239129240555152458411618176840083369027087411275697602672800535420241445033")),
+		[4]fr.Element(unrolledElements("5055986438933843320191883832368873364317518240116572837638530203232324514214", "5107187010568846508576947375783223588380097816707453186027011866228251820997", "1527155704876225029075283178539002144667957821730837586876720452831412121542", "83286232743231027633079807253047312991621921700300791446662313822423740290")),
+		[4]fr.Element(unrolledElements("7125640106765472955270607211134272276203230836128053469725613782985442865271", "2180476554376898623484277820689382499291011715851343072522196265764954291756", "38244987879528426542293296038751925940824504066193680982782573408893083808", "7855613662501313255649930474266680604147161519202969539768717845815826486841")),
+		[4]fr.Element(unrolledElements("3643162821388047597970457214669523147688264652133075653816761827723491735045", "3746322873550875690979057076192948762016325867974055854734499355952086242080", "7353491477684055686670265835353779576876941836455471406278117639892940509839", "5346483159704445679599865671815286565471808805775495135094281205547095609151")),
+		[4]fr.Element(unrolledElements("7928803288514043069953618480447888992266878100476306800693279233200985051029", "6479343364072988838873072909314473020091768609709398756458063578053696923295", "4434395019193169266376831601994730023199880341194868463551149883442489380082", "3131373309041153411100327199836118901809968560018735631870396645754304483564")),
+		[4]fr.Element(unrolledElements("2040138786635396859347000144795080313464950406231185013519129644129525513031", "256060681861758214579293438089056341586094518727524321304997399150923955748", "7895053450250058546899934592009133475553071409362085222130456691957607672403", "7231685666803712841031131387084562867797854326747738610367605509107875369869")),
+		[4]fr.Element(unrolledElements("2035976088044982521002244992226905770103287798560197492178682638945273560223", "3748845008706170057393436580531906525256806972970027022383676914779783393770", "3925274757659112116531148285004973609768370532274840958695263812832533277558", "5965655044763142880522083648980709139609670317051589020361672943251890637758")),
+		[4]fr.Element(unrolledElements("8355077891935195965636780598114384991522387608183568473418584424239623706144", "2395998216471419690539030043160399146748927054554741632399317733548193774640", "4911199399137336421581925578885871898641848466079377965475407249142657415120", "8282472002730039166457902080257450875586583951812902439081859677205227038044")),
+		[4]fr.Element(unrolledElements("5146350314785980429495014005720178366507811095593675568941623046717615145844", "611125123113631169302288784250537208558041866202453194644031553350958094723", "831595831080353913407905706192098025224051185124067282951606509834528216084", "1761239959767681641499399040958499124277816674209861834163241497657123526507")),
+		[4]fr.Element(unrolledElements("6106335870275206321884466645892920597752410233200754229315388857462278579731", "7467386598914502373332758942732190720625316109408212760281705978324855701650", "1551786634777214502069901922305416941913822714689477451325915034725719533371", "3466500200584207147032242453306266143878825802251700246817023516920556683284")),
+		[4]fr.Element(unrolledElements("4632917325047214647439739098126112871783575749914769689313563284458758801221", "6451452994982073840370160322982027164291872056663366751434029645452801445199", "3432373127502422641782182662002421380407799262996272602743294942636272069608", "1316315767500662961923153672954995829778297399341325960570566793292339065457")),
+		[4]fr.Element(unrolledElements("2050510628665020598163759803704813870250308407096768628367266423375767523308", "1147702008784885247016214874553404165222986735703612060876612264638305325081", "4697966359508119859326117906022250293622459077784506316415324252737792955160", "119104870019254797951597780497177343456795739605918964890197392759600664956")),
+		[4]fr.Element(unrolledElements("1714104864686782325311974422227029341005379520900921589669354379983831096791", "6355900711983048945424422007473283840527918461797705007601376935405326826270", "6205984082382470048440703786425214225955343359113630911991148473187132254618", "2076639151294816049459578860566204028445513987881719318661867323279714556333")),
+		[4]fr.Element(unrolledElements("4851022331350489214192529408640061599476172849031205949893936972214456654861", "569544777994808532812657599082478580355343656816638141625588562619721655294", "3597364319232779316358335570556114783424283640010607206642595629430943742091", "7713659050528749580400659918232464057230995444599386683240849056296517494988")),
+		[4]fr.Element(unrolledElements("5076611145057315806380004611796532954436863153873426415905889496552529634772", "6902081102527110237382583596515019488448884807358018131179640480646540323029", "744041210090521747176403212996560220609644796808826324835948815691090019863", "538993509700570276425841464689493859828855186671495437568951258148459819011")),
+		[4]fr.Element(unrolledElements("4646322751854801234731352489207644268662735260647550764462485273822182817382", "1106177822527344852088029853064171844795159920222752093412361513449207699117", "7615977070072120902680534995679450341413696413443614226507798998614235255072", "2616856936311864505955976015708291307959425442749790010438387383118852531080")),
+		[4]fr.Element(unrolledElements("5634982846356552909168661951455526441777684276429624078606664959779494619886", "5769199807227671801807154686153688462327540110682030921858648823770292295337", "3770184081544028155731225962265091006486124963723934965109585385773663487697", "6873287763770813246328369787694155655491749572150910186277102817462904104737")),
+		[4]fr.Element(unrolledElements("8134741963073580335638897620653689595895332306563951177264099671206793234068", "3916367573176870199453445193421006095443930283793440358480552644010986847555", "2807443924479944961063047990577549211699999696870636222333749953986165100628", "20730024138333705543940448323310426825384998917533759959583780955280460090")),
+		[4]fr.Element(unrolledElements("3338813109580633906063869989265057378617704595266624954767878392859302848451", "8435047133814619295029990748210722482202135477420117111253773682245006266199", "2252377947646424354821883714724169224519980725813350347575507063637316485012", "7804489943271469550859755590908810630620936052654320832407623115458304643761")),
+		[4]fr.Element(unrolledElements("3658883970918202138484393719491752911081750846910156163090097729080963461041", "7204901915144243045966726229598350678275522193200994363940595418294368138623", "1624610982273463395799733328098985092002310069330254054246646792362848308128", "7330482401692680977272729694619185888207758189295195329046170260288363014708")),
+		[4]fr.Element(unrolledElements("3448696766506763064921488664122067683388109240378070308156676709448926876778", "1974971184441122009556496772115375292962161002530812832212668507144331588493", "1315679272484053391238025676263754412872100486765166420556776065530843202901", "7458140051479294430085132910723185974351606578642170493043406916481274243555")),
+		[4]fr.Element(unrolledElements("230180567561092428581461883869909628734863902172888610151585847129315749362", "4642835576168619384011371876621697179766987465802367981785867935128464838010", "4934030569770971622965603811469514476668986112356963598588634127155387519690", "3503691230462160252789893382664327522080544849459335962247315516490097579596")),
+		[4]fr.Element(unrolledElements("6518730623141954010306573644275105531897044590355326935372241612175159980570", "5394255754549239290175178885040217635130396352182181394460317349783700291032", "3404706260422562719684687102499928656336851279333066109544800862070196420903", "4043797845053143220691535549606355794819868823953702603614029735841702602387")),
+		[4]fr.Element(unrolledElements("7699747998683667190573326257257443176434334489687357180200982933203966818594", "3880918486211403227125357133790845825293271765098151772549073002511882350498", "5018897129106413988424023024305652893432395045250364584459515179910357702192", "7100278641311820396566427396761409707978201825688133224217965713445183697663")),
+		[4]fr.Element(unrolledElements("7162544644923667500915112116076133309653944596008969459846495169522896251360", "3373997690100573811383036562499702287411397323003071231117089166427441580682", "5895327729868574013621755571328634355360492054173600445413603419687774128778", "3655153155390178344598925297480751155136587960411459630195285823672776377034")),
+		[4]fr.Element(unrolledElements("4012733397339688185029782756606728191968175736528982577351136329934898708220", "6262697295631829628859674125336982462934889453875154476673688239862385874931", "5085825659450544986082129430646888569533264228643197338100774443583723457903", "8214296039282360463957805777056728734288816395766627805951417382166579814908")),
+		[4]fr.Element(unrolledElements("1807245950314576945323485069523429782775508782500049071871833290319833575427", "7893264646003823461671300545655338425730441117932100366170290567268122019144", "8265906368109070139668510059293657506938287419087529560556675186900440924495", "1337631178228955711149035970161558697810911925638959749399418478053608064614")),
+		[4]fr.Element(unrolledElements("7881497632799812395965569942862776762617506046143792906072884558856248623105", "6408286340583520932341207843153268861256256075551980039121413094468925712571", "1340086119615274224252185458358586695220872336772825641381208224119072267047", "4451415431892692315692398844425511362212228553892495707821439480226537458099")),
+		[4]fr.Element(unrolledElements("7037051457856975353540687448984622109479916112628386523279361213264507699201", "7238110070938603220784707090384182741179342287274911852515914390786350776321", "7388904030749824121217721821433853214953911918259805849443329273927733084161", "4691367638571316902360458299323081406319944075085591015519574142176338466134")),
+	},
+	wHat: [31][4]fr.Element{
+		[4]fr.Element(unrolledElements("4417541017220834592601154115220651649100169323531829869051424072294706352304", "3930235853886293923058395599435827711942396145670762284199199877051063687896", "1113459174453328932240774560447435803788728850389316669790526976479823190967", "3897319440193179061342594292137286614859181559440152645716298371919328123888")),
+		[4]fr.Element(unrolledElements("5871441182232839872950823593835505313731115211539846303632242245521748336252", "7740965820227823530156434833692069114571977612482372653706082622805514699995", "7331871753577981110657588144787662468789463484664168593743832625479487458612", "5544760211747097446844773606780952308162542889260931361929139749102706169513")),
+		[4]fr.Element(unrolledElements("6456132847630184396504039964646929845701742244842000973106362357072558976071", "6217608205768582304235137982912939040685010935224764469723948238113987126433", "443960645005857651345828512221521058537348357008393130806643951434047086752", "4753453807492578612925484448362122996553543129312837682265698844924484558075")),
+		[4]fr.Element(unrolledElements("2978149858601239328121254971240317223551560877657845512747451621609502995207", "6629506352332550014357138340553567208953894178602260967213101973416533455776", "8390418494404824363562791747004489520829339721445366443098648879040960900616", "2186303554319090221638668665835362129975142112962341751514027513096340467665")),
+		[4]fr.Element(unrolledElements("5957072848649616515873186902770275998866445560298038481080045154011837206449", "5215258190858723091412123085613303099314930531987660188079461925474755980594", "2224560393596634669427143232487112413813928936566792075526194710568333253273", "3986269683690075554453656585259805302527870103632405295494678478718130750908")),
+		[4]fr.Element(unrolledElements("2128612164934423112748251853934390066981266464931170771856440022551352116238", "6764516936742548565729697260329582792894124209281822837218325640225323213276", "3287320424716585772069196053256591902204500504857166726822649648381315166178", "2391520958092812925890702861334251028669964184529558461473866883608527569720")),
+		[4]fr.Element(unrolledElements("614729849208207161925974936985138958352853331431773565831693637299072074148", "2378424569492949224963272034350260366315379900153500765825409497949642063535", "6324246262442616162271799523532072108081467389465559180974898583558995294996", "5622122243842417058201028636429294191394865991095798875922692865649322362370")),
+		[4]fr.Element(unrolledElements("5922523402602629959990792280972466743807524055155444640062431175839939640635", "43107947230284832968938017863302867185791527167921751354515147495917355136", "8258692506632848148111935550005451527894872169055340507310206033969083482338", "977355271743417035295939913549754050269040535384454724797032715083259091510")),
+		[4]fr.Element(unrolledElements("3877417423931764816229013679469055872316720243753441131569840278581889859935", "3679245451573871703996029538042277557601685182154304963207911501660224909014", "2185515957860175492089955633419281758958993124028687367987264728207460318637", "3072090016768203366290930196466710860467160632623628217824036466603335840894")),
+		[4]fr.Element(unrolledElements("1235470829699865478220361655539125402361093071203941821256378197664553417307", "6771460440074668793157514492470625799777487658651367913625948476832200287787", "818421747180053645304248591258749247830389219300331971408995552616190823177", "1667208153711222845805460411010299470073097046240132937297109810939462314051")),
+		[4]fr.Element(unrolledElements("620442506596716843752021578297386055932452137357085645450245776839258188263", "6136531434460682084028745055011686479912049709547450492544065226171421695531", "3923609867366353419207719839092795893359166541793499533459599412496375713176", "1131439386242437924012306454710255109701985377488919913193338934568005718058")),
+		[4]fr.Element(unrolledElements("3143958666817035906600867216379496035268863940369184345914787474816424133956", "4664382549441513350922842667310727289338604394835914307092247989610061253376", "417069836579026464600380714376936561596995853137158089964287163406829351932", "6118304736267276038896588255931685257291894300609594861564722035310130651022")),
+		[4]fr.Element(unrolledElements("8311886661817520064334417681608000213064263766588361772050679871113471342056", "118825293318917925643375939562544388214009980745421132404998573642716548402", "3930998725612814064837733252370747874483387348478156374582969551567720525408", "6176650730992866654519754359559849418479639444055517701269861658681464336474")),
+		[4]fr.Element(unrolledElements("1727253903421378641984239228288289152914830794614345260093368662972240276200", "2586825853789045313087665779427379353980634132577895462983251401050414999451", "8349835322486994911058186483248558578953177684827573461744671264428362956877", "5357114892160892247763327322321331896086030579971316449106295423855225128979")),
+		[4]fr.Element(unrolledElements("7217535999468983354199414176005171871235049431432948579663665661570070506938", "7685270478326438253876359084879936513768111365316448312917683286564645177454", "2538931957166067300969100905073527861005053800205404499237574636954543546291", "6658257428934571553690135925316210612017854976550423552075318898208472870610")),
+		[4]fr.Element(unrolledElements("1163646925945490717631836311168688527445341565836154314822149762893916298833", "7336522063860959626725610939508845711128785023679074944472499085746419775758", "7977253681011363511052854584561542088744993941489269835685624486889180048334", "6249276581582202557237401296819684143727048153346625122735390813988245734220")),
+		[4]fr.Element(unrolledElements("5241861450296384287079851043682233419738359190155547488029737658047894339025", "6678776109614958850470018780254289485708352263785775134681041213063694265050", "7380315511711642758725205867681909040056135359693482522863196065796203775578", "3066544636407850157713893892878041530281074296359999668696930431683417087292")),
+		[4]fr.Element(unrolledElements("7354827846427448413270885936751241947792652925979739583947138284174997231750", "4412223378052098937642592631972483067460807278121291371816770863671129413630", "7209347833439310337756350466853679826890270509691831333559258957581197787950", "8182740858060105474478836377982927458541006827523940122489493834618857115703")),
+		[4]fr.Element(unrolledElements("5748166919628348161967854967505473580632756709490802715424747454193578852676", "5497321836632578531192368649598224910089844485535207438939200532607393136721", "996385884157428583618520192713934508133927299185234457001925051834889684932", "14569418789086142266290603292811115699386293356931371633619352839396573533")),
+		[4]fr.Element(unrolledElements("3393165188949208429097596504575610918348289351728012475955836047972469746347", "7204944558504244124518521960216915771891791873867800909615650431905610558904", "2120393345382531553995609688203757288976037123993199052013404464011798680137", "5638818165103244107186726336375714461836064184337399349534120933263852216971")),
+		[4]fr.Element(unrolledElements("2814820685379449176026616665504052076169026290107351646099685110372469746347", "3619054603101714647310043630542996558327680464771616450386844579164603959589", "2412703945063685769379408274819105829490074908797477683092836460776402639726", "1072312344082474827694833443716539604076193157389159880633969390922845617656")),
+		[4]fr.Element(unrolledElements("2814820583142808214264790588893687216317178907339261907248755000932469746347", "2412703356979457975304041111246343871776522299774473366670317543656402639726", "3619055035469405568756039962174782129129877605821397084971624110684603959589", "5897719317061038317738760385657339553512748086599565397378530693915650897108")),
+		[4]fr.Element(unrolledElements("2814820583142790141419469800869821755001951411295885864909727891556469746347", "4825406713959068813842960220797677047530988404782518361428195639504805279452", "6031758392448836017338966444221089846483330430452020612203572632389006599315", "2278664281591782495424128430177828907381312649284878827234443884927046937519")),
+		[4]fr.Element(unrolledElements("2814820583142790141416274980158612222215330072571933647736447366462069746347", "2412703356979534406928235694406294884726060234387358460796474534453202639726", "3619055035469301610392353548440714665373381744512999724441201185631803959589", "1072312603102015291968104752700145638704856665849353616627070425649245617656")),
+		[4]fr.Element(unrolledElements("2814820583142790141416274979593848943627808908896521258858178651368309746347", "3619055035469301610392353545192090948375343072665391436948311694520123959589", "574274210887796403597026954537444480000000", "7104070995550851309288693996117808733776056110805915639609695514440012216971")),
+		[4]fr.Element(unrolledElements("2814820583142790141416274979593848843791984093496387107935196475177365746347", "2412703356979534406928235696794727580393039458972786875844307033233170639726", "101517116441390185985989409672000000", "1072312603102015291968104754130990035730228218600851278057603946526109617656")),
+		[4]fr.Element(unrolledElements("2814820583142790141416274979593848843791966445054474406815670366956428146347", "2412703356979534406928235696794727580393114095745110060005635308041757839726", "1206351678489767203464117848397363790196557047897097627052196445742846119863", "4691367638571316902360458299323081406319944075077686918073382411859961177245")),
+		[4]fr.Element(unrolledElements("2814820583142790141416274979593848843791966445051354609863245588054368706347", "7238110070938603220784707090384182741179342287274911850183569059838995599178", "6031758392448836017320589241986818950982785239395759880268931400489345819315", "4691367638571316902360458299323081406319944075085591014122330201646382217245")),
+		[4]fr.Element(unrolledElements("2814820583142790141416274979593848843791966445051354609311744582798284330347", "8444461749428370424248824938781546531375899335154063827935233043616198291041", "6031758392448836017320589241986818950982785239395759877096595886443067547315", "3485015960081549698896340450925717616123387027206439040100254830049465248382")),
+		[4]fr.Element(unrolledElements("2814820583142790141416274979593848843791966445051354609311744485305820360947", "2412703356979534406928235696794727580393114095758303950838638130262043966526", "99098450", "7104070995550851309288693996117808986713058170843894966358212272438411793371")),
+		[4]fr.Element(unrolledElements("2814820583142790141416274979593848843791966445051354609311744485305803079687", "6031758392448836017320589241986818950982785239395759877096595325655292313585", "2412703356979534406928235696794727580393114095758303950838638130262116925456", "2881840120836666097164281526727035721025108503266863052390595544479750772048")),
+	},
+	m00: unrolledElements("6755569399542696339399059951025237225100719468123251062348186764733927391233")[0],
+}
+
+// permute4 is the rate-4 permutation (width 5).
+func permute4(s *[5]fr.Element) {
+	k := &permute4Constants
+
+	// Full round 1.
+	s[0].Add(&s[0], &k.arcFirst[0][0])
+	s[1].Add(&s[1], &k.arcFirst[0][1])
+	s[2].Add(&s[2], &k.arcFirst[0][2])
+	s[3].Add(&s[3], &k.arcFirst[0][3])
+	s[4].Add(&s[4], &k.arcFirst[0][4])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	permute4MDS(s)
+
+	// Full round 2.
+	s[0].Add(&s[0], &k.arcFirst[1][0])
+	s[1].Add(&s[1], &k.arcFirst[1][1])
+	s[2].Add(&s[2], &k.arcFirst[1][2])
+	s[3].Add(&s[3], &k.arcFirst[1][3])
+	s[4].Add(&s[4], &k.arcFirst[1][4])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	permute4MDS(s)
+
+	// Full round 3.
+	s[0].Add(&s[0], &k.arcFirst[2][0])
+	s[1].Add(&s[1], &k.arcFirst[2][1])
+	s[2].Add(&s[2], &k.arcFirst[2][2])
+	s[3].Add(&s[3], &k.arcFirst[2][3])
+	s[4].Add(&s[4], &k.arcFirst[2][4])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	permute4MDS(s)
+
+	// Full round 4.
+	s[0].Add(&s[0], &k.arcFirst[3][0])
+	s[1].Add(&s[1], &k.arcFirst[3][1])
+	s[2].Add(&s[2], &k.arcFirst[3][2])
+	s[3].Add(&s[3], &k.arcFirst[3][3])
+	s[4].Add(&s[4], &k.arcFirst[3][4])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	permute4MDS(s)
+
+	// First partial round: the constants of row 4 go through M_i.
+	s[0].Add(&s[0], &k.arcFirst[4][0])
+	s[1].Add(&s[1], &k.arcFirst[4][1])
+	s[2].Add(&s[2], &k.arcFirst[4][2])
+	s[3].Add(&s[3], &k.arcFirst[4][3])
+	s[4].Add(&s[4], &k.arcFirst[4][4])
+	permute4MI(s)
+
+	// Partial round 1.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[0])
+	permute4Sparse(s, &k.v[0], &k.wHat[0])
+
+	// Partial round 2.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[1])
+	permute4Sparse(s, &k.v[1], &k.wHat[1])
+
+	// Partial round 3.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[2])
+	permute4Sparse(s, &k.v[2], &k.wHat[2])
+
+	// Partial round 4.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[3])
+	permute4Sparse(s, &k.v[3], &k.wHat[3])
+
+	// Partial round 5.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[4])
+	permute4Sparse(s, &k.v[4], &k.wHat[4])
+
+	// Partial round 6.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[5])
+	permute4Sparse(s, &k.v[5], &k.wHat[5])
+
+	// Partial round 7.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[6])
+	permute4Sparse(s, &k.v[6], &k.wHat[6])
+
+	// Partial round 8.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[7])
+	permute4Sparse(s, &k.v[7], &k.wHat[7])
+
+	// Partial round 9.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[8])
+	permute4Sparse(s, &k.v[8], &k.wHat[8])
+
+	// Partial round 10.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[9])
+	permute4Sparse(s, &k.v[9], &k.wHat[9])
+
+	// Partial round 11.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[10])
+	permute4Sparse(s, &k.v[10], &k.wHat[10])
+
+	// Partial round 12.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[11])
+	permute4Sparse(s, &k.v[11], &k.wHat[11])
+
+	// Partial round 13.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[12])
+	permute4Sparse(s, &k.v[12], &k.wHat[12])
+
+	// Partial round 14.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[13])
+	permute4Sparse(s, &k.v[13], &k.wHat[13])
+
+	// Partial round 15.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[14])
+	permute4Sparse(s, &k.v[14], &k.wHat[14])
+
+	// Partial round 16.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[15])
+	permute4Sparse(s, &k.v[15], &k.wHat[15])
+
+	// Partial round 17.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[16])
+	permute4Sparse(s, &k.v[16], &k.wHat[16])
+
+	// Partial round 18.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[17])
+	permute4Sparse(s, &k.v[17], &k.wHat[17])
+
+	// Partial round 19.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[18])
+	permute4Sparse(s, &k.v[18], &k.wHat[18])
+
+	// Partial round 20.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[19])
+	permute4Sparse(s, &k.v[19], &k.wHat[19])
+
+	// Partial round 21.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[20])
+	permute4Sparse(s, &k.v[20], &k.wHat[20])
+
+	// Partial round 22.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[21])
+	permute4Sparse(s, &k.v[21], &k.wHat[21])
+
+	// Partial round 23.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[22])
+	permute4Sparse(s, &k.v[22], &k.wHat[22])
+
+	// Partial round 24.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[23])
+	permute4Sparse(s, &k.v[23], &k.wHat[23])
+
+	// Partial round 25.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[24])
+	permute4Sparse(s, &k.v[24], &k.wHat[24])
+
+	// Partial round 26.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[25])
+	permute4Sparse(s, &k.v[25], &k.wHat[25])
+
+	// Partial round 27.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[26])
+	permute4Sparse(s, &k.v[26], &k.wHat[26])
+
+	// Partial round 28.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[27])
+	permute4Sparse(s, &k.v[27], &k.wHat[27])
+
+	// Partial round 29.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[28])
+	permute4Sparse(s, &k.v[28], &k.wHat[28])
+
+	// Partial round 30.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[29])
+	permute4Sparse(s, &k.v[29], &k.wHat[29])
+
+	// Partial round 31.
+	exp17(&s[0])
+	permute4Sparse(s, &k.v[30], &k.wHat[30])
+
+	// Full round 5.
+	s[0].Add(&s[0], &k.arcLast[0][0])
+	s[1].Add(&s[1], &k.arcLast[0][1])
+	s[2].Add(&s[2], &k.arcLast[0][2])
+	s[3].Add(&s[3], &k.arcLast[0][3])
+	s[4].Add(&s[4], &k.arcLast[0][4])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	permute4MDS(s)
+
+	// Full round 6.
+	s[0].Add(&s[0], &k.arcLast[1][0])
+	s[1].Add(&s[1], &k.arcLast[1][1])
+	s[2].Add(&s[2], &k.arcLast[1][2])
+	s[3].Add(&s[3], &k.arcLast[1][3])
+	s[4].Add(&s[4], &k.arcLast[1][4])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	permute4MDS(s)
+
+	// Full round 7.
+	s[0].Add(&s[0], &k.arcLast[2][0])
+	s[1].Add(&s[1], &k.arcLast[2][1])
+	s[2].Add(&s[2], &k.arcLast[2][2])
+	s[3].Add(&s[3], &k.arcLast[2][3])
+	s[4].Add(&s[4], &k.arcLast[2][4])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	permute4MDS(s)
+
+	// Full round 8.
+	s[0].Add(&s[0], &k.arcLast[3][0])
+	s[1].Add(&s[1], &k.arcLast[3][1])
+	s[2].Add(&s[2], &k.arcLast[3][2])
+	s[3].Add(&s[3], &k.arcLast[3][3])
+	s[4].Add(&s[4], &k.arcLast[3][4])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	permute4MDS(s)
+}
+
+func permute4MDS(s *[5]fr.Element) {
+	k := &permute4Constants
+	var n0, n1, n2, n3, n4, prod fr.Element
+	n0.Mul(&k.mds[0][0], &s[0])
+	prod.Mul(&k.mds[0][1], &s[1])
+	n0.Add(&n0, &prod)
+	prod.Mul(&k.mds[0][2], &s[2])
+	n0.Add(&n0, &prod)
+	prod.Mul(&k.mds[0][3], &s[3])
+	n0.Add(&n0, &prod)
+	prod.Mul(&k.mds[0][4], &s[4])
+	n0.Add(&n0, &prod)
+	n1.Mul(&k.mds[1][0], &s[0])
+	prod.Mul(&k.mds[1][1], &s[1])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mds[1][2], &s[2])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mds[1][3], &s[3])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mds[1][4], &s[4])
+	n1.Add(&n1, &prod)
+	n2.Mul(&k.mds[2][0], &s[0])
+	prod.Mul(&k.mds[2][1], &s[1])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mds[2][2], &s[2])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mds[2][3], &s[3])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mds[2][4], &s[4])
+	n2.Add(&n2, &prod)
+	n3.Mul(&k.mds[3][0], &s[0])
+	prod.Mul(&k.mds[3][1], &s[1])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mds[3][2], &s[2])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mds[3][3], &s[3])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mds[3][4], &s[4])
+	n3.Add(&n3, &prod)
+	n4.Mul(&k.mds[4][0], &s[0])
+	prod.Mul(&k.mds[4][1], &s[1])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mds[4][2], &s[2])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mds[4][3], &s[3])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mds[4][4], &s[4])
+	n4.Add(&n4, &prod)
+	s[0] = n0
+	s[1] = n1
+	s[2] = n2
+	s[3] = n3
+	s[4] = n4
+}
+
+func permute4MI(s *[5]fr.Element) {
+	k := &permute4Constants
+	var n0, n1, n2, n3, n4, prod fr.Element
+	n0 = s[0]
+	n1.Mul(&k.mi[1][1], &s[1])
+	prod.Mul(&k.mi[1][2], &s[2])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mi[1][3], &s[3])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mi[1][4], &s[4])
+	n1.Add(&n1, &prod)
+	n2.Mul(&k.mi[2][1], &s[1])
+	prod.Mul(&k.mi[2][2], &s[2])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mi[2][3], &s[3])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mi[2][4], &s[4])
+	n2.Add(&n2, &prod)
+	n3.Mul(&k.mi[3][1], &s[1])
+	prod.Mul(&k.mi[3][2], &s[2])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mi[3][3], &s[3])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mi[3][4], &s[4])
+	n3.Add(&n3, &prod)
+	n4.Mul(&k.mi[4][1], &s[1])
+	prod.Mul(&k.mi[4][2], &s[2])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mi[4][3], &s[3])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mi[4][4], &s[4])
+	n4.Add(&n4, &prod)
+	s[0] = n0
+	s[1] = n1
+	s[2] = n2
+	s[3] = n3
+	s[4] = n4
+}
+
+func permute4Sparse(s *[5]fr.Element, v, wHat *[4]fr.Element) {
+	var n0, prod fr.Element
+	n0.Mul(&permute4Constants.m00, &s[0])
+	prod.Mul(&wHat[0], &s[1])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[0], &s[0])
+	s[1].Add(&s[1], &prod)
+	prod.Mul(&wHat[1], &s[2])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[1], &s[0])
+	s[2].Add(&s[2], &prod)
+	prod.Mul(&wHat[2], &s[3])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[2], &s[0])
+	s[3].Add(&s[3], &prod)
+	prod.Mul(&wHat[3], &s[4])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[3], &s[0])
+	s[4].Add(&s[4], &prod)
+	s[0] = n0
+}
+
+var permute5Constants = struct {
+	arcFirst   [5][6]fr.Element // rows 0..4: first full rounds and first partial round
+	arcPartial [30]fr.Element   // state[0] constant of partial rounds 2..31
+	arcLast    [4][6]fr.Element // last full rounds
+	mds, mi    [6][6]fr.Element
+	v, wHat    [31][5]fr.Element // sparse matrices of partial rounds 1..31
+	m00        fr.Element
+}{
+	arcFirst: [5][6]fr.Element{
+		[6]fr.Element(unrolledElements("845774603178492790430819572559948899425806472242990359595837698866402459283", "5104113060992167207950156191834052800250377917110335646977277694909225606639", "606930867215997902681408163383822852644874536120525401359262311657265176286", "3246189740533673369325580437595636125853593448497295559526133710916170023325", "2228557437175639577799724834018880269762506939154314745471148408315031040782", "5248714497354123146476603303736275602516846846345343189695992364680008748274")),
+		[6]fr.Element(unrolledElements("4468660183396113816417160760392174946434715734046476615756949516900460245783", "7393542747111210649333111074982387945081968569023142501741239756021903723895", "7199392773674619989102817361994783385540000190771324931792999149811086723727", "4179131455162240247318123589436646198639084830612188212185789176671108496032", "24442689501566866103376042248929386407192919728660696030608041405438957133", "3097787510685557034777264781234655318746563426795026202239222093602417399558")),
+		[6]fr.Element(unrolledElements("6574238658366226506536641479793831030684930753068170934418220861949357860591", "3230736603041831131224401646930510293150560782678475479524520009468240625703", "4449397682136210685399058399525408353602443323667935079724525731105410330857", "1462805245535093331717840709705014862484623276551060803969576565098857503359", "5430193997486659445858726501908887547497708817666434922669358130281149310067", "8182992080105598491896178755005741632616297373995328206793063427977612612424")),
+		[6]fr.Element(unrolledElements("2470198070508873630088316330360042099497336224174355851511490652176698623455", "616202094790463990913669657355701717237705251976201488136276464144059917047", "4300832028822930023146879288491662568841103305714742296374296369784204253894", "3476274661803244432899568134341751009082965077671317942410157824155554772073", "5064187415312733563044421812957719834749578846215859205757776767013163169207", "2785720285300546669174088113375060468705507039789824059878564962780494844393")),
+		[6]fr.Element(unrolledElements("3955439879096535600770596087986987521484177541728792312152110382971886552324", "503680037339076032856815489377858587415355113560755157086717098432815716841", "5964553105116402172916680761616166920975463210054977222482002500286412179060", "7281158201578211076266982138746435442933971728104518923259322554020752212264", "2241343432184659572884353882416005796993328095513207173919122103820341809513", "4853474723618434181335137108301073507880281739605909113444950270026187208176")),
+	},
+	arcPartial: [30]fr.Element(unrolledElements("2266484297898603139651464188099311881951612550072221529849601819688663614860", "2834480596359285257722679812165553631677121028793347973736474916876436840226", "3955655794240894760428011546576522693511264260766201184351196104203109672892", "6440795831658995228689638941764634235317879901726596500646803387380683298066", "7509995303856628178658864238317388391703173442817405478557680610554161559518", "3550320773765760050621750445990832702705780121450024814732192552193040091427", "2547339927154500734961334241185816462984985122408755638845157529517440404888", "5429472492516938288020588447033050680511243088021902555626331208609494322809", "6038155690422100700638510175974194653385020121201170068183639395022497652683", "8196883901367901100230454896732431566152928973255009907169942832718545558987", "7148661732519455303042665061428727258149029429431163054145732237026532889489", "1103346966514279047338269965467284691516791424600841454288175566663047065119", "506501463999583535576083051388238107272349203512591077156367833191176693496", "1149231095916448689731204689337414874810990008730168558258467468151736044861", "1211317984484755908746711394692099576861991632666741109848017891399630249230", "6017874075566883986651275686693033257769937156099759525630010678262207639985", "3073074021524417887287970232094691430723143577639770883918040346601896146795", "134322564106387068808518725818157537971916470010873683390299125718406273957", "7040363473961332593733143478583439087530465096639620629229243458327373964466", "563451981459495065711526283364283662742307512754720432350812655985079858111", "5570498493573838619078336592446358732592459054714103239182255473689891360031", "5955050266740665240632543482309019624723976125958790422257400594518597029180", "3494771094276850877479627249158913515667815533875151231775096745822620973237", "3411818460864172740195641615200664114728178401494159321877510855488036874139", "4246924861274374789571583206802025439809822100434979812077101166440594671411", "6493720911104932428533466302395488089924060304439516917191316090657092895655", "2379218033893055222653926256633317026405237872826721673269282768490590945976", "6646242587253766573347400624243988750258308477645970782579754603927338804578", "6424174353837718401266897399154131367683818742063987521971015251587962775678", "7002656101292719313815856091772832441016655729701219648910142117630011665679")),
+	arcLast: [4][6]fr.Element{
+		[6]fr.Element(unrolledElements("4424355663759696797083117401116579382210148092564273386055340689619958180062", "38181968798540411178433004489330464377413440568580946670698824537964234740", "3985137582625427870914337711862008955159518565586436026102511255896022545247", "2686597946680903067963389814411594898511296256126226466543512771762628518380", "3912072963699923538135335881783459711445809699833034464235046836295051637157", "3768948985107031263554991350035396579658231033668291305113893407107999590132")),
+		[6]fr.Element(unrolledElements("7209840459207478084934132990507426086496015722161589774417691869050002492357", "6470232135949884401983050741535994050038392389090501339898509456281439988861", "1403782228835667896108593023720179504199984149882443104282945790037828677887", "2616692368426935727956976017730416940577328529933157623691062413188068132680", "6013155465106742700343824998420749144547684411124338994627587197137858317900", "5046057668721457926386883951512447209843468155520782995797315336934626297875")),
+		[6]fr.Element(unrolledElements("4699213848989418709226497398340240243077331984560588410432125105042384834910", "5229593958715996992063094437959528963051688396408152717883918637659886098311", "1951976044069223056158754588030180422847727493829048238776399717813979759789", "4158688722225908788153206659322854682351209416161193960155253054969298046740", "6729156920345996334287429817597914498081658415518610887789376041974889047771", "8001080793346807220307430636768987640386922596264252165255164305011067417921")),
+		[6]fr.Element(unrolledElements("805049615247355128632817272390362068051853601888800837662896676244202957816", "6954697886862123377467715908414695350592349300176823211514354407092860264474", "1037357063158990576124983865369468459708807060409999263024817001922664598244", "6274173843835872888849453577522195998097492776857839215359837309285307683859", "3829234834194025101976400998836257325560624583979963442125678498831465993617", "2634048471413514276664575681345205309356785859870536213890046907527525143930")),
+	},
+	mds: [6][6]fr.Element{
+		[6]fr.Element(unrolledElements("7037051457856975353540687448984622109479916112628386523279361213264507699201", "7238110070938603220784707090384182741179342287274911852515914390786350776321", "7388904030749824121217721821433853214953911918259805849443329273927733084161", "4691367638571316902360458299323081406319944075085591015519574142176338466134", "7600015574485533381823942444903391878238309401638657445141710110325668315137", "2303035022571373752067861346940421781284336182314744680345972760704747974284")),
+		[6]fr.Element(unrolledElements("7238110070938603220784707090384182741179342287274911852515914390786350776321", "7388904030749824121217721821433853214953911918259805849443329273927733084161", "4691367638571316902360458299323081406319944075085591015519574142176338466134", "7600015574485533381823942444903391878238309401638657445141710110325668315137", "2303035022571373752067861346940421781284336182314744680345972760704747974284", "7740756603642672888894756193883084320427907723891225175607297334590958469121")),
+		[6]fr.Element(unrolledElements("7388904030749824121217721821433853214953911918259805849443329273927733084161", "4691367638571316902360458299323081406319944075085591015519574142176338466134", "7600015574485533381823942444903391878238309401638657445141710110325668315137", "2303035022571373752067861346940421781284336182314744680345972760704747974284", "7740756603642672888894756193883084320427907723891225175607297334590958469121", "7794887768703111160845069174259889105885445540142212764247907805462223912961")),
+		[6]fr.Element(unrolledElements("4691367638571316902360458299323081406319944075085591015519574142176338466134", "7600015574485533381823942444903391878238309401638657445141710110325668315137", "2303035022571373752067861346940421781284336182314744680345972760704747974284", "7740756603642672888894756193883084320427907723891225175607297334590958469121", "7794887768703111160845069174259889105885445540142212764247907805462223912961", "7841285910183486822516766014582864636277620811214487840225573923351880007681")),
+		[6]fr.Element(unrolledElements("7600015574485533381823942444903391878238309401638657445141710110325668315137", "2303035022571373752067861346940421781284336182314744680345972760704747974284", "7740756603642672888894756193883084320427907723891225175607297334590958469121", "7794887768703111160845069174259889105885445540142212764247907805462223912961", "7841285910183486822516766014582864636277620811214487840225573923351880007681", "7881497632799812395965569942862776762617506046143792906072884558856248623105")),
+		[6]fr.Element(unrolledElements("2303035022571373752067861346940421781284336182314744680345972760704747974284", "7740756603642672888894756193883084320427907723891225175607297334590958469121", "7794887768703111160845069174259889105885445540142212764247907805462223912961", "7841285910183486822516766014582864636277620811214487840225573923351880007681", "7881497632799812395965569942862776762617506046143792906072884558856248623105", "7916682890089097272733273380107699873164905626706934838689281364922571161601")),
+	},
+	mi: [6][6]fr.Element{
+		[6]fr.Element(unrolledElements("1", "0", "0", "0", "0", "0")),
+		[6]fr.Element(unrolledElements("0", "3466885886383614736932071158256868571025325905266057014725599828463273557140", "7631238485140139170519957292292796390470671952054900803134611909388031911017", "7871633013161771723971720588337110516688943421216367362350930829393725374005", "1073861992954466378139155040243430947959159969896043282552939666018391012017", "7183433298824164175395505075717883311708425536530432110390555349177505525596")),
+		[6]fr.Element(unrolledElements("0", "7631238485140139170519957292292796390470671952054900803134611909388031911017", "41500372083843706934182411142065801633822663035944991289021626121183355283", "5125031358671829763143662154931208209925017208372180524423682982510617172395", "981480440519254016924108191447655466657783346930331844108329856514040945996", "2331757192524643452388648023333684073825792737387882230674275113643541352821")),
+		[6]fr.Element(unrolledElements("0", "7871633013161771723971720588337110516688943421216367362350930829393725374005", "5125031358671829763143662154931208209925017208372180524423682982510617172395", "5712778409618812547852143178260582577133932863530824058318138545806090962486", "7550005823807598061571947111819109954946648930422615185305732016051037379606", "4799007357221912049451609521186356374716557970509367518632657620190429554132")),
+		[6]fr.Element(unrolledElements("0", "1073861992954466378139155040243430947959159969896043282552939666018391012017", "981480440519254016924108191447655466657783346930331844108329856514040945996", "7550005823807598061571947111819109954946648930422615185305732016051037379606", "6158108558546920229448272459059447924688962268670692359811577868253380475777", "1969215327660361195767023373489611175139477730908610516274418325350559201003")),
+		[6]fr.Element(unrolledElements("0", "7183433298824164175395505075717883311708425536530432110390555349177505525596", "2331757192524643452388648023333684073825792737387882230674275113643541352821", "4799007357221912049451609521186356374716557970509367518632657620190429554132", "1969215327660361195767023373489611175139477730908610516274418325350559201003", "6846721788455410245195334523636560162771380747503714722615054473011010713906")),
+	},
+	v: [31][5]fr.Element{
+		[5]fr.Element(unrolledElements("848836604072499758747059453660370009974593670854202838287413465373432091653", "3047102260061767217116728517244424881290907872992189004054234941930203431649", "7237583088447883361002345155915843962913513542268782540683850400729094940465", "6929383547412319526976648734922923273554492951001563754725699632161202962266", "3132747410952189320997046000269383806861934184935808608495743622151361789016")),
+		[5]fr.Element(unrolledElements("6681643333571807222735775986415899108178298316047766720766399594583207798916", "3518833953434233472116763773858372400042685039847566378694731000195553633371", "3461636820284695086795383614644125098606653460698400639162920772987717675608", "5644603989946552583182916764974069538070855789735574326734898596489104442299", "206577133176895050891689030107036704932235778591774625169530408225098356324")),
+		[5]fr.Element(unrolledElements("4585352636873525824828104759068690973281974820135878331879221370389775023943", "7641260529140977102042703341856833650015340430014086738397810636181186112074", "5863396470823682301618006713561278918846761693001096499090825381381333197518", "904842458120294943179706514589550895267467664882990315233867819312320067754", "3220700483058000523961146743388642537412666861309394450638829258069123210298")),
+		[5]fr.Element(unrolledElements("4717994132854965213639492210908837769349591933687169638749603740427164621707", "6761450480158449952491585599633818106281185872627021288991452291542156073366", "3795412990796694239548309065871618040804921074488092419190725493924790976540", "3097803502404347599412562836305050929038994714467265300677530778260720657408", "930653861172258191907352192272622238258733136653075005566927516613580047086")),
+		[5]fr.Element(unrolledElements("6797218534895565825010153596978623441886597135565230008465745210509398562623", "7911829579284151706198741755052533651888640483435783163537136789567445554084", "3064599057061767021844128373975151381942998394487972392912798081667420575732", "7063805345764805955148215922898806123389066020619470253506509562832165904667", "1892174850394816493301732615726146718742746721222344255693709457408463448246")),
+		[5]fr.Element(unrolledElements("3183883339779654676061479301855480480072473192768088585440218959931936308398", "2261437371939482004662625493332148611545726111042452410673223413030818086262", "2635616215590115067316425848446650757374978178964137414842654459527122987826", "757642534360438237303334526849518635508736153455398806606655610105389859073", "81095642896409553327019920179113463585893256018230512374427961174685023581")),
+		[5]fr.Element(unrolledElements("3076116378792126017550285525536207744292826744681071611608701902507121645518", "3854674949135327103510638330547969828128748205997669771635963688882753213182", "4755716130415217542977073597524817507349271798304793468020622084876605836304", "6050679637333033844711176454247417794628303962982782280824008090010299899476", "2685297888248924359701694693388428875167185712157540130758768365617060661216")),
+		[5]fr.Element(unrolledElements("1197691971280396306287192581398202969239826051158575768752709995306828020580", "4238082431770470956206384878390594711282141802779080073594702685840562565961", "878442576213693814831503247472547748946049635003052458359266421326366453086", "5684948148104820523289805822153448759615921390176571118906786942761743595141", "2148195330920651774204475372303962369753530865773311898903827493341686860486")),
+		[5]fr.Element(unrolledElements("4451970210234717986114292035901002878265169407249728090179081149172309065999", "7410547164950177415607578777491789201470470457823190836808741925311048263477", "3377257617979106867239904053601661934125307448850679529255044890270861718040", "1853599437066515805677543489139997598844238785564000340227677707818357936054", "5429064913656400225471445707275667326030314352474713949407963978763116919579")),
+		[5]fr.Element(unrolledElements("7519304592477765757260636321810574180069142992153012371811342069529892471792", "5487843288490548458944483494589225326153301298806910777379580982291350610665", "510218660126967783517348372881817729621700503771717889621802047344472012169", "2209942353071004113141626194945694063131134098571705757070963473639581531249", "3758603934629736803795276443958343494675773193363251693220409288341551712330")),
+		[5]fr.Element(unrolledElements("3139649722060623801892440109936841783814774383361763259558015594361298197330", "3408390433030768147591222599748716339661171573476398480372499194642353127409", "5883406640005961510518943922315984407601675653894586250360310629546321124535", "5915431096730178243561739029584601014253247255317937438021020844157491638060", "676358766621617874963827581679634070309235155222486738747234537410357380738")),
+		[5]fr.Element(unrolledElements("6850933996320895880612129783291391300582256806630505952529312913434970849928", "5740537437043965385118623008585070483275524217044780698253346664671370932569", "5613808328537682180580806697618787482041835953570245642832539431400477912465", "355985213407966689514720253068594068335704430948235959092978366409374991936", "2896502603549427612693976511346976872923625464294097211108220572952870663980")),
+		[5]fr.Element(unrolledElements("7872957250286640781261597615947123682145439021290179984851671184197371144237", "4270080453127734296040974725474939563669611937415279941318423355292187409247", "7837996606991115158840354692708299889539902758634946340775643090935180150003", "5040055913185555759799644626682679990624017535965186108689014808086875148076", "4076452896420841774788519858212832470236168087782495438602828618333959655679")),
+		[5]fr.Element(unrolledElements("757101241546777826925891083535493867234396654755470441451967986563409129039", "4277352352236356233977705224157738527338644281742783698948438751984703091316", "1073255593582939467087448489641130248372081764818232419926531966764867684115", "2391951887243757039001297845116658050641414539618206517877391386559220050374", "446289917362131613879994461507020996276068930852357270607964327842602903038")),
+		[5]fr.Element(unrolledElements("1258749243792719359855766640067438625733311786514044851257454315362969702894", "4990875883891633704713814491196413878780021470031873828007434832149285923877", "6200439178012128180944679794966330198826507650304501101432289910763147930376", "4719349149224404163490786150494926125120106933700574110618021496050485511864", "1669534973986495667607393278917637166321086332372652600720280617188164633279")),
+		[5]fr.Element(unrolledElements("6693934743118354072745455302248449275870616983077929839884690599594261990264", "7058406496766309320843636175087488388778952908263354883183538702347753768680", "112805754093120896429706632826847444269816278879470103868773877928631291767", "4460995013973858057325952086481429303515013534733418559957765962760079329642", "1193755776095245869744378485032820330351025440846483775843412710878165867680")),
+		[5]fr.Element(unrolledElements("7320202478200266460346837952319492973701300494180563641577412999106426824526", "8108138820297213490738634228023986404893629041887825345738101636090573669345", "5301400962117056506538035780727177833845300364548746807848903621566273804316", "7971226113694983652944802446401717326942984192515500878860213259125412300090", "1409724173090869167154726972562766469176093846298227350952800639400394379601")),
+		[5]fr.Element(unrolledElements("972220203986731589364086387871705609244047376641536480969523415104231505317", "2453099774609779786435139396136811622507167591786353931912160643835718094689", "2309065636781068029672836043701027208170922286862910676725834963109305476313", "4040972464611400951352495819486215055185950553934569254115621318574769593313", "266592240564307010502052371487343932718275775959852829865912283245934928094")),
+		[5]fr.Element(unrolledElements("6231227535823799182606539690293251573601273968506136784794381447547797875406", "5655696554758517712037618944610404293554195829135747039469944770632773447716", "4444168197817530534143157441257649256244288310556485093995388501501954152333", "1562418969880179086156917387423691633688817703447121391732362425285294358707", "7905764595508721324139048664234901114040748376212995627171084399283682311031")),
+		[5]fr.Element(unrolledElements("6483881711338357066194191585999345605402998669728999576287475423195967294516", "5501728230554894840326119230491311997268184984598574434463777368920844355440", "8367940238888010226586240215378562834384437861789668269806504885290056620482", "4005415763048160253365089879982518591344227056640495742855020253298231389435", "4969458979893683785296838293021359389961523766136361848003712765926059083145")),
+		[5]fr.Element(unrolledElements("1857624422719040917493211390079431947937109202694869920357330729144903868304", "2029890818045040302870868737913497052385936280913070890864441987012718466511", "4104891951295802196336284351106287169259076743314463275235162647640034843094", "3317743299043490973800206704349700508551217135364996277285466640418805531480", "4503628229300759611603500675648810337410843314819359324931140629462919270882")),
+		[5]fr.Element(unrolledElements("2534660301813065402436899987950706043720168534445708767463663688295231036999", "1862197728742954238317031608983645132235346659299783635170547034502707852312", "8354656080998698755534880892987167690526972663880127867686954943923735687140", "1775518450943553915654815218875459501770597002098074473909421276677429275087", "2124859946365887562306513696664991963838266491638672763451233757378814957834")),
+		[5]fr.Element(unrolledElements("965233995498712348514292638207588694637619135632861752546203918580581657796", "2235248835582290539598865944236350480756882553474622611902054399378093841466", "6148670551886129962186712133076360841910072233440731871161757883158900286392", "4054278367287334965144453624843616328618302644423097642232152664094471575724", "4001954402147966855706286198881966747171612451247708565778725254440310720763")),
+		[5]fr.Element(unrolledElements("7218387484091115458420041283246976806713985651774223714134722192996410597635", "4231441015621368596701073091630925900586070904488463026235216795349201114365", "5715835773349980671804855661644390972640814704300898420795922471947844692230", "3384775390488715640672241644007991181322465102287685771223084113219078253957", "1578690082496160366966500006705645782141820241374842010133997910991194251930")),
+		[5]fr.Element(unrolledElements("1431741209182181199992000253938318158333401938504830255524365058344239129028", "279679141156856528872157540682727305343441098379922980298786323868535111888", "1343276697514446520140249002263887965747501206963843072689492127831811083086", "5395779912686855072366358214378270978263275956455850818134350137622249887820", "2290631682495891783628966223526885873135859535180846401576213059798995666190")),
+		[5]fr.Element(unrolledElements("4954341900180395110889178860820384290951169163842084917289390943955280510103", "6470021790101590966415371963124335260423343038658155655583738979541628807188", "7024912598992383092238946119397715724276097417266378240259886700124887181196", "8389166652326586786953909098741873237682335743682374833051600609786490990114", "1479056552842953534617606512037080286390006037416864574856058371553085514122")),
+		[5]fr.Element(unrolledElements("4141532419655592065610332370213881627362230648707131410133482121563357457244", "2805235589752126003131414548954915344462868809211489671397294395767543886469", "332040327407072334084989323171791720012842838222695661384360475795390351693", "7334299789481162862229727080563099218490223927327602697962460500113680347478", "1897213404555237456414103142001857906694603886125516762011014457060482151674")),
+		[5]fr.Element(unrolledElements("4026080672506377877140717282989760586025956049417327866114462369437851588279", "6930852424050627186126232807770899853776794918209634874800721153623171499129", "5498012549009454424469147474518034650798956467007298624071684872238445737362", "1747046067795269910228387161032836383761725017018529359296681232808565032961", "3831101026643899311630245440904261642914504445778502537885800288638580114296")),
+		[5]fr.Element(unrolledElements("6110231202011253694165846173723468950408820697542497299473283487019249347773", "3157575504651297787039118187608403480542790084101511446959773804450529464927", "3170253555681361289524464227542709736785270405371895935037102909830638075272", "7329436681995464374339159819496735533883658754043030465016961864474689381111", "30349159942757727446849373494433009012335581144047744258728329526937878811")),
+		[5]fr.Element(unrolledElements("7941815216724300756138775835282644952127333898537750504843850512112801546241", "7990146614099692070380126710619077796285849805904703708987252718247859978241", "7091612235338528214950923548253306297996640736944858140401246370412529217992", "5248338661925326407677785786700133502402681391582359740068141165676751836502", "1387049006151042337159381234970341877270851357356636994798804520514354983930")),
+		[5]fr.Element(unrolledElements("7238110070938603220784707090384182741179342287274911852515914390786350776321", "7388904030749824121217721821433853214953911918259805849443329273927733084161", "4691367638571316902360458299323081406319944075085591015519574142176338466134", "7600015574485533381823942444903391878238309401638657445141710110325668315137", "2303035022571373752067861346940421781284336182314744680345972760704747974284")),
+	},
+	wHat: [31][5]fr.Element{
+		[5]fr.Element(unrolledElements("5842007535022474771393881356706605267295630721532511994030387263110400264338", "1227209451965928705493525855023338836137996912556625280462063141166021348947", "8134015645683550675422951584794364531158826391292824005367333970284589558011", "5901328219507809588409254764336375535304428247431378374896704332678701815314", "4939576631781937288610922587005877806487736407007026097365113971356835030095")),
+		[5]fr.Element(unrolledElements("120375634993663345423467049551652322601878955778258240407899079731972206487", "2360725044803241846323041998265959465716259409244471169154334893947280038816", "4580002630231263979965442647658625061156714435344181528728315343332013976678", "7848313915613164359774658974259709378409518978722869099202180662775696685850", "3078852579910724817997671033826651242678954186458090648762919517123747991613")),
+		[5]fr.Element(unrolledElements("5281505736209476228625368194815871062689395969317896176277314459239189376784", "2457546237656968036899963756388246340437168002506103539551030167985965354345", "3892176615420455765037280466810963591888231682316139412412178539615826314931", "1788123120679946155909513795234528577990134600872358510097311428549188756787", "273589616277476720667578093976265883502524371605403263650116114032148814504")),
+		[5]fr.Element(unrolledElements("5013553443579759107013408664332143099854950676275087991348495168273138527360", "4655198839392469201950502817201006711216158113323375254703834658422385565315", "7121488522621409406003001219490250954875030644708686497090529774334720941538", "4879965827659283508327468762292484871346676700103121983457352310625424222221", "103152003412995626670815965501126271318826872518262302503619399755622628419")),
+		[5]fr.Element(unrolledElements("5809782815784787926268402925214888584149764009650054747468569878029923452150", "8280145905563717743202628630954608550347586608397486300236452105547458619922", "4223481685313476349848461423341780130503294307218542899824569635830447137960", "993204521332903287234535150860521113221764454436300109653837338888330650195", "2563252883098197962221692353034335439311114519005465085762942401961889300370")),
+		[5]fr.Element(unrolledElements("5123330347351134046280450017950519157624694185693895938445735905210917017618", "1742773833719122668792629780460804613251445465373427448336062615346517195357", "3567694412527938085828038330048457319751538543542197128290136125581680445636", "4498472419615226424399411610766984588697145044608248877855663258413876453219", "1444251946724225916664227347449568843644825496126458906659024503648940024020")),
+		[5]fr.Element(unrolledElements("7507575352920546011580751800347232214132430382655133054000793229332124078786", "985299904800384704459231555271751907841186606295166114240258547866514615481", "8426484653605928215551193990367284664594933417769200099513815528703726430677", "1537129124339191366864722641799696087535996150518462743211985240222388634054", "446620028879660805798594245123540461586434781164639270008747826990982365323")),
+		[5]fr.Element(unrolledElements("5837684128262005804825647876106396142407661842460638784306129186463739176717", "6662796944338374019172519603175357145037980757399391331384076471398266264932", "3538622469659248332042772828245338174429673448257783665386043998638702523130", "5022816291214140110504443097806662114485674873177043216896278108209654880261", "4542766497876255232247401948638181126555577534438947572506979568462289380963")),
+		[5]fr.Element(unrolledElements("4397005538359600086742317385712862552381867760010853785937015065983947987534", "1842635903428029129148218958247779846335820193004725584446572122966531312411", "7897688425336130150414495496523924792334653319043440325252576845731810994644", "6276856837371748712682861942595613909310648957795334798085219267828186601317", "4773896295726202208039186673787510014445841431433987462747404225557581366732")),
+		[5]fr.Element(unrolledElements("6193171054036940913333090255101417408801785780334369900764866135970384484659", "4551641954930566466407494509161169442349275022700016313694979642453078526780", "443593926855234226583797362846611849084221578246195442848672130661595987924", "38716373318262392097548680967212111560327234780348654812026422373921578918", "4119837901352182838209628754374786180221155496975346011315270227112191586257")),
+		[5]fr.Element(unrolledElements("2440894045539607139384970159844795091335685730998085963541278259675380457090", "4079089191679715612584661905555705615698244728704610383068061456446135276606", "2781199127908094188307884311354145437982527616396457441154125172373917962006", "5079881838253990449699203933325777004491929049218215622684047878792847313931", "511112710468324423836237588477306568460588458786970315016463344773539702631")),
+		[5]fr.Element(unrolledElements("525822604805773990383324445504722316877180206856566089198088980494871388389", "4400023399581476229076084375173729875486722009053051898063343151796447571049", "7404129173409617670487111130870756803276972080377412391071495785791511418119", "69815072931190129644566979469585540892704861327813106937056253546227026551", "2938116839228029901630462687041312294281358796436697967216446160525126004866")),
+		[5]fr.Element(unrolledElements("1846896143367372426155378749779540479526329997314556667571432516374526465646", "746893234597842719093706399801104979178261083366162352975875167525143570759", "82837965708636083214036604879642670669028538693223234779418174141620775612", "1789602760401806638838943859429912859411922512697594176773609218897047889276", "3741809056313642372578292414231732520389329967433566923544810449513421883947")),
+		[5]fr.Element(unrolledElements("5846260064036629690367418064068595902324192966197820080729498322482658368349", "5525337998808912224512210056940988315896660163067041507808871836208172793887", "3993000539076748210251258268562224898098456206203800372027860777967319168941", "8228293027820808967145977053154764722872668588400984499433663529444584663411", "8415214022743764913726986894119593781718035711103680911686555068054626667838")),
+		[5]fr.Element(unrolledElements("4790500917295764763697366145065957194569104981361890676387931830094895735303", "6765067133012392530950335784033789799961417752880480708579206665868262011118", "1200044006575803372465626276342547112295776217287206934415479826698503787596", "3061266063492948149668119338254318029578480809441037969703296499786245154497", "6747033153522969873411124368706363473290402704649820672186813563687324250543")),
+		[5]fr.Element(unrolledElements("6253922075102013311537122047339873473008076266090999962470325524288578564404", "3917027989955906926608335366537258273851803130690798654064947604891287977926", "5135721837966704529574095277086392826673198100443352124274047571231198270765", "968169212551652489407762820946477614372262491202162378605535508220047598348", "4056552440492954150064468537227577703143820882312293513424357662370555633197")),
+		[5]fr.Element(unrolledElements("4182417038997255631155135764925288979044181144794636308760205432713537092510", "6465650277195218565293260238870881254677567232561972896323016914393179713760", "5356330305962920654745530394638790017357523191707754138680465355067825357197", "6280948051937432729715564206090984917124623926267316571104752056331004207464", "2902037236812081844758075455540049896545151817490161551320005202662158377301")),
+		[5]fr.Element(unrolledElements("5250957375647202870293019768469320139075875130408369348720297278352439213590", "743892906917993225011642975476894243958832578253512245493833962831841201600", "7812539464246114489632904460977630195580767185254706736989481538679956000149", "5380749615084730699455707375619285634367165018364177465667568705466212964583", "464102675462536776794165887409532203099290051605084304047832210958985260587")),
+		[5]fr.Element(unrolledElements("845416597345020971354694442864794117508425815141020654672299616210760885837", "4176515287473442008552714353707417819037235555517648035298731053730648583051", "3918635121671318921816701716451302298167000555185499555391707221199118926327", "782573690607453281177421994322253656961228445101520254957281771744505405363", "2854547265602259967307220069271073951198979639818867636474386128933491322892")),
+		[5]fr.Element(unrolledElements("2961644632303047695577715823695273928888883923467165255278574501055364322955", "5138971517972955595710238252356052231557540441856292250007069976332716412249", "228563284313582033963104009850978903512039411189781655361817997812028638365", "141163402551404501198169773199150554984142577581536757121540753023086357807", "1749744466380453436950103697893946249069896462911811415890837353382182991518")),
+		[5]fr.Element(unrolledElements("856148838795908209208746356812074335364003203828323724294220179408363440196", "6094827374407119647935038893236271706226260060670897287172467813332325927706", "6444530954538389171784125059927336017341906140733147801238681004212027093186", "7370302277137835780088705682513570202438218267363479646564652327825898626795", "7024714842605452078293087244353778483566040287854284549442343302803492826054")),
+		[5]fr.Element(unrolledElements("6147177418394293675839647631388895239651803847437754497428815597144867369302", "6073787838288528059052971888600522063589241003419452547557519489243255700001", "877966251044781814196731303328684002773704756980205596320523227035168824661", "1680300822361370381905072597776767704714840902739122054261259298762777002822", "310831973912850730876861807340180486365780626755730976508052803884598108135")),
+		[5]fr.Element(unrolledElements("1206460601248005043560178447148823906439049019815536644364771507828201319863", "2412057908064881264713928350962053121645408720902539578822087125096402639726", "1383881272194246002194984091333820129980506623974261470473742080000000000", "8443181644663185172104662718372042665962816448631956622706690166765409239041", "3838824739102159117098530433496929204672681334070385103267973589324495108655")),
+		[5]fr.Element(unrolledElements("2412703356979700953373542989927327947754075091368714761694419645595602639726", "8444461749427383511833990529988684649885752662216620453248673723850209239041", "2115999542611808425206383230017385219277994830598924825600000000", "8444461749426413102365761701541701660970228412654561772902021865775969239041", "767678340857786708362429396252524122341246514661864491788758030330819021731")),
+		[5]fr.Element(unrolledElements("2412703356979534406928490351734551341209374418729386016521648159578322639726", "1206351678489767203462608827168196954300006685900101255806851772385961319863", "3235432225507357939279305089273845337395081895040000000", "8444461749428370424245832129866126400404346532737832819755829311748289239041", "6909105067714121256204596450504334760095366669142909759344956622810075195579")),
+		[5]fr.Element(unrolledElements("7238110070938603220784707090384572116877256549111016795125497716206263919178", "7238110070938603220784707090381875398566311432055124803695538959134751919178", "4947081261147683023640511606228713587968000000", "8444461749428370424248824938776970429058890637021317756397426912059482839041", "3070713363428498336090481795922110382428606798585639031528163517498252086924")),
+		[5]fr.Element(unrolledElements("2412703356979534406928235696794727580393709463883685749577350432537993039726", "6031758392448836017320589241986818950979257237382735248977797259580557799315", "7564248390604308733188034854724800000", "8444461749428370424248824938781546531368902325653271604704980364296524039041", "2303035022571373752067861346940421781286703136026495212913928537688819305193")),
+		[5]fr.Element(unrolledElements("1206351678489767203464117848397363790196557047880062312703730225680899719863", "6031758392448836017320589241986818950982785239390365447015253077049789499315", "11565982222751178173858400000", "8444461749428370424248824938781546531375899335143365172565110624394626855041", "3838391704285622920113102244900702968807226970528193616511633177534156868655")),
+		[5]fr.Element(unrolledElements("1391947599256776000", "1206351678489767203464117848397363790196557047879151975411070768152208597863", "17684782061002212000", "8444461749428370424248824938781546531375899335154063827918874895000645342041", "767678340857124584022620448980140593761445394104914893454191359178521156131")),
+		[5]fr.Element(unrolledElements("4825406713959068813856471393589455160786228191516607901677276260526367623852", "6031758392448836017320589241986818950982785239395759877096595325642663707165", "27048767200", "8444461749428370424248824938781546531375899335154063827935233455892410459001", "6909105067714121256203584040821265343853008546944234041037918282122694281179")),
+		[5]fr.Element(unrolledElements("3619055035469301610392353545192091370589671143637455926257957195393175388169", "7238110070938603220784707090384182741179342287274911852515914390786350776293", "5629641166285580282832549959187697687583932890102709218623488970611606159404", "4222230874714185212124412469390773265687949667577031913967616727958704619488", "3070713363428498336090481795920562375045781576419659573794630347606330632388")),
+	},
+	m00: unrolledElements("7037051457856975353540687448984622109479916112628386523279361213264507699201")[0],
+}
+
+// permute5 is the rate-5 permutation (width 6).
+func permute5(s *[6]fr.Element) {
+	k := &permute5Constants
+
+	// Full round 1.
+	s[0].Add(&s[0], &k.arcFirst[0][0])
+	s[1].Add(&s[1], &k.arcFirst[0][1])
+	s[2].Add(&s[2], &k.arcFirst[0][2])
+	s[3].Add(&s[3], &k.arcFirst[0][3])
+	s[4].Add(&s[4], &k.arcFirst[0][4])
+	s[5].Add(&s[5], &k.arcFirst[0][5])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	permute5MDS(s)
+
+	// Full round 2.
+	s[0].Add(&s[0], &k.arcFirst[1][0])
+	s[1].Add(&s[1], &k.arcFirst[1][1])
+	s[2].Add(&s[2], &k.arcFirst[1][2])
+	s[3].Add(&s[3], &k.arcFirst[1][3])
+	s[4].Add(&s[4], &k.arcFirst[1][4])
+	s[5].Add(&s[5], &k.arcFirst[1][5])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	permute5MDS(s)
+
+	// Full round 3.
+	s[0].Add(&s[0], &k.arcFirst[2][0])
+	s[1].Add(&s[1], &k.arcFirst[2][1])
+	s[2].Add(&s[2], &k.arcFirst[2][2])
+	s[3].Add(&s[3], &k.arcFirst[2][3])
+	s[4].Add(&s[4], &k.arcFirst[2][4])
+	s[5].Add(&s[5], &k.arcFirst[2][5])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	permute5MDS(s)
+
+	// Full round 4.
+	s[0].Add(&s[0], &k.arcFirst[3][0])
+	s[1].Add(&s[1], &k.arcFirst[3][1])
+	s[2].Add(&s[2], &k.arcFirst[3][2])
+	s[3].Add(&s[3], &k.arcFirst[3][3])
+	s[4].Add(&s[4], &k.arcFirst[3][4])
+	s[5].Add(&s[5], &k.arcFirst[3][5])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	permute5MDS(s)
+
+	// First partial round: the constants of row 4 go through M_i.
+	s[0].Add(&s[0], &k.arcFirst[4][0])
+	s[1].Add(&s[1], &k.arcFirst[4][1])
+	s[2].Add(&s[2], &k.arcFirst[4][2])
+	s[3].Add(&s[3], &k.arcFirst[4][3])
+	s[4].Add(&s[4], &k.arcFirst[4][4])
+	s[5].Add(&s[5], &k.arcFirst[4][5])
+	permute5MI(s)
+
+	// Partial round 1.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[0])
+	permute5Sparse(s, &k.v[0], &k.wHat[0])
+
+	// Partial round 2.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[1])
+	permute5Sparse(s, &k.v[1], &k.wHat[1])
+
+	// Partial round 3.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[2])
+	permute5Sparse(s, &k.v[2], &k.wHat[2])
+
+	// Partial round 4.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[3])
+	permute5Sparse(s, &k.v[3], &k.wHat[3])
+
+	// Partial round 5.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[4])
+	permute5Sparse(s, &k.v[4], &k.wHat[4])
+
+	// Partial round 6.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[5])
+	permute5Sparse(s, &k.v[5], &k.wHat[5])
+
+	// Partial round 7.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[6])
+	permute5Sparse(s, &k.v[6], &k.wHat[6])
+
+	// Partial round 8.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[7])
+	permute5Sparse(s, &k.v[7], &k.wHat[7])
+
+	// Partial round 9.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[8])
+	permute5Sparse(s, &k.v[8], &k.wHat[8])
+
+	// Partial round 10.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[9])
+	permute5Sparse(s, &k.v[9], &k.wHat[9])
+
+	// Partial round 11.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[10])
+	permute5Sparse(s, &k.v[10], &k.wHat[10])
+
+	// Partial round 12.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[11])
+	permute5Sparse(s, &k.v[11], &k.wHat[11])
+
+	// Partial round 13.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[12])
+	permute5Sparse(s, &k.v[12], &k.wHat[12])
+
+	// Partial round 14.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[13])
+	permute5Sparse(s, &k.v[13], &k.wHat[13])
+
+	// Partial round 15.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[14])
+	permute5Sparse(s, &k.v[14], &k.wHat[14])
+
+	// Partial round 16.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[15])
+	permute5Sparse(s, &k.v[15], &k.wHat[15])
+
+	// Partial round 17.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[16])
+	permute5Sparse(s, &k.v[16], &k.wHat[16])
+
+	// Partial round 18.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[17])
+	permute5Sparse(s, &k.v[17], &k.wHat[17])
+
+	// Partial round 19.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[18])
+	permute5Sparse(s, &k.v[18], &k.wHat[18])
+
+	// Partial round 20.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[19])
+	permute5Sparse(s, &k.v[19], &k.wHat[19])
+
+	// Partial round 21.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[20])
+	permute5Sparse(s, &k.v[20], &k.wHat[20])
+
+	// Partial round 22.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[21])
+	permute5Sparse(s, &k.v[21], &k.wHat[21])
+
+	// Partial round 23.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[22])
+	permute5Sparse(s, &k.v[22], &k.wHat[22])
+
+	// Partial round 24.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[23])
+	permute5Sparse(s, &k.v[23], &k.wHat[23])
+
+	// Partial round 25.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[24])
+	permute5Sparse(s, &k.v[24], &k.wHat[24])
+
+	// Partial round 26.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[25])
+	permute5Sparse(s, &k.v[25], &k.wHat[25])
+
+	// Partial round 27.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[26])
+	permute5Sparse(s, &k.v[26], &k.wHat[26])
+
+	// Partial round 28.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[27])
+	permute5Sparse(s, &k.v[27], &k.wHat[27])
+
+	// Partial round 29.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[28])
+	permute5Sparse(s, &k.v[28], &k.wHat[28])
+
+	// Partial round 30.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[29])
+	permute5Sparse(s, &k.v[29], &k.wHat[29])
+
+	// Partial round 31.
+	exp17(&s[0])
+	permute5Sparse(s, &k.v[30], &k.wHat[30])
+
+	// Full round 5.
+	s[0].Add(&s[0], &k.arcLast[0][0])
+	s[1].Add(&s[1], &k.arcLast[0][1])
+	s[2].Add(&s[2], &k.arcLast[0][2])
+	s[3].Add(&s[3], &k.arcLast[0][3])
+	s[4].Add(&s[4], &k.arcLast[0][4])
+	s[5].Add(&s[5], &k.arcLast[0][5])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	permute5MDS(s)
+
+	// Full round 6.
+	s[0].Add(&s[0], &k.arcLast[1][0])
+	s[1].Add(&s[1], &k.arcLast[1][1])
+	s[2].Add(&s[2], &k.arcLast[1][2])
+	s[3].Add(&s[3], &k.arcLast[1][3])
+	s[4].Add(&s[4], &k.arcLast[1][4])
+	s[5].Add(&s[5], &k.arcLast[1][5])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	permute5MDS(s)
+
+	// Full round 7.
+	s[0].Add(&s[0], &k.arcLast[2][0])
+	s[1].Add(&s[1], &k.arcLast[2][1])
+	s[2].Add(&s[2], &k.arcLast[2][2])
+	s[3].Add(&s[3], &k.arcLast[2][3])
+	s[4].Add(&s[4], &k.arcLast[2][4])
+	s[5].Add(&s[5], &k.arcLast[2][5])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	permute5MDS(s)
+
+	// Full round 8.
+	s[0].Add(&s[0], &k.arcLast[3][0])
+	s[1].Add(&s[1], &k.arcLast[3][1])
+	s[2].Add(&s[2], &k.arcLast[3][2])
+	s[3].Add(&s[3], &k.arcLast[3][3])
+	s[4].Add(&s[4], &k.arcLast[3][4])
+	s[5].Add(&s[5], &k.arcLast[3][5])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	permute5MDS(s)
+}
+
+func permute5MDS(s *[6]fr.Element) {
+	k := &permute5Constants
+	var n0, n1, n2, n3, n4, n5, prod fr.Element
+	n0.Mul(&k.mds[0][0], &s[0])
+	prod.Mul(&k.mds[0][1], &s[1])
+	n0.Add(&n0, &prod)
+	prod.Mul(&k.mds[0][2], &s[2])
+	n0.Add(&n0, &prod)
+	prod.Mul(&k.mds[0][3], &s[3])
+	n0.Add(&n0, &prod)
+	prod.Mul(&k.mds[0][4], &s[4])
+	n0.Add(&n0, &prod)
+	prod.Mul(&k.mds[0][5], &s[5])
+	n0.Add(&n0, &prod)
+	n1.Mul(&k.mds[1][0], &s[0])
+	prod.Mul(&k.mds[1][1], &s[1])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mds[1][2], &s[2])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mds[1][3], &s[3])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mds[1][4], &s[4])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mds[1][5], &s[5])
+	n1.Add(&n1, &prod)
+	n2.Mul(&k.mds[2][0], &s[0])
+	prod.Mul(&k.mds[2][1], &s[1])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mds[2][2], &s[2])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mds[2][3], &s[3])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mds[2][4], &s[4])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mds[2][5], &s[5])
+	n2.Add(&n2, &prod)
+	n3.Mul(&k.mds[3][0], &s[0])
+	prod.Mul(&k.mds[3][1], &s[1])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mds[3][2], &s[2])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mds[3][3], &s[3])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mds[3][4], &s[4])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mds[3][5], &s[5])
+	n3.Add(&n3, &prod)
+	n4.Mul(&k.mds[4][0], &s[0])
+	prod.Mul(&k.mds[4][1], &s[1])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mds[4][2], &s[2])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mds[4][3], &s[3])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mds[4][4], &s[4])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mds[4][5], &s[5])
+	n4.Add(&n4, &prod)
+	n5.Mul(&k.mds[5][0], &s[0])
+	prod.Mul(&k.mds[5][1], &s[1])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mds[5][2], &s[2])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mds[5][3], &s[3])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mds[5][4], &s[4])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mds[5][5], &s[5])
+	n5.Add(&n5, &prod)
+	s[0] = n0
+	s[1] = n1
+	s[2] = n2
+	s[3] = n3
+	s[4] = n4
+	s[5] = n5
+}
+
+func permute5MI(s *[6]fr.Element) {
+	k := &permute5Constants
+	var n0, n1, n2, n3, n4, n5, prod fr.Element
+	n0 = s[0]
+	n1.Mul(&k.mi[1][1], &s[1])
+	prod.Mul(&k.mi[1][2], &s[2])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mi[1][3], &s[3])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mi[1][4], &s[4])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mi[1][5], &s[5])
+	n1.Add(&n1, &prod)
+	n2.Mul(&k.mi[2][1], &s[1])
+	prod.Mul(&k.mi[2][2], &s[2])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mi[2][3], &s[3])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mi[2][4], &s[4])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mi[2][5], &s[5])
+	n2.Add(&n2, &prod)
+	n3.Mul(&k.mi[3][1], &s[1])
+	prod.Mul(&k.mi[3][2], &s[2])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mi[3][3], &s[3])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mi[3][4], &s[4])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mi[3][5], &s[5])
+	n3.Add(&n3, &prod)
+	n4.Mul(&k.mi[4][1], &s[1])
+	prod.Mul(&k.mi[4][2], &s[2])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mi[4][3], &s[3])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mi[4][4], &s[4])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mi[4][5], &s[5])
+	n4.Add(&n4, &prod)
+	n5.Mul(&k.mi[5][1], &s[1])
+	prod.Mul(&k.mi[5][2], &s[2])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mi[5][3], &s[3])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mi[5][4], &s[4])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mi[5][5], &s[5])
+	n5.Add(&n5, &prod)
+	s[0] = n0
+	s[1] = n1
+	s[2] = n2
+	s[3] = n3
+	s[4] = n4
+	s[5] = n5
+}
+
+func permute5Sparse(s *[6]fr.Element, v, wHat *[5]fr.Element) {
+	var n0, prod fr.Element
+	n0.Mul(&permute5Constants.m00, &s[0])
+	prod.Mul(&wHat[0], &s[1])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[0], &s[0])
+	s[1].Add(&s[1], &prod)
+	prod.Mul(&wHat[1], &s[2])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[1], &s[0])
+	s[2].Add(&s[2], &prod)
+	prod.Mul(&wHat[2], &s[3])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[2], &s[0])
+	s[3].Add(&s[3], &prod)
+	prod.Mul(&wHat[3], &s[4])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[3], &s[0])
+	s[4].Add(&s[4], &prod)
+	prod.Mul(&wHat[4], &s[5])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[4], &s[0])
+	s[5].Add(&s[5], &prod)
+	s[0] = n0
+}
+
+var permute6Constants = struct {
+	arcFirst   [5][7]fr.Element // rows 0..4: first full rounds and first partial round
+	arcPartial [30]fr.Element   // state[0] constant of partial rounds 2..31
+	arcLast    [4][7]fr.Element // last full rounds
+	mds, mi    [7][7]fr.Element
+	v, wHat    [31][6]fr.Element // sparse matrices of partial rounds 1..31
+	m00        fr.Element
+}{
+	arcFirst: [5][7]fr.Element{
+		[7]fr.Element(unrolledElements("3267179889377925711574658284856732208025355841040084473551461173520230225381", "4218970795463228197327790213244921451375713052676530804173314273248521302251", "6558452507257297773903880599933734956650828395452896303007568859251089292382", "4042547271226656281806908526176747617793733742101121262910694724314042815131", "4484826253675472206510819347554710738378387223810894155307581506069733612810", "3340623787106332728769891522629193546852430705194948956506410107893436206270", "1146818540713897566188401511637220499025428484560997690844890982000538808551")),
+		[7]fr.Element(unrolledElements("6089924376626192718256476404089056274813344438059373355846067526383524489588", "3252380459144218718661502728554356451451872089835332429937606714939592080537", "7116956469008263816717247030121065061393433609954531462053914466227296297033", "2749312272215231848139078131254509267239590195342230643928479893117354148584", "764882704567509245651596837424209709274533314828826071535700095515278197679", "4932281286580045638368979604177064776138370306619513783360199241458597325911", "6484320961953933323289746320919349728579597325051701151281300835155595148740")),
+		[7]fr.Element(unrolledElements("6682606457331543519450813892692923338913286820264911373641321435621952784002", "6625780871179973490233386959951589849631496582163936124259260687776843181994", "7949225290500346406153571792115001941134688057907882657603244062341345143557", "6392844449548821017972820206673777102358896581926384483680180004397700038029", "3392678655944756762416562388041708502386246415055289087688321666480356102352", "1482525589417638728292358287918496174732548433885907700008322735579133317611", "3098572574557576897289665910879403912332252678168883273111538708481992361738")),
+		[7]fr.Element(unrolledElements("5529056592387037922896478601046572040782992911318540751019232411511652047969", "3748164104379232094417838431717964236407138591232998705172215282549268275626", "7993215220832663678736550799426833055975676440107252656509024603970443366873", "5554706095086365018531517384733334573065210312805294463584717615914523071948", "2443631285780523173220046820359095033333463124538739189832478296145755085550", "1220796011041198056582991284907417463980599290206522252014247996281492269358", "1682760707303747404170762352671876629726555466723959746802386893894433719465")),
+		[7]fr.Element(unrolledElements("3051923729738325569700743140016346599799580971673827402271729235767984866691", "7563176581771672618255447019898227372221620915263206079381670008997384230483", "6714389944529537488857452034094868070645683033112486934483248858539099368014", "7493906226657143301604183755067902519920822547119913298302294623502238733674", "2154229324145977209709505054539133771940440151492224785606993781621358928730", "7456876762420982497005391795935505349258720733415090396527680093854035684367", "2463860822091516107069327148389300215821586320398049504393157965063872793114")),
+	},
+	arcPartial: [30]fr.Element(unrolledElements("7457734898674665726899333231363665869048074122705641470037575830423177022983", "6606638027680683034019087360504930965296383619912667674373484912931510081604", "3896120473808259659498886851165050715042137210210508969425175628918596110703", "4177785039984150553207166633005666784182736665405993035615821533100346473102", "2251264701928031600714846652509034432058540215603054186751448537230602211233", "5918356541462280245369962174485820011301090124256309721902773908738295333234", "4488288402938721031665768590845827018046248664515272235168813500747779130469", "420804516809894227524959414447369962794383714748308458102009786417751385338", "1611892711560390106144670651718407615978367227165742318749208966143654708953", "3625688803767314787198072508648376639173374598674550917856802780225203641909", "5858314979178924176986455221724067371667793542695736437793040582649231142217", "4718469649382301003608330023856268030475656658372577531043938083130450360244", "7101923401566220379502756714091944759289065666990111994223809262581510991930", "2205893178730705290412148160747666618068740934944353076517034081562976037846", "566819841478218788433029207586828887815246268605467258094080218381409354679", "8338268662992166550895097018748216356557418021592425015601050125971961877922", "550747726396516458904193097749683959139768812560046963055412203445141510555", "6649371484039146069959271641955740256733353418841311643978236734398595127934", "2401235852414872890161144154127434587596859309807274678173402920624069948487", "2359766917637333091301887580657348885955799935313770486384545232084724526410", "1536556904746856543160299837694949527224460265981570672200684249095439228342", "5239491739480094741737608066646010096816870470677999810889296988406366638984", "8351070687600450810120155572058954232266570466877012525797484411025940422009", "3350142567086983170974492599937588617046949185020614743476460067513217808235", "1380167696271416207304028914120094605829261661079429177783223779312922081950", "200591230621722282999884256572529502020351906981653626535579613005322864375", "6112426468352321907537481445509868610249385338744048909702839757395982274228", "4234794823819824216288706384030793953524919518937019309684882995210561605401", "2617023030286105441547722950902887917262286407967232161936665945310280906622", "2625262002311345264309624648651677447307963829568516041674770057182326832869")),
+	arcLast: [4][7]fr.Element{
+		[7]fr.Element(unrolledElements("2373941392307013801383592832488158577752891975962531227890579346355584846451", "1961718924193031296798751690610974386828264605504889890771349325463025336195", "2868124060985675920436079106596156284905943204632191942776718861373436542214", "7845669997742840217688401931462742306009286446506113818599869436449377367754", "6173407037353888350243445875635080301447444707547969387574700153706191518628", "7098672234934132886939827569385283896802454444867206042797084120044836023173", "5351899839977801032921348451916620591965842541634687391321577183284412726675")),
+		[7]fr.Element(unrolledElements("5620070496211129683300134579883602187317512502813714244130767313773035697719", "6979709989426911001555340276783571500889381472387839399740190281565272894942", "5135679336879799934417470005708054630248446694753779453216003661110099588738", "138682133719722492420465554190750590837692301151943132427693112118115423576", "3151429238189687747957628376177893410569754087843736384164577812731670460702", "121814244732221808057679271483647003115742086673430961846079912667911157285", "8371534681354007650628559426369748872592875254339532946571231278729041076560")),
+		[7]fr.Element(unrolledElements("3224012810937385216119822993567522300423926201642509833393672779197095195785", "6906061554583095104670274977270927222784149562340469632678517151372610188027", "7241632034261962184770214288273863345887509924743840645741009488606102609959", "1777064018030106946519734883392052222472885181372642200849135017704677431530", "8324217430862472098854213825386877833955599293369985729017003228770288665572", "5729830751513293833514450194921789300271973437013945551402502294027950049854", "2187758249046601765496974874050556156501868988788103300613705176170900124540")),
+		[7]fr.Element(unrolledElements("3661666483638282285384645460120103900291506320035168165941968172560553131692", "71172694711798875087336054947012411069704167761224496888177661616309271064", "5479753513109529765878513587350339376225541758759421668496911367601412633643", "6657339706920780308636317262842926498794391181237998790979567359048507409879", "2844473401317966605062077825184314014360268580545792284786608033783639709289", "5533028151199355284748909539327941418386476750051202689820514906007980206539", "2147788020290210661458158885081344432970895350439383460330782349760173328255")),
+	},
+	mds: [7][7]fr.Element{
+		[7]fr.Element(unrolledElements("7238110070938603220784707090384182741179342287274911852515914390786350776321", "7388904030749824121217721821433853214953911918259805849443329273927733084161", "4691367638571316902360458299323081406319944075085591015519574142176338466134", "7600015574485533381823942444903391878238309401638657445141710110325668315137", "2303035022571373752067861346940421781284336182314744680345972760704747974284", "7740756603642672888894756193883084320427907723891225175607297334590958469121", "7794887768703111160845069174259889105885445540142212764247907805462223912961")),
+		[7]fr.Element(unrolledElements("7388904030749824121217721821433853214953911918259805849443329273927733084161", "4691367638571316902360458299323081406319944075085591015519574142176338466134", "7600015574485533381823942444903391878238309401638657445141710110325668315137", "2303035022571373752067861346940421781284336182314744680345972760704747974284", "7740756603642672888894756193883084320427907723891225175607297334590958469121", "7794887768703111160845069174259889105885445540142212764247907805462223912961", "7841285910183486822516766014582864636277620811214487840225573923351880007681")),
+		[7]fr.Element(unrolledElements("4691367638571316902360458299323081406319944075085591015519574142176338466134", "7600015574485533381823942444903391878238309401638657445141710110325668315137", "2303035022571373752067861346940421781284336182314744680345972760704747974284", "7740756603642672888894756193883084320427907723891225175607297334590958469121", "7794887768703111160845069174259889105885445540142212764247907805462223912961", "7841285910183486822516766014582864636277620811214487840225573923351880007681", "7881497632799812395965569942862776762617506046143792906072884558856248623105")),
+		[7]fr.Element(unrolledElements("7600015574485533381823942444903391878238309401638657445141710110325668315137", "2303035022571373752067861346940421781284336182314744680345972760704747974284", "7740756603642672888894756193883084320427907723891225175607297334590958469121", "7794887768703111160845069174259889105885445540142212764247907805462223912961", "7841285910183486822516766014582864636277620811214487840225573923351880007681", "7881497632799812395965569942862776762617506046143792906072884558856248623105", "7916682890089097272733273380107699873164905626706934838689281364922571161601")),
+		[7]fr.Element(unrolledElements("2303035022571373752067861346940421781284336182314744680345972760704747974284", "7740756603642672888894756193883084320427907723891225175607297334590958469121", "7794887768703111160845069174259889105885445540142212764247907805462223912961", "7841285910183486822516766014582864636277620811214487840225573923351880007681", "7881497632799812395965569942862776762617506046143792906072884558856248623105", "7916682890089097272733273380107699873164905626706934838689281364922571161601", "5464063484924239686278651430976294814419699569805570712193386353828911860556")),
+		[7]fr.Element(unrolledElements("7740756603642672888894756193883084320427907723891225175607297334590958469121", "7794887768703111160845069174259889105885445540142212764247907805462223912961", "7841285910183486822516766014582864636277620811214487840225573923351880007681", "7881497632799812395965569942862776762617506046143792906072884558856248623105", "7916682890089097272733273380107699873164905626706934838689281364922571161601", "5464063484924239686278651430976294814419699569805570712193386353828911860556", "2345683819285658451180229149661540703159972037542795507759787071088169233067")),
+		[7]fr.Element(unrolledElements("7794887768703111160845069174259889105885445540142212764247907805462223912961", "7841285910183486822516766014582864636277620811214487840225573923351880007681", "7881497632799812395965569942862776762617506046143792906072884558856248623105", "7916682890089097272733273380107699873164905626706934838689281364922571161601", "5464063484924239686278651430976294814419699569805570712193386353828911860556", "2345683819285658451180229149661540703159972037542795507759787071088169233067", "5777789618029937658696564431797900258309825860894885777008317627732964216186")),
+	},
+	mi: [7][7]fr.Element{
+		[7]fr.Element(unrolledElements("1", "0", "0", "0", "0", "0", "0")),
+		[7]fr.Element(unrolledElements("0", "2971532415911286051480807062801752246591259658502923419803409154127716874227", "186721909275808996213483557735547595196583288549267688741666424698377478502", "171569226090454573056015571244986560811254383892286118258836989224221642407", "3059343727662923148040974376454314232464250064500758647733074274651761726585", "4534790817941674769520151899347645131818454957902974337909760859064524815201", "752504296504922106039276029343024737384939687135507584023218842040330119392")),
+		[7]fr.Element(unrolledElements("0", "186721909275808996213483557735547595196583288549267688741666424698377478502", "6878014754292273965662364339621838293207934661231232074331918091480453008340", "183363162394153695662618300137233056599401818684870300820436590183000839325", "323309267169864967183993273237976405791799941298379378023660391163003148074", "2984385967212965149577676299752889643335871322269624334601938328063273591337", "2387384200502060729167223926301911620388467206818002709702008525318991518803")),
+		[7]fr.Element(unrolledElements("0", "171569226090454573056015571244986560811254383892286118258836989224221642407", "183363162394153695662618300137233056599401818684870300820436590183000839325", "2202640673133172626883180044813653960680889430908222833514846101262482081955", "4888295793251851281899864344306186279311431201119991135199837230820994784636", "2433731852407108819432196859978838899151281290436756458939839325499215812031", "731926506771160032112511263905821283491821021472892417724296623943939021102")),
+		[7]fr.Element(unrolledElements("0", "3059343727662923148040974376454314232464250064500758647733074274651761726585", "323309267169864967183993273237976405791799941298379378023660391163003148074", "4888295793251851281899864344306186279311431201119991135199837230820994784636", "4879307465283136396656540908967210645566803069610027203615451315780292362539", "53588176193090599021120787870659787980315522270614534505384592464815769697", "408978125764434241332678747716884128531757404579793070344432643042299921165")),
+		[7]fr.Element(unrolledElements("0", "4534790817941674769520151899347645131818454957902974337909760859064524815201", "2984385967212965149577676299752889643335871322269624334601938328063273591337", "2433731852407108819432196859978838899151281290436756458939839325499215812031", "53588176193090599021120787870659787980315522270614534505384592464815769697", "3547177385091292298144724315597646110249274215668284461731278710569085728882", "5351366443528656762905344630680491023130811138760868630072321645701479274440")),
+		[7]fr.Element(unrolledElements("0", "752504296504922106039276029343024737384939687135507584023218842040330119392", "2387384200502060729167223926301911620388467206818002709702008525318991518803", "731926506771160032112511263905821283491821021472892417724296623943939021102", "408978125764434241332678747716884128531757404579793070344432643042299921165", "5351366443528656762905344630680491023130811138760868630072321645701479274440", "6443272067441507346009006217334521613736782334945581007272802366242342772219")),
+	},
+	v: [31][6]fr.Element{
+		[6]fr.Element(unrolledElements("3935774520161526740097689550249405987046328390443521871924853723988707896330", "6918786608398592617276629876715484486041233343502195959209083096568163992460", "3798804619192181012547382063045284903891320386224253033203661634354532335359", "6958359647886431719493646056064811341300360163107724957953149340023495089048", "6983827742609619254818998935222656639601430411092917449808525919708994534917", "2677669127039915070153922045109578473191675770880810440570147864345552626822")),
+		[6]fr.Element(unrolledElements("5177706977962292842851753131934739566931571273157939983013622125850694666613", "6027171566415239037360005269963084906418120366156018575196784770066430293982", "7298767999809211852257612226305319450835672742831373219899936566011927244606", "6628611823733949782085400455554362990990087712656001119059742556615651144627", "3912675480422924202570397920970262758909643800193454897495816031838465649584", "6776290655773766806134037706320799618035287628352809475860968944984210923889")),
+		[6]fr.Element(unrolledElements("5713473266986796506811001887871816973415558565554940938139635148763794224262", "4637959147832857689884957887319565727209143127817227072780119194297178805014", "4855692527966263955051790710403356853449219823450653161958996979000781189584", "925019382016372314618558131501990895754156999722676544190390953561091127847", "6105423255520462525662991288037968308792655943978581420535598040036599991107", "2583976545504230682979644750684382262608387235801641445703856992557442328241")),
+		[6]fr.Element(unrolledElements("4257326995725534305721882447368934910773550510778071306430418447853777775052", "4202117970594662030203468603818939602501567096577993999017471841515492725043", "6976403048862011221249333237090614675202663631455431445386958934096391690840", "2808537441332221258433677344213859082405312320403187797854727579979313542432", "883388542378631172482616227511249901109681641934985320810473744099020836845", "2977107441688382108192492711126319843440372379163053797449179494013580160637")),
+		[6]fr.Element(unrolledElements("6547869538324066602850908408298264694345256117322034970199481297929958411615", "7332387606884107254870646732635892592261432704483778002493738638111043992105", "6742000149393280800083266454420071646721497345052615543539504296217070579417", "6495994531606341510751550342241116238121080840162105656705775342189804517181", "586603087362199507562771084888513879800221064624129056723478396083904343376", "6774051440877927848134032916449754949453620909444130679324306069789791753664")),
+		[6]fr.Element(unrolledElements("5693575984813870208886090686958376767942919984076686826314075819406693357115", "1673383684557377085138754431432381708512163663619744494364185316167642829139", "5742486983635806136512567664316411501363882099678481167078842119610752489373", "1694513786365278810553764605135878317964196139382277672113919449181980565862", "7380814210861661532301767653840588064429392733090126387661183815352799317375", "7085891307035659653390675208067614851382123841240589557475796465408539761469")),
+		[6]fr.Element(unrolledElements("7702815478478514521701541123140603389699263970322897445281310660622840913737", "4188794212871506418360682573034054099921577729476808717530816826995761620864", "835608170379202553185618372885326648949518436995563652190406505915370722674", "4046128912327988078070215313283311540030645981021489992643379538966290463143", "6800097811760596404542299388591346960309301857724844653559409281805123921815", "5233002066189155441689969077161996207831496096774982003037111756375909305057")),
+		[6]fr.Element(unrolledElements("897995524923151874204111111262730806578836541267003314322406203899040805389", "5479704205758736332607406159422767470050176207843890033213762409088531337153", "1681670828251006565041847492695775223718890987641504922146296378400634282651", "4732714417093501014308524891842081985702371381289583422397068939092320865389", "7272371774227848893494196263072662939920596010915412568335234864584713153035", "7493068195260429794675685959421480208652466916482902456883009489376311197921")),
+		[6]fr.Element(unrolledElements("691280730493729216703430834325558620682769299173691343365944785758763047868", "4119931719292839649421913734519490484021535835673728385117744421025476922754", "3336230237376767290239549407498555620337888923640971638181172506247523415292", "5062448259439827405458323602642928024404096034675093829669184681939070922779", "7213237486779785173776222423449751232607441389041088658694926504335765055239", "279468899677344087839039447509430543322933366755265852333823117675260543537")),
+		[6]fr.Element(unrolledElements("62320420000000082093831685700692039543455003776204858891538140700730562780", "1030473664643997546892309530264610266562252064765767989420593343119523365039", "3775424158905106596190209034980311526318993016990633917227873200003608699756", "5974263175179782189682688179096432481883774971814035358332879844030775931428", "2640226464730323586704804033099750959805295453135356862672242892720496268119", "8002163508658524653520615183578621606921539531587925684792192660184886980755")),
+		[6]fr.Element(unrolledElements("3986684349493474991649489907681056939682958623892310913719091217007666755897", "327176924856451208981276134998379869770062842638901321796043647507966610743", "1187796565822843833708875996460989236478576641001829504485590611431129523823", "4627537935009432513142903965547069426720172883635794424402963121304865007661", "2700275217520338411096320582320968544157205723036502430829135416335561594133", "2010982744359185978033316039085624780275063506780801742451967544059579582778")),
+		[6]fr.Element(unrolledElements("5194968509028232939505565441480429795924044289405054432844873084494956981042", "3339670687515266746076864263975658693121911316758729968755577987089274104131", "5651849134818027659080808521198039120782090975677462664032542433372486989729", "3420499397483573303157014937470048467469939224584966186661369284206294376334", "4129598328560531351709336581780087796070371276088587604563881631487174216338", "6644756600626228865698859345091173225505897653872661586955701155264222960300")),
+		[6]fr.Element(unrolledElements("4360577751598251443789350641893513497267286834366304610297037166297502140865", "245392484555628045512953049249084465517336666377483464922307079679412032594", "8131376494830434978991910364061547715814456858699143618464048389850916495304", "4303357116753189179755435352506698625993860632018961817221653363073421605551", "5475538941174116944168813910979568357127272783189294540580767652301126750820", "1213363963268142990085233684143983290510566594530609859286044551429896518762")),
+		[6]fr.Element(unrolledElements("2169089802823167233694168231653589499983287197905996013634547680871589911846", "8043366846396684996803153547701316907486853456017101110993970004381981172608", "4337024048992420305249758485692125528051187917843229901637206221823495725611", "3879961221429927232007254860210576170111768768505481975736357869623697449267", "2831448238952612834889178505177298512177094599330545187556904977665994153519", "7911296809503676750079719485483266056330132951586854486165456996937281246225")),
+		[6]fr.Element(unrolledElements("3406040245914593459201988905832973653763939543017083311401987597133896186004", "6719892518358096922360311024656759390112987630121744699683778208789109708980", "4120931279238012564995997542799695468600389707240705956463031772869350982446", "4821529834864949965445702867483423396497428237762794632531037976039217207758", "4368153594563176824445777540536364229696491498760708732282363653286727590317", "2809979344891853744733163055173964932021145937950394306579825690484434996852")),
+		[6]fr.Element(unrolledElements("6913230520739304537684162939665887112556563997976053315466810090217988752944", "878570080567377334739150071807321599939730189375918370216074727611936409223", "2937090995014433089078245105618695814724783942922696703157618620510350033232", "8321335430874574197004632306034006293742002828891072892783045851538109349687", "6703396323441407289054101792401921107339395525549166965716835828144862935657", "7981157534429456627251922015725247638058718560368000049447170697424187823765")),
+		[6]fr.Element(unrolledElements("7659164801145646626814986844830311716251402235734340761554764194202408740203", "2280572786444500051293327673737782500962544310705112166461600186675096392629", "5797289699486501736648385448361731791173415431904203287915686506129309317811", "309516798119063989349747065107692209017586995167526997803141110082423199051", "6987829457800001580263330220326477757312146002166840381406236751807812734595", "3573293242211115572375585879384780991073468600067229441489796956652145548760")),
+		[6]fr.Element(unrolledElements("1457452467467324445825354759502437222687338714696576946498157051037951095497", "572185086166093350300248602124835164252957797536269343092702972292406721769", "375507034351175620416183353751741018151850383770460361090460190272934066962", "8385315398347827003747853011292188020245328012469399813008893861594009021083", "2018462698917613238438997644206820102431756452641295936632999179192615642414", "7908888764265328835673473216839016757412647830054190424517934029795934333335")),
+		[6]fr.Element(unrolledElements("2823198562735937069527670429520260372423636886876867432966981737644494818575", "2408256888424885112079591420303146947714638647773938774567664304344118054240", "4658918560159645496186157367158442238529974205112543302077659248985492246584", "7047369142792914103336136353001081256611097211997660456007691752759174101341", "4137626172684074448770720893250301887786479528327279266087239230936650360863", "4600823758612394242491233053721253163318785578501576974189513328159243409479")),
+		[6]fr.Element(unrolledElements("3323526238693028291659175131900839845766559375176859383887048667810017779920", "259015480570643608683584850533938410337013173115267025007113449688099907661", "1782523703735097240127183266065276335911308681464729426279652136355520240270", "2505527043450937088610326430439132872477914039077900293440797157888058002140", "2706759487962169205717504449325545627039776222411638449607886578747780287300", "2768515238740647481746046219999136104654387949908480290919981398852317543977")),
+		[6]fr.Element(unrolledElements("4955291914153931034825808818521925115939289999350929183099214254110926278122", "6987333570739662956676463142636190127757043786003275247382629721771447545475", "5528748383645271914644859952110503234146840824880133768530126692882774213853", "4709941490691789097736576597266091150508411443160619838738784384406239651314", "5635257327009345870850179100670864958913188441579286691253101961921449428807", "6357447447406381200258919404586301438328184250241912943129018587607864264656")),
+		[6]fr.Element(unrolledElements("3779930024374424479555590057209848113560145826106709240135203119060739421956", "6961850372861798510287610705950380316759460313239607079580294484733986084733", "2984249821372139948857292705782224945602096484420679430340040977927960386230", "27316756714969795316490676801391467524785542207044951498839555833562070262", "5976606160493736325868817736717792627076259638061437576653350786274679361773", "224876311782950451539995454407053277116060857536520210942760060162752854011")),
+		[6]fr.Element(unrolledElements("604259321698896847790373602007734259257224956435886231718382787067516452575", "7890756385333024244643106904245128455457999845996640138648620086273529574872", "5384484290573392919892685721229692603955265518656239599366064134080331150756", "5075492807667910554854483463036160344630540108549987845023663819348285370139", "3759501637553048143369895401832756596707795281888811801227629449525465314470", "1407293680556190241562496593446139970756456679522246187412238430873051529548")),
+		[6]fr.Element(unrolledElements("1804643137399501997892145221142349350062557457891317434975618667555841579290", "4148350407437797777266489015241457439089581174966917344245442806404723533240", "1362921597439020253582567376557520709739025907567831051509120598334422442772", "7767987822764109549530323326214711330446552401944236086829655879830224255404", "738474159265524751959043072028569272247479454111304610092650100105873348475", "8126496732685771106040829934119202733259053277370631193395442806484780250105")),
+		[6]fr.Element(unrolledElements("3633240764036730302829799241612384674818969520874781821058805867490455983157", "2271709291405670066056603360416702015832511486624100124944712619160478403539", "2193969055596424961106027792474671936780332680786760487915444318615876218096", "5325697257668381005942764630668645989999759604505406772342071072526706670307", "4730786278433336134651262395727595065042879520766462401226585451158905758810", "6470817977317643965786306269949209657397893408314615347946520127545549358598")),
+		[6]fr.Element(unrolledElements("6344947172936788237003814657546218017886560198045266774401313596768565864541", "3713003075137186807051079872378882285939755720355584380535354110659107294575", "5152590182003496369775702721302332735216105958051124635273579451994132815179", "1307013476906003321873015425370921844153846593509911768631796973903071734623", "7678377664054863651940730677777426644560395525437392418262453813469093423909", "7585889197547988929336839597495982627948266501715953301285214846469351262193")),
+		[6]fr.Element(unrolledElements("4271217437818815949938990517320338285099458061324498208787759561521720261113", "7133074491574613903248543904967935845737671052466549454760124028941233544522", "6296277583242434657903691348184845043089157989002336901640786368030837706503", "3166968730869456307780998523723323465244619347522329739887414362745055780253", "1011565385556723881562935636761493104958379987370689642626714748400539653406", "2954554307111187065491675606782183162991387038143329464047999217849246204012")),
+		[6]fr.Element(unrolledElements("2327497137436718627416140967107253516969051692346333557812593379728310855116", "7027060212920793663243495857988487919802233961807448052017033131129072623221", "4986892509600647556741276362324904991790885183785366525418677315894485947761", "1514196322905422597773563541749108811799537189448954309915737826284173592065", "2249325158676764834620105602954601732785960081258375253389376891593204700996", "8292955926579449576107812791030712311452043412867290027596539289541535522336")),
+		[6]fr.Element(unrolledElements("2768918473928334563491601943898525318711312384477821833903327906778447410489", "390642325379555301779940889089724185339708502097253513681223943887364803760", "3341494777613818948885593684620865393793543176676768812401695367227353712488", "3238939092625012882967350941997562123576024993494722172637725731749557095600", "4719349967830965473744809299509667812604001855667592936741585606643692149352", "4118738110220967041544862372729285207062492976349765936513039911780592002098")),
+		[6]fr.Element(unrolledElements("7992079869994707722949780745632535110052190442199381837152988806493262315521", "2400974937883106114672334551046419876877314235570589973299839194906676079275", "1495094186720882779478446055629510586276010748690967517684035711738816437362", "4553294399166630813180549490103937947111116882222551044268790113481775975974", "4721650076204707538087345000927459081142480643047726768984534143485978627990", "2863481130378056794722734134719622953652020593221982834037837906540290599614")),
+		[6]fr.Element(unrolledElements("7388904030749824121217721821433853214953911918259805849443329273927733084161", "4691367638571316902360458299323081406319944075085591015519574142176338466134", "7600015574485533381823942444903391878238309401638657445141710110325668315137", "2303035022571373752067861346940421781284336182314744680345972760704747974284", "7740756603642672888894756193883084320427907723891225175607297334590958469121", "7794887768703111160845069174259889105885445540142212764247907805462223912961")),
+	},
+	wHat: [31][6]fr.Element{
+		[6]fr.Element(unrolledElements("7558776603930954101476040867719934215584329313775144345099897265341944047864", "804545479171765838397001620010222983942166928200971493451522183079506799291", "6658318296305447316451052953794899075501719904570254317018749819128942062534", "2329968286560061123042124490889763719808027907946200461336848278774006569355", "4647212202583877826756895323295662908730216001878570539128728543881363358774", "7479084682217356250593141538344815887129372946396996095370364143452914287245")),
+		[6]fr.Element(unrolledElements("6118385258536040171789235122924575316438695697671861034911199061163074354147", "6421295601851675449477168237283435552411514462601604107105032584911433751679", "306373887315817443013092597694335151734702043938881288546684435295134210676", "2241657777906355269052947603870169536855359103764382739209403126592539928079", "4692167130329292138793589348243934406799530771369829890306782340284201189081", "2192185799726907056194145512061571885230129215042723669930788085067747858131")),
+		[6]fr.Element(unrolledElements("1548106820955839682293083625960349362535457133113634764223604681890115013651", "4465281856335466678255125519673078819332377819720506371536654186525547242633", "969773745441015858618983302688992181994425869758184064198996358037931424628", "6280083935111582346708241309314509219070063468817439813483624632389419612812", "5476655022179055938380910634396262067255155917597423161617148936716657922289", "627097700844318626010053727275562019117584226452500403394783478047233703389")),
+		[6]fr.Element(unrolledElements("36842954376282979636557832682570642199266089699974775228248015408517509598", "3026632691904039826108148794203883114232689902166652584331086002056629405140", "7531168873176286077504374988548521825061872227636117160324933042887207703416", "4106920952486254052597445599294048235835798103471802269193721813167710014279", "7269478701521338174232988927503711794306378586726632748184437594824939217117", "558872339179120902377263825890376033023587089352726486070837167228158365326")),
+		[6]fr.Element(unrolledElements("6714817096485701269481109364929965797023541742145557630544747997567839963519", "4331876343893338488032725680021976137450544850291034412406871631704743586996", "4635491174536761845308076787184519522702318218214225197392511044101416167838", "7148265332819971213228527024783778597456328611568263264306119796668704183952", "1444627790663353353628686023038051023634720862749153089014038404402852461112", "5432552600858643112835107870131399115614741437380771002580944946833822933486")),
+		[6]fr.Element(unrolledElements("1411014658510544882011475882370510673755434974948582565623271410876437451508", "6948020882432572845197507952592969590694368103230430179570315615182282374398", "6722964559981414065014969805335853003762918744908784920346397308171250169960", "2455008936040100351240439755188491639058940013744234884624742038391114271446", "519816307152140593257787552575361125906303707515220408961641101170845443050", "6990382301199766734460364366996477159573560505261295017350942537257110652072")),
+		[6]fr.Element(unrolledElements("1044627383774178740246927034855276531721947891017405155504934664363963744205", "3358443304821405853608077528484150146664863668795435090092302471470268187768", "4393709706901091014969955480781664800996324532875596929838737765289660236287", "5249451643950974464239301173831437707893267000803842660603692970765214658517", "720403292222864764664662027099398853890117824525950586777116939477195987659", "5135595196690594471321330706235194948208391266704109894291833948815105874156")),
+		[6]fr.Element(unrolledElements("7426963327539378639426037730546639936416434663632106359847322808760959464570", "5274296489964292775051093492157325226405689693565091986859809652401309607823", "7827241313868774196245990793266909562648160445985645425406006810232271513840", "3043499501949644477529208269833298836634909727183364948493064469038391134262", "4340713803276461970375821544340407909092462955482721062263750193918064951694", "5727982185296156082216966520688456783134943785289401264046725930042185635011")),
+		[6]fr.Element(unrolledElements("3393918342469034924348116688940468088553725054272081137005893644398233292695", "356281899587848124367887360007515220926404349673435312940743989041434010720", "1758742446842427957265238289578738315501946123766607371038897954137567662312", "3153279758273655617261199850808939384868848311566803603932878356236952020290", "8018464815556207986344462980286589026737429793071319925512400071857345028066", "2385617689466097179116916530734940962465651152336581042090550709183334141063")),
+		[6]fr.Element(unrolledElements("5160102254338178203639481010905547148079652309603644336740772043727665352789", "1908587684123490184383493488393345901623694825735646560701708684401216116190", "4607754449958274888663224753679727931518293982567530170325818946763802137602", "3077300517885992872375505511813200362773532385839710312034164426589540687002", "7532020324106484066925224084668148085387790393589392812602736372278615054276", "344977220186613806599384685408032848394016886221781259797052361089856749437")),
+		[6]fr.Element(unrolledElements("4783393037952393291676157314203688715572568898491170576394490367655354700330", "2564092004508338917172131695917182632124512867963633069036512436771487570987", "6234763871139151449222613007869400141739427704391662491715437446263922756941", "689103049127644270925099406037243367835562644586412023094949236816236531237", "6851846354321535101578840356262994164671494700472243045405214332342721815881", "5997292496028420476680707772620912594076026332432398364354381333842798084707")),
+		[6]fr.Element(unrolledElements("8413802661647996069929242978558377227858274358336126427211903639327803833209", "519364413056777122986410731670806373800886479563051852550120848319225505878", "7926804546501459228201204623325131860939001673282462498572744669512703076804", "5650310321905591539559963214069793334754737568666543702646448786051353553296", "2212379372877387742218381359390418343683168694381553896470110889296755279013", "6836660046038469548805291223101800861311375959194362298531717617207198616422")),
+		[6]fr.Element(unrolledElements("4870629149819877404691719630975370611347013605793410713942417073069370371875", "2399078142980031140291521751388842961085997337576968862512067215563093822204", "3708877822583507743350110323522733863528643355466751851275704717214938918404", "4659934970686421571857112812473321425164764393952157820004126478998490835412", "4943573888412009109384646950610736804563962845255262704208403178778984841942", "574190747345028748757479941424031591917303217731663302226030530784513235264")),
+		[6]fr.Element(unrolledElements("4455309302437988400247978836649149373064349787700165136763216616954129866804", "3498248107762194682617173362097779965602870558832307975328475786013507978508", "1566139969472275797575198116176816662668233129953917181580670671577078937849", "1355147466212180870983886499378631512348142617378543897159003413651672684920", "3529686117689783675017612646957517855192834636228967262187459527141362160235", "492332142737138848780130509214129818118200587391154423991013494407255765902")),
+		[6]fr.Element(unrolledElements("1271681513644441576881320605484022904856585972264708373840155481588191464418", "354302809621521641910052682190715354449567975654901328882040313605911394317", "4864686249647799165085767915833712762108918965054447425381759787413914253569", "3308000603710509123917501267387805188495421125708699886017151696212993621103", "6301637987523405000406147772970404642530461143188930033078907205473208589886", "6604928023250481449541821793422532372126522613671917245836875687405344779190")),
+		[6]fr.Element(unrolledElements("678037994656638315044320375806213045333413303499346347761819782873358347299", "3068859481908195193410796579511918175163642611384057677410991117039998101518", "643496455385992335466025720988443201782291086775247314069940336031282257224", "117008816555618128517407003888800521248168345403971286470219940711833605941", "2193556659204886673185266460823286263515142486003081854295416181816963862972", "526484012518620576802050623949095054805646484574753287924982547880486118095")),
+		[6]fr.Element(unrolledElements("6525055137991025563442641792948601343252219443789817517262328532767297194932", "4176104461436890274614849205817920116490813517963174316393509490755333125639", "3763688205247176372714916105480966950087380206203629894502527791223781539850", "6740121658694194863667067253050047084682118177445547374080792520025172439572", "277743261640993205738189477176807889297707699564529302694786704219164803122", "7561320922915877454592910217702443984638836770715812956701473878298965141543")),
+		[6]fr.Element(unrolledElements("2729197371782575407599408379923690936957171100442605056593066227422321836812", "2504325774583278165172327070553467359549266683503885693897641678809738304364", "8010149206438103026942512144092961515512701514783471849833737658763585770215", "7740593142796423942166875020873412727523565414296365088900809828363014813078", "3889214044397985400634811245878178974364309864185248558312333288913117782467", "7147195344077222712727860397001802884814268857523195957699287548038977344480")),
+		[6]fr.Element(unrolledElements("2779629536295250731092438621118036921956697128125483760481776504081122115914", "7394253754673156168452362255058891835420133990688577653209860752925696911785", "415745502887986010638221288974137687574267613480360770748702995943235080703", "4713860786245012428396497117005083142025054946867179299997449162783175709618", "7421846132699326412452212935882185153701192583144272690124119786946541259588", "4269853562516389470459167819117890630233818826455773032009793737526728914721")),
+		[6]fr.Element(unrolledElements("737417062310641875717854177587329774735105148895641355087952769430553423077", "4273603383860682790263241825695980936923693387937117633041424439557261189556", "5613953334394940835192344443865169572035923269136339989725300651448437779043", "5970741496963160151975575467965326108716841245297538137214719049177398175727", "7183381362659247193528739142776882367501368440330884271377441605859256127998", "513780765249169544963825173309893735540585013338068892775669096687804559139")),
+		[6]fr.Element(unrolledElements("2454094302824264707580445601970407527679048995808759039105841156977396020695", "821335875430970773649202361630472362858048177062168250440298869253462060615", "183573162384463899471570488290746850693500901219325686699411738076309511863", "2796668117021201703320298849755532965523877585208601885396540013497772367925", "5162031161158159530842102736359466134637688706608492790439123517498529951931", "4091570422617304951741307638791061524968671405739168022549238038269479562245")),
+		[6]fr.Element(unrolledElements("5527133803268077686151225145760980816565460725760488585529151764746659819444", "1420465977977641080545070749415982853445599051771707088951082713745042631908", "7047376190395367960949164888487996847279638781138175230063141865103094484458", "2165145780342348990506906048672931216628755607705753985811995151202431804300", "2330363392623895453330675002828476643751718479485796885705257620311258600906", "708265230616189882069062451862440500827149299926423694051099015440875980243")),
+		[6]fr.Element(unrolledElements("2602263341124119419147600624827447438986082328034425967696066618778512554933", "2165915860896437438973961461429867834313126926074653100329148237021540506883", "6929600495258348942343968645229240170562040042475317316542332746442883564751", "7091627611238271039649712494089312289697889252980980096327086917449089768931", "8401748971212958946521855216348549536545094243962934281840752808796068499726", "7688672667136922415527838349445896811460438379038109343148065330626378989651")),
+		[6]fr.Element(unrolledElements("7756502168100571184376896302280551277548793166114668639883983616612521596699", "4175157724607359289427558086631305210641516791653385486531603261744641783086", "1416059597121053958848128267926139362734506289103718408674495275160432533625", "2256677883012223113115674666522881880127488799250977469310075151400801433097", "7071292475951265952987682896495006531647953024574866860245107900298149912242", "2211106407139492155021949863554524644063070656030909157002547259089747550493")),
+		[6]fr.Element(unrolledElements("657502230041317283767025639240476313891746830730683023968962359712", "8444461744373059349037989890554749705790828340758533079207675960904452050641", "15016066346629390132233299998858409247978718327591153325656946772480", "2303035000917898327698950636348149247382811606268184512293140923890221835113", "6141426742078751808016463421651164549794229779725621836880502819458640449688", "5432800561878830456759146117323331318538544406461292221688948900282725875236")),
+		[6]fr.Element(unrolledElements("8649849297986071665276157533420698605253745215327156136", "8444461749428370424182319188751980067582585362494872935535074887074931523841", "197545658421149818917561971141042446745854486127340997440", "6141426726856996671896098703722601375622694308040571764542738889888950803608", "3070713363428498336290733417831436517150502940615193805698933884644612054444", "6023322366725131351507016665858479160691537412781490695942055328652698883770")),
+		[6]fr.Element(unrolledElements("113794127934652073573359854518539457356074408", "8444461749428370424248824938780671607019838393861908837732848909298605355941", "2598835557921291792385119776205377404281832320", "6141426726856996672180963591837377175954278226909094808814703658100074323128", "3070713363428498336090481795923196809230236874981638381328917319064102149484", "5609957106263602729396072511777225851849766536266586933036012637836350010753")),
+		[6]fr.Element(unrolledElements("1497032304330324162618458541417474", "8444461749428370424248824938781546531375887824981810411585137515900771992241", "34189292491655564496915855909282960", "767678340857124584022620448980140593761396092444739590789666329896247709571", "6909105067714121256203584040821265343853043204558323526628825895326181628259", "3365974263758161637637643507066770295723251041050700771527752329307650396823")),
+		[6]fr.Element(unrolledElements("19694526679434762608922", "8444461749428370424248824938781546531375899335154063676511134982758038587766", "449782197840604804334880", "6909105067714121256203584040821265343853008546944233392444095909506781412099", "1535356681714249168045240897960281187522890788209830242838847199288190395902", "6318583267054794653109260618528849502498050551478914766817831298892896986601")),
+		[6]fr.Element(unrolledElements("4222230874714185212124412469390773265687949667577031913967616728218408433999", "8444461749428370424248824938781546531375899335154063827935233453922486635241", "5920955126100", "6909105067714121256203584040821265343853008546944234041037918273581901234739", "5373748385999872088158343142860984156330117758734404254140603114305521263287", "7322470328175649878369610436425956432801479143769957445202580058028627621964")),
+		[6]fr.Element(unrolledElements("4222230874714185212124412469390773265687949667577031913967616727958704619531", "6333346312071277818186618704086159898531924501365547870951425091938056929237", "5629641166285580282832549959187697687583932890102709218623488970611606159454", "3838391704285622920113102244900702968807226970524574467243287934507913290365", "6141426726856996672180963591841124750091563152839319147589260695212661264822", "6082374546791064011871531249611883165956067353292787232708594726989462598734")),
+	},
+	m00: unrolledElements("7238110070938603220784707090384182741179342287274911852515914390786350776321")[0],
+}
+
+// permute6 is the rate-6 permutation (width 7).
+func permute6(s *[7]fr.Element) {
+	k := &permute6Constants
+
+	// Full round 1.
+	s[0].Add(&s[0], &k.arcFirst[0][0])
+	s[1].Add(&s[1], &k.arcFirst[0][1])
+	s[2].Add(&s[2], &k.arcFirst[0][2])
+	s[3].Add(&s[3], &k.arcFirst[0][3])
+	s[4].Add(&s[4], &k.arcFirst[0][4])
+	s[5].Add(&s[5], &k.arcFirst[0][5])
+	s[6].Add(&s[6], &k.arcFirst[0][6])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	exp17(&s[6])
+	permute6MDS(s)
+
+	// Full round 2.
+	s[0].Add(&s[0], &k.arcFirst[1][0])
+	s[1].Add(&s[1], &k.arcFirst[1][1])
+	s[2].Add(&s[2], &k.arcFirst[1][2])
+	s[3].Add(&s[3], &k.arcFirst[1][3])
+	s[4].Add(&s[4], &k.arcFirst[1][4])
+	s[5].Add(&s[5], &k.arcFirst[1][5])
+	s[6].Add(&s[6], &k.arcFirst[1][6])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	exp17(&s[6])
+	permute6MDS(s)
+
+	// Full round 3.
+	s[0].Add(&s[0], &k.arcFirst[2][0])
+	s[1].Add(&s[1], &k.arcFirst[2][1])
+	s[2].Add(&s[2], &k.arcFirst[2][2])
+	s[3].Add(&s[3], &k.arcFirst[2][3])
+	s[4].Add(&s[4], &k.arcFirst[2][4])
+	s[5].Add(&s[5], &k.arcFirst[2][5])
+	s[6].Add(&s[6], &k.arcFirst[2][6])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	exp17(&s[6])
+	permute6MDS(s)
+
+	// Full round 4.
+	s[0].Add(&s[0], &k.arcFirst[3][0])
+	s[1].Add(&s[1], &k.arcFirst[3][1])
+	s[2].Add(&s[2], &k.arcFirst[3][2])
+	s[3].Add(&s[3], &k.arcFirst[3][3])
+	s[4].Add(&s[4], &k.arcFirst[3][4])
+	s[5].Add(&s[5], &k.arcFirst[3][5])
+	s[6].Add(&s[6], &k.arcFirst[3][6])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	exp17(&s[6])
+	permute6MDS(s)
+
+	// First partial round: the constants of row 4 go through M_i.
+	s[0].Add(&s[0], &k.arcFirst[4][0])
+	s[1].Add(&s[1], &k.arcFirst[4][1])
+	s[2].Add(&s[2], &k.arcFirst[4][2])
+	s[3].Add(&s[3], &k.arcFirst[4][3])
+	s[4].Add(&s[4], &k.arcFirst[4][4])
+	s[5].Add(&s[5], &k.arcFirst[4][5])
+	s[6].Add(&s[6], &k.arcFirst[4][6])
+	permute6MI(s)
+
+	// Partial round 1.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[0])
+	permute6Sparse(s, &k.v[0], &k.wHat[0])
+
+	// Partial round 2.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[1])
+	permute6Sparse(s, &k.v[1], &k.wHat[1])
+
+	// Partial round 3.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[2])
+	permute6Sparse(s, &k.v[2], &k.wHat[2])
+
+	// Partial round 4.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[3])
+	permute6Sparse(s, &k.v[3], &k.wHat[3])
+
+	// Partial round 5.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[4])
+	permute6Sparse(s, &k.v[4], &k.wHat[4])
+
+	// Partial round 6.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[5])
+	permute6Sparse(s, &k.v[5], &k.wHat[5])
+
+	// Partial round 7.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[6])
+	permute6Sparse(s, &k.v[6], &k.wHat[6])
+
+	// Partial round 8.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[7])
+	permute6Sparse(s, &k.v[7], &k.wHat[7])
+
+	// Partial round 9.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[8])
+	permute6Sparse(s, &k.v[8], &k.wHat[8])
+
+	// Partial round 10.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[9])
+	permute6Sparse(s, &k.v[9], &k.wHat[9])
+
+	// Partial round 11.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[10])
+	permute6Sparse(s, &k.v[10], &k.wHat[10])
+
+	// Partial round 12.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[11])
+	permute6Sparse(s, &k.v[11], &k.wHat[11])
+
+	// Partial round 13.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[12])
+	permute6Sparse(s, &k.v[12], &k.wHat[12])
+
+	// Partial round 14.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[13])
+	permute6Sparse(s, &k.v[13], &k.wHat[13])
+
+	// Partial round 15.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[14])
+	permute6Sparse(s, &k.v[14], &k.wHat[14])
+
+	// Partial round 16.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[15])
+	permute6Sparse(s, &k.v[15], &k.wHat[15])
+
+	// Partial round 17.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[16])
+	permute6Sparse(s, &k.v[16], &k.wHat[16])
+
+	// Partial round 18.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[17])
+	permute6Sparse(s, &k.v[17], &k.wHat[17])
+
+	// Partial round 19.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[18])
+	permute6Sparse(s, &k.v[18], &k.wHat[18])
+
+	// Partial round 20.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[19])
+	permute6Sparse(s, &k.v[19], &k.wHat[19])
+
+	// Partial round 21.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[20])
+	permute6Sparse(s, &k.v[20], &k.wHat[20])
+
+	// Partial round 22.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[21])
+	permute6Sparse(s, &k.v[21], &k.wHat[21])
+
+	// Partial round 23.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[22])
+	permute6Sparse(s, &k.v[22], &k.wHat[22])
+
+	// Partial round 24.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[23])
+	permute6Sparse(s, &k.v[23], &k.wHat[23])
+
+	// Partial round 25.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[24])
+	permute6Sparse(s, &k.v[24], &k.wHat[24])
+
+	// Partial round 26.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[25])
+	permute6Sparse(s, &k.v[25], &k.wHat[25])
+
+	// Partial round 27.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[26])
+	permute6Sparse(s, &k.v[26], &k.wHat[26])
+
+	// Partial round 28.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[27])
+	permute6Sparse(s, &k.v[27], &k.wHat[27])
+
+	// Partial round 29.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[28])
+	permute6Sparse(s, &k.v[28], &k.wHat[28])
+
+	// Partial round 30.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[29])
+	permute6Sparse(s, &k.v[29], &k.wHat[29])
+
+	// Partial round 31.
+	exp17(&s[0])
+	permute6Sparse(s, &k.v[30], &k.wHat[30])
+
+	// Full round 5.
+	s[0].Add(&s[0], &k.arcLast[0][0])
+	s[1].Add(&s[1], &k.arcLast[0][1])
+	s[2].Add(&s[2], &k.arcLast[0][2])
+	s[3].Add(&s[3], &k.arcLast[0][3])
+	s[4].Add(&s[4], &k.arcLast[0][4])
+	s[5].Add(&s[5], &k.arcLast[0][5])
+	s[6].Add(&s[6], &k.arcLast[0][6])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	exp17(&s[6])
+	permute6MDS(s)
+
+	// Full round 6.
+	s[0].Add(&s[0], &k.arcLast[1][0])
+	s[1].Add(&s[1], &k.arcLast[1][1])
+	s[2].Add(&s[2], &k.arcLast[1][2])
+	s[3].Add(&s[3], &k.arcLast[1][3])
+	s[4].Add(&s[4], &k.arcLast[1][4])
+	s[5].Add(&s[5], &k.arcLast[1][5])
+	s[6].Add(&s[6], &k.arcLast[1][6])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	exp17(&s[6])
+	permute6MDS(s)
+
+	// Full round 7.
+	s[0].Add(&s[0], &k.arcLast[2][0])
+	s[1].Add(&s[1], &k.arcLast[2][1])
+	s[2].Add(&s[2], &k.arcLast[2][2])
+	s[3].Add(&s[3], &k.arcLast[2][3])
+	s[4].Add(&s[4], &k.arcLast[2][4])
+	s[5].Add(&s[5], &k.arcLast[2][5])
+	s[6].Add(&s[6], &k.arcLast[2][6])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	exp17(&s[6])
+	permute6MDS(s)
+
+	// Full round 8.
+	s[0].Add(&s[0], &k.arcLast[3][0])
+	s[1].Add(&s[1], &k.arcLast[3][1])
+	s[2].Add(&s[2], &k.arcLast[3][2])
+	s[3].Add(&s[3], &k.arcLast[3][3])
+	s[4].Add(&s[4], &k.arcLast[3][4])
+	s[5].Add(&s[5], &k.arcLast[3][5])
+	s[6].Add(&s[6], &k.arcLast[3][6])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	exp17(&s[6])
+	permute6MDS(s)
+}
+
+func permute6MDS(s *[7]fr.Element) {
+	k := &permute6Constants
+	var n0, n1, n2, n3, n4, n5, n6, prod fr.Element
+	n0.Mul(&k.mds[0][0], &s[0])
+	prod.Mul(&k.mds[0][1], &s[1])
+	n0.Add(&n0, &prod)
+	prod.Mul(&k.mds[0][2], &s[2])
+	n0.Add(&n0, &prod)
+	prod.Mul(&k.mds[0][3], &s[3])
+	n0.Add(&n0, &prod)
+	prod.Mul(&k.mds[0][4], &s[4])
+	n0.Add(&n0, &prod)
+	prod.Mul(&k.mds[0][5], &s[5])
+	n0.Add(&n0, &prod)
+	prod.Mul(&k.mds[0][6], &s[6])
+	n0.Add(&n0, &prod)
+	n1.Mul(&k.mds[1][0], &s[0])
+	prod.Mul(&k.mds[1][1], &s[1])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mds[1][2], &s[2])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mds[1][3], &s[3])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mds[1][4], &s[4])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mds[1][5], &s[5])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mds[1][6], &s[6])
+	n1.Add(&n1, &prod)
+	n2.Mul(&k.mds[2][0], &s[0])
+	prod.Mul(&k.mds[2][1], &s[1])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mds[2][2], &s[2])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mds[2][3], &s[3])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mds[2][4], &s[4])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mds[2][5], &s[5])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mds[2][6], &s[6])
+	n2.Add(&n2, &prod)
+	n3.Mul(&k.mds[3][0], &s[0])
+	prod.Mul(&k.mds[3][1], &s[1])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mds[3][2], &s[2])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mds[3][3], &s[3])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mds[3][4], &s[4])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mds[3][5], &s[5])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mds[3][6], &s[6])
+	n3.Add(&n3, &prod)
+	n4.Mul(&k.mds[4][0], &s[0])
+	prod.Mul(&k.mds[4][1], &s[1])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mds[4][2], &s[2])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mds[4][3], &s[3])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mds[4][4], &s[4])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mds[4][5], &s[5])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mds[4][6], &s[6])
+	n4.Add(&n4, &prod)
+	n5.Mul(&k.mds[5][0], &s[0])
+	prod.Mul(&k.mds[5][1], &s[1])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mds[5][2], &s[2])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mds[5][3], &s[3])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mds[5][4], &s[4])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mds[5][5], &s[5])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mds[5][6], &s[6])
+	n5.Add(&n5, &prod)
+	n6.Mul(&k.mds[6][0], &s[0])
+	prod.Mul(&k.mds[6][1], &s[1])
+	n6.Add(&n6, &prod)
+	prod.Mul(&k.mds[6][2], &s[2])
+	n6.Add(&n6, &prod)
+	prod.Mul(&k.mds[6][3], &s[3])
+	n6.Add(&n6, &prod)
+	prod.Mul(&k.mds[6][4], &s[4])
+	n6.Add(&n6, &prod)
+	prod.Mul(&k.mds[6][5], &s[5])
+	n6.Add(&n6, &prod)
+	prod.Mul(&k.mds[6][6], &s[6])
+	n6.Add(&n6, &prod)
+	s[0] = n0
+	s[1] = n1
+	s[2] = n2
+	s[3] = n3
+	s[4] = n4
+	s[5] = n5
+	s[6] = n6
+}
+
+func permute6MI(s *[7]fr.Element) {
+	k := &permute6Constants
+	var n0, n1, n2, n3, n4, n5, n6, prod fr.Element
+	n0 = s[0]
+	n1.Mul(&k.mi[1][1], &s[1])
+	prod.Mul(&k.mi[1][2], &s[2])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mi[1][3], &s[3])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mi[1][4], &s[4])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mi[1][5], &s[5])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mi[1][6], &s[6])
+	n1.Add(&n1, &prod)
+	n2.Mul(&k.mi[2][1], &s[1])
+	prod.Mul(&k.mi[2][2], &s[2])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mi[2][3], &s[3])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mi[2][4], &s[4])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mi[2][5], &s[5])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mi[2][6], &s[6])
+	n2.Add(&n2, &prod)
+	n3.Mul(&k.mi[3][1], &s[1])
+	prod.Mul(&k.mi[3][2], &s[2])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mi[3][3], &s[3])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mi[3][4], &s[4])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mi[3][5], &s[5])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mi[3][6], &s[6])
+	n3.Add(&n3, &prod)
+	n4.Mul(&k.mi[4][1], &s[1])
+	prod.Mul(&k.mi[4][2], &s[2])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mi[4][3], &s[3])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mi[4][4], &s[4])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mi[4][5], &s[5])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mi[4][6], &s[6])
+	n4.Add(&n4, &prod)
+	n5.Mul(&k.mi[5][1], &s[1])
+	prod.Mul(&k.mi[5][2], &s[2])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mi[5][3], &s[3])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mi[5][4], &s[4])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mi[5][5], &s[5])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mi[5][6], &s[6])
+	n5.Add(&n5, &prod)
+	n6.Mul(&k.mi[6][1], &s[1])
+	prod.Mul(&k.mi[6][2], &s[2])
+	n6.Add(&n6, &prod)
+	prod.Mul(&k.mi[6][3], &s[3])
+	n6.Add(&n6, &prod)
+	prod.Mul(&k.mi[6][4], &s[4])
+	n6.Add(&n6, &prod)
+	prod.Mul(&k.mi[6][5], &s[5])
+	n6.Add(&n6, &prod)
+	prod.Mul(&k.mi[6][6], &s[6])
+	n6.Add(&n6, &prod)
+	s[0] = n0
+	s[1] = n1
+	s[2] = n2
+	s[3] = n3
+	s[4] = n4
+	s[5] = n5
+	s[6] = n6
+}
+
+func permute6Sparse(s *[7]fr.Element, v, wHat *[6]fr.Element) {
+	var n0, prod fr.Element
+	n0.Mul(&permute6Constants.m00, &s[0])
+	prod.Mul(&wHat[0], &s[1])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[0], &s[0])
+	s[1].Add(&s[1], &prod)
+	prod.Mul(&wHat[1], &s[2])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[1], &s[0])
+	s[2].Add(&s[2], &prod)
+	prod.Mul(&wHat[2], &s[3])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[2], &s[0])
+	s[3].Add(&s[3], &prod)
+	prod.Mul(&wHat[3], &s[4])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[3], &s[0])
+	s[4].Add(&s[4], &prod)
+	prod.Mul(&wHat[4], &s[5])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[4], &s[0])
+	s[5].Add(&s[5], &prod)
+	prod.Mul(&wHat[5], &s[6])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[5], &s[0])
+	s[6].Add(&s[6], &prod)
+	s[0] = n0
+}
+
+var permute7Constants = struct {
+	arcFirst   [5][8]fr.Element // rows 0..4: first full rounds and first partial round
+	arcPartial [30]fr.Element   // state[0] constant of partial rounds 2..31
+	arcLast    [4][8]fr.Element // last full rounds
+	mds, mi    [8][8]fr.Element
+	v, wHat    [31][7]fr.Element // sparse matrices of partial rounds 1..31
+	m00        fr.Element
+}{
+	arcFirst: [5][8]fr.Element{
+		[8]fr.Element(unrolledElements("8272449376473671765296008869302038521165312284059868547350729170495609308478", "4148638154552987643427927719929617623033282419205047107576105870710636474516", "4610941420378965310285838612448425703694278187368438665958951036771382090951", "4189826392798972095615428009133315511437859427098777387981389252767021216956", "3485778653302006026931053379631767402100167194043813051334318884790009606746", "6997258294599821948176438428013771474496501637817875041649844838098869986336", "4727315047627304951694923894487815521672122955066864851590793419420877448838", "8323215308029035830922479600341609342422153730357233252273677915504737315769")),
+		[8]fr.Element(unrolledElements("5637158139100131712830467651283357933314259485688016797833552952753722230574", "108652494334494423537470670066593093589367691856837736916781504579141415881", "4282654110357871098442082930601101276537207779575238008006989562579070025596", "4983986459778055581371939303652208870578018890190948885506698845403601706443", "7812942429443051975589393178689314192901424482402091373617863947800041031380", "41843404634003821257499508246384846359567653827738009587074364821357556674", "3256367381652958574022221764563986866005281747176483523562910892352385700536", "5081754897888927450572755485400127827962724388364782188548629761403223168208")),
+		[8]fr.Element(unrolledElements("3140662389046800714717134038842932929914881789234256624133712658493702862986", "5837678370345291060707921053934078274570658514314699024317340256646194510315", "1758249205614064761923397241362751226590619464566451174621990840706075711533", "2996424966666775948197322316539263332388876501081218038331262962889143767323", "5569073755001022846727381324947502843907951568584887846136479111303763947571", "2152697470307011874759937686919219515105381348752365467161409416739810705705", "2547478791649003851472374459274423878735641266844974068366653679604458605992", "5819954505684844235667713476731504359909606655725958006022735818802065644880")),
+		[8]fr.Element(unrolledElements("2946723954639143698985169687050778926763705251783882355884017269111316721156", "6674853737187547917453936531282787623469969017096699291523892007866852923446", "5128641242163000635309430370963825992485844601443450009390057810268350954415", "7352715836632872274247822067257986059394276266477211651891582858684335942428", "6217878791689924076142875699523164926319528131418439289154670832402836449354", "7597096949831323643078864071580889261097889487612663379874935302362723251908", "237095701117524079797711198067928714601748963673162707716983647008814265398", "81542366906477747415301649039728726195369083982626300684546056109880517297")),
+		[8]fr.Element(unrolledElements("7969630902758093833683878012450239872858160201838806304585343230952248744893", "3789327608923240559621438807555696123833071955799944159387139196635270052063", "6942616259250924824073986423294800348651033465355434234434993171049712250516", "2647437603096292336353677765155312490789174022898243243816650308367065378101", "858333965015035637643335195902146224444598500681518760427029286506032744943", "1085987275871688332924812187386406747246042360380060273363537934161803248437", "131244237985541375592729188507346605049439505813779950494766218772899758145", "8162284026136349222474753321840031971765915654813381581275117004695374042650")),
+	},
+	arcPartial: [30]fr.Element(unrolledElements("8377966484821285635062133307724528670490764417368291567050759399120800713798", "6683135554507103312548415634835771695346853367393830432480354483776963346061", "2364264937015271597195130959991703143036264788670414162349951210762322968487", "7177210463401468215533723224323420436159896385743502049044063066021320863299", "5321755656196686918677181304437356383675997707902461504646790696979458444105", "5273909547463914314311121506305889722562287170180792835051985610930490751736", "3524920266646250812189331201989872055640559899716802944944958871821450859410", "3301983957403153259338489549576582345951825570486799616806922754330762472977", "6673499656849907531628536663885825753664675720291382647320697476251378700120", "1425551101551462259535092602551860254166749477354386121616078836669644882196", "8053099556957609980711279208897255220880821260943259374965408763774661782217", "2309393037289334753926171018572958469284953624866032575895746422511308172679", "6739323531923276813794688774733915761174890747235564536150406736732855010836", "2113476282918034868081062154313983617562296599978058098083698921160825340508", "291144037825967459368489782451793991392643527556288908882015551935442610051", "7881680865401501348808149620040019562419411500658158289003943905314003623546", "398653848611615203461446873634902510920329653587500486676482630864147990938", "7542595010985278252426930418732019254441233633369170565525116826658577895896", "624646061076484871998998136899085459755354844295806804251352949555781761900", "2878007940045280915232178001764062802242036376084965026739527182456170745800", "957138565110708992656758056404288915794461431846827426815707069867574474195", "3573878058072025981267513622008560027419877239740926244852309429167924033178", "2753753270744095065090261815840182647789875208390784947622997744192408651519", "2674947701019192619637312463382626859837200638524022459273079560454426330098", "4616845004491896593243577117812476572782262747038425056775977006441329607530", "472769197802757141666730694540641940396667319756835802922497001228129628101", "4649292990069818513168066888219552448438414049200294093658290508988720134989", "8376917518523341006108089001316126110827184327011733608448297453486530426437", "3811152039323168938101246639657115818769282522604240518662116231683280999657", "7758492066947493347583714495077260042960647116506643056327150605211157896111")),
+	arcLast: [4][8]fr.Element{
+		[8]fr.Element(unrolledElements("3625346994346262101074016662525684274846352014684613212636681749029860414438", "8185154950738214151796515968928351126603905297125790874608920063796767252690", "2091359350151991805321734262591896664272488333838196693958572789838473427626", "7893870176223799684038550109973360297326111269737078480578958224089785457116", "1520182496293084184060350659186894159187633793202146327512504615309868073244", "986343632237534315729765358105004123719914801088741895575181866570497964221", "4097141299559832108681797482282499886552681964557682291595867549941946001900", "5170162657752733961617316579862704251015678496124961523018864855203991590574")),
+		[8]fr.Element(unrolledElements("7493448755702210572189375484947729156648010297427762392736281250135251611888", "6306473423076606291527527393606984727052936248884714392394987865264933460998", "6490159471621292220891426718270011645908869111376473944426770950932725435316", "3085590823906807640982299920152736395554307120994537085809023375753886005214", "6470210152105334455344254379422681911310598808426785938835085698000538958774", "6384336895500659645759952084976852819294861590593737217260679303514486658147", "5403908794555626454891599520460723503768107553937985694373405266900803378169", "7491312003383848086366979202793200051175563793929611119984170139909207668912")),
+		[8]fr.Element(unrolledElements("4387144195323867178748400107405487976710661311964448504266174388350850987894", "2359754701244196043720426483051454843104685842583792338379012975172349507848", "6267415440471529358165929206436078810779767091328742668461372203706996320269", "244265221148857592230117779251126109093672259705074593425726854244432431537", "3339783573831930710228181302359655715657861147157281612383276198708893187560", "4616762454119578616027399475977737765223162792543279667476807359876684539357", "2701067941287335451337695832217251573222436646292125046249999856859643981490", "5393196336119855744824571655674412475633706505827284947563193413492438488691")),
+		[8]fr.Element(unrolledElements("6796573436449302551523083955724739045751503643681750413111371067794789878597", "1717871245275641451442602910236392322516037306074008675881250178168072663141", "2525541969317989804751812899254922410702247459776828808500461785313548593449", "4393406080224759831407943487747233497883289715389419134393180407785819428816", "759271099303622493097265875295304471216619404471506323635435779228707599323", "6657858624373344153040139320987988391335408962732827923838403731161871088770", "6939038849898671885316533183739266254229700214001815157826574893807635000524", "2956062250044031925588211089300332661152138256628933421957907397358544574740")),
+	},
+	mds: [8][8]fr.Element{
+		[8]fr.Element(unrolledElements("7388904030749824121217721821433853214953911918259805849443329273927733084161", "4691367638571316902360458299323081406319944075085591015519574142176338466134", "7600015574485533381823942444903391878238309401638657445141710110325668315137", "2303035022571373752067861346940421781284336182314744680345972760704747974284", "7740756603642672888894756193883084320427907723891225175607297334590958469121", "7794887768703111160845069174259889105885445540142212764247907805462223912961", "7841285910183486822516766014582864636277620811214487840225573923351880007681", "7881497632799812395965569942862776762617506046143792906072884558856248623105")),
+		[8]fr.Element(unrolledElements("4691367638571316902360458299323081406319944075085591015519574142176338466134", "7600015574485533381823942444903391878238309401638657445141710110325668315137", "2303035022571373752067861346940421781284336182314744680345972760704747974284", "7740756603642672888894756193883084320427907723891225175607297334590958469121", "7794887768703111160845069174259889105885445540142212764247907805462223912961", "7841285910183486822516766014582864636277620811214487840225573923351880007681", "7881497632799812395965569942862776762617506046143792906072884558856248623105", "7916682890089097272733273380107699873164905626706934838689281364922571161601")),
+		[8]fr.Element(unrolledElements("7600015574485533381823942444903391878238309401638657445141710110325668315137", "2303035022571373752067861346940421781284336182314744680345972760704747974284", "7740756603642672888894756193883084320427907723891225175607297334590958469121", "7794887768703111160845069174259889105885445540142212764247907805462223912961", "7841285910183486822516766014582864636277620811214487840225573923351880007681", "7881497632799812395965569942862776762617506046143792906072884558856248623105", "7916682890089097272733273380107699873164905626706934838689281364922571161601", "5464063484924239686278651430976294814419699569805570712193386353828911860556")),
+		[8]fr.Element(unrolledElements("2303035022571373752067861346940421781284336182314744680345972760704747974284", "7740756603642672888894756193883084320427907723891225175607297334590958469121", "7794887768703111160845069174259889105885445540142212764247907805462223912961", "7841285910183486822516766014582864636277620811214487840225573923351880007681", "7881497632799812395965569942862776762617506046143792906072884558856248623105", "7916682890089097272733273380107699873164905626706934838689281364922571161601", "5464063484924239686278651430976294814419699569805570712193386353828911860556", "2345683819285658451180229149661540703159972037542795507759787071088169233067")),
+		[8]fr.Element(unrolledElements("7740756603642672888894756193883084320427907723891225175607297334590958469121", "7794887768703111160845069174259889105885445540142212764247907805462223912961", "7841285910183486822516766014582864636277620811214487840225573923351880007681", "7881497632799812395965569942862776762617506046143792906072884558856248623105", "7916682890089097272733273380107699873164905626706934838689281364922571161601", "5464063484924239686278651430976294814419699569805570712193386353828911860556", "2345683819285658451180229149661540703159972037542795507759787071088169233067", "5777789618029937658696564431797900258309825860894885777008317627732964216186")),
+		[8]fr.Element(unrolledElements("7794887768703111160845069174259889105885445540142212764247907805462223912961", "7841285910183486822516766014582864636277620811214487840225573923351880007681", "7881497632799812395965569942862776762617506046143792906072884558856248623105", "7916682890089097272733273380107699873164905626706934838689281364922571161601", "5464063484924239686278651430976294814419699569805570712193386353828911860556", "2345683819285658451180229149661540703159972037542795507759787071088169233067", "5777789618029937658696564431797900258309825860894885777008317627732964216186", "8022238661956951903036383691842469204807104368396360636538471783121538777089")),
+		[8]fr.Element(unrolledElements("7841285910183486822516766014582864636277620811214487840225573923351880007681", "7881497632799812395965569942862776762617506046143792906072884558856248623105", "7916682890089097272733273380107699873164905626706934838689281364922571161601", "5464063484924239686278651430976294814419699569805570712193386353828911860556", "2345683819285658451180229149661540703159972037542795507759787071088169233067", "5777789618029937658696564431797900258309825860894885777008317627732964216186", "8022238661956951903036383691842469204807104368396360636538471783121538777089", "8042344523265114689760785655982425267977046985861013169462127100873723084801")),
+		[8]fr.Element(unrolledElements("7881497632799812395965569942862776762617506046143792906072884558856248623105", "7916682890089097272733273380107699873164905626706934838689281364922571161601", "5464063484924239686278651430976294814419699569805570712193386353828911860556", "2345683819285658451180229149661540703159972037542795507759787071088169233067", "5777789618029937658696564431797900258309825860894885777008317627732964216186", "8022238661956951903036383691842469204807104368396360636538471783121538777089", "8042344523265114689760785655982425267977046985861013169462127100873723084801", "1151517511285686876033930673470210890642168091157372340172986380352373987142")),
+	},
+	mi: [8][8]fr.Element{
+		[8]fr.Element(unrolledElements("1", "0", "0", "0", "0", "0", "0", "0")),
+		[8]fr.Element(unrolledElements("0", "5987802675949301722734385919344137409857194935044766256074095640396681039620", "3553705083859528362617438366698217359460023642562222809102497295709732843049", "1471498806406329435653147925889651042291246022460863395407601073234501312545", "7327333833707077501239003319093682957785371992237509876238455576393180821184", "4528896639439799324617953490368919146378250448146155586883292735308991953644", "237790219739220190185128338681864436314293209860904232407596054589852123998", "7980037802415770957241592981036111647175626945384370454240323936626768569375")),
+		[8]fr.Element(unrolledElements("0", "3553705083859528362617438366698217359460023642562222809102497295709732843049", "3508443347698883923356335547785729687612222249185397723735886584774205036076", "4560714272573214180482754936523249208839114909110599725316757684969169339990", "6144159359716410116981577774822403752412981418640990629076790115919294821466", "951590151720823176384478317185369533014426946486914990566558990833034190696", "5247386132682151562738764958592545833305864671135408282506693808101502023013", "8337767701504474018913570462304750872904718586427535123402022807024886922784")),
+		[8]fr.Element(unrolledElements("0", "1471498806406329435653147925889651042291246022460863395407601073234501312545", "4560714272573214180482754936523249208839114909110599725316757684969169339990", "1786015959091056798080035641825072671264475024520963143882954708758594992367", "1950891177939887640697138530443964835164958008167870202318068975784795408880", "6717212549584634724964634331096801776928043887780579188448771387758173398978", "635198105378237387307776049704032658349755686396875644857765922796927875915", "3095148013001311046817328885645685570642748290737833907229522220911216382997")),
+		[8]fr.Element(unrolledElements("0", "7327333833707077501239003319093682957785371992237509876238455576393180821184", "6144159359716410116981577774822403752412981418640990629076790115919294821466", "1950891177939887640697138530443964835164958008167870202318068975784795408880", "8387532266654620581348630508238588355481223248899836244135652430363895602355", "2650694787661483373619145108833496474237329209702318227944058573141282685946", "5533919037673724620511302335117804120276201450591187300716841214469989374792", "5246922544191653694134152075354469250176891713518109233999908508463235368025")),
+		[8]fr.Element(unrolledElements("0", "4528896639439799324617953490368919146378250448146155586883292735308991953644", "951590151720823176384478317185369533014426946486914990566558990833034190696", "6717212549584634724964634331096801776928043887780579188448771387758173398978", "2650694787661483373619145108833496474237329209702318227944058573141282685946", "4683860795254151995118777804192174160709422264912795099871743771790179518211", "7485050546895969666725737679261803808393726473422573724872927284363282181066", "7075563150891563543018066683010289076432378200940445857257754620150534944364")),
+		[8]fr.Element(unrolledElements("0", "237790219739220190185128338681864436314293209860904232407596054589852123998", "5247386132682151562738764958592545833305864671135408282506693808101502023013", "635198105378237387307776049704032658349755686396875644857765922796927875915", "5533919037673724620511302335117804120276201450591187300716841214469989374792", "7485050546895969666725737679261803808393726473422573724872927284363282181066", "3417748148482912299230167032939376641166673547855060675897122853291289371726", "4963343343321585433868151343546992102250304092632696150330940061467712531806")),
+		[8]fr.Element(unrolledElements("0", "7980037802415770957241592981036111647175626945384370454240323936626768569375", "8337767701504474018913570462304750872904718586427535123402022807024886922784", "3095148013001311046817328885645685570642748290737833907229522220911216382997", "5246922544191653694134152075354469250176891713518109233999908508463235368025", "7075563150891563543018066683010289076432378200940445857257754620150534944364", "4963343343321585433868151343546992102250304092632696150330940061467712531806", "8159993471843855332094099775842401052452467944366702145198144441356061228407")),
+	},
+	v: [31][7]fr.Element{
+		[7]fr.Element(unrolledElements("591974908425765936009237241734941323627256550053973883199004838658865252279", "2203332906052779152418481494350866767263282811690502934939569671652009638025", "1879441701543400346585910122900901984903428399131693026457756745004883591950", "8413835281132999135870465797954119754718546116888646532517168433884922949283", "2532623531753300235535073800876012110115713687387112403872832110951915450813", "5463927936086078298396594311349737473363583404692473092511607811895072393682", "995802384113279216268392612242705530636879747323240252027594260573840283293")),
+		[7]fr.Element(unrolledElements("5233654585352624813407638537397807226928652120346714028342769151727893699327", "185603810584831040910145966955984825695608102565502526623716188221215566339", "3162150505327454394653368945400057372042633964254024335551309199365577734169", "7302823677701409286756593017904335141152970388909073078892389778015293471084", "5792828468757570165733388860635984484717441089790307514809200926639244357501", "337196961175007424160849138323492834759981681249389623278091568473208726145", "6532476656365069077985695592066985591611407773678090844920845869661790728635")),
+		[7]fr.Element(unrolledElements("7329082863975206882581081919461170442814649825411789773837117434173648601584", "5180348996880381086795081621809250959135148058360471923036454708038250136600", "4624622061638310709236430231388578596380377046606832730205643038500787334557", "5304546910495849338546351160540497732865593813864779803460859431654853358340", "3571359489158352685288273516666561349519419367196182175433016369605513511955", "5709312927520532191687554001871301105905529279522595903967130804723854957820", "7366486316821458107983173143814434159831765190702826324948222927269770816717")),
+		[7]fr.Element(unrolledElements("1545625684590523475787632627291017308379651563860294214155832906269302310704", "1202311766334514351306399525003248301450683432994462553910755004165884142433", "2947200870033348813479419826439728846859029549028835010967423259467912433334", "2508591113733320408922890896679591128908716439375370130348925879771969289506", "1164803469926668468468377640760689999616473384457624926818957202174658653063", "2932649542154101984475205582120208530940935610581291905335736294371023919798", "2825614731816557815225412156228666173831383093588979868493851366840372506315")),
+		[7]fr.Element(unrolledElements("621782867159875264358700569196861886225263328356198879034425424109187183714", "3512738818249127629435053711663315906049595101189715112136086738800582609601", "3192249988371773259853623429379386166722766832020189919449930172723474476574", "3089339745538850097130804756733874704612142248507766167096123277397531297047", "3083625222105880065195444481164146971731794592933533958669703693809500778892", "3273363364850963237981488703768333764275671812843766981483361416179996953511", "7748033361405347289752809537097699489635473435337400646842573868428162224866")),
+		[7]fr.Element(unrolledElements("1389446611567558294147758526712362771127004014674874584067665147933372850118", "6397034856725794996766551865270005250377704486901984596519747198554061148465", "205091484996310478205703607716484078123053032065875030687222830709169725972", "6760700655223747282503709932399015496786065040576644044409208187280186899640", "6208839752299751281929089777333555574637643038073339905446787043144565458232", "7799020404886684366046619883196793726934585574318686715186038482331817391940", "2096892926148424031780972095669470073193351141524607985243983251877644113229")),
+		[7]fr.Element(unrolledElements("3865112971120624110022577332710387002236164556958861040953573633602069504967", "6799696086859597228356355342638832485805468253947231149032404678115715746734", "7933355749454024838361918663497810781569445010357336237387755837582242577436", "7549760862785332633177298822090871736934164243141323492825789299131659718460", "5207516807486724388620727435950185632988341488489834724044899978032154858408", "6924559754387258858153869967765663638868546501283524478566330743582082205732", "841345160146100964645129849608848330313946253894268274850157330232572830629")),
+		[7]fr.Element(unrolledElements("7368817873751876582496989433342624006233500116260591421769273016378544156304", "6571617532986588963636284663084898135265943506679752032808038593840690550041", "5342984625831497563205296500949597710867570731333920373077104072376312217109", "2686676745851327222300965995817661504584394905268737191520278982163850818493", "1763152377567147521803938002046151991534295992834343384819829435110945794799", "4893330949619348361167642669972637735325671619271883656430481517113966806946", "414344109355501870156709841330000678144374572028269579598657927336862145505")),
+		[7]fr.Element(unrolledElements("7023261662395900523393369237387433571581176531290068105857812129213894430660", "3139221192647842444391960382090696051605219119850609505342162707013483456143", "934601359865684394521084046280192088038457606280193920222996751431527940648", "3530715520073224219879340990263113036296244875680177143570902501651392528002", "3544555912556702704621300521038529610722334740547723147079556692628145155871", "4100540746209267768748482676428079373467886882759412040612266343752742681678", "6728732194524499650606291010620190118646942370570503244300760817501662832276")),
+		[7]fr.Element(unrolledElements("4688894183395564384735085304769791579904390152119790455597579213305686209138", "1720984636446161686724852085221993383599577456401369793638420309409664465509", "7512618564374751996148862034970031319672019085411400229862351883599296147621", "6438438929497042201268729870346789904686350582968390036349034966681318053266", "954391960697389704486808439769294829496354946916643936579856657620573939853", "919690982656537728246307165544427390829435490213889749014397264916486824772", "552019023398190790458068343809201163100153476773434103925036577698292446607")),
+		[7]fr.Element(unrolledElements("5058358197187775448457187896642003602140930050042561514437451864437419663789", "8169315541354842035448700926046485995357477895212518967589633380337342330868", "4208866343764157044149396781711324601057077504495906334792068243219686230392", "4492855826534130272657431599914280592446889868219419378568368186789126664310", "8354201251122896161461677259713964234072404985185364660849123593411981126696", "3441632979435452241638199024701271594593258333968734196559390055346359710994", "7205797181716337159713173844648591196641795585853171012368744221891176842494")),
+		[7]fr.Element(unrolledElements("3627065917965011067928814538573355934296255750858331870507121772570339368954", "5894514926968401584306172853036531498444743856778512594048306622363295971370", "6953962099089743276810433784966387364821314174819222022830612122697916386003", "1369363699880882253972635974711633257431434510236154514579968798052244619363", "1345000968885262957906117677255165119462115545467261937625319008684764226272", "2574908814979798335811710530680921856151750921313368821150534513908804942904", "2274122711934267684748591252330176584191821122950998488559364329776334110412")),
+		[7]fr.Element(unrolledElements("7177006381743154255082939477979950691406063383680689661265803200866395370786", "2217914183345151528285084611709360347039873199328443402397971785242531857036", "56117075176406383403379240299626338903643468387347752957507159953115989224", "6700013260335466131512998478978138964671359794982252989435652507186376094550", "3538103420893712641300679553747307593125100565205702231674611866046469798960", "3535761337917069397853979605527391881530205022862882522721056714517399516201", "4792932734184078531929839957316952869907553251015932106108001525422724689996")),
+		[7]fr.Element(unrolledElements("3574664812163336457293878436783012641794978309822833775924762357291748278557", "2502722352743876750952322924868196768348150146414241605763056199057187860110", "3448300331792167149277248613327659175711861373649823893074345603645806844868", "8169708347265401538506618095762903937495242711089275307640012779642671083045", "2188454359516425210667651089970577635410627667522295286860289340428351181434", "7705026160374792680627522380210282027407377856374049229750363731541432103743", "427693743177362918507683318288154679859115633935674271625560083860760601624")),
+		[7]fr.Element(unrolledElements("1978273272299834292591126783774413913593812309894639240806482874369860378822", "8316127508943490476713947811908356514809995669643367363097055778835361418237", "504479419995849636058622125345465287440727856190299396307763746692714961057", "7900908390841890265211288235756266286145740680496789614172974839654237467253", "5813384146097420947212046394535737585759957512993864723250970463300421178064", "1032637872845236297346329846281096302654244366628952580043191441147397660125", "343379830722556770456326323352139963875167070080580201515690987065251486912")),
+		[7]fr.Element(unrolledElements("7904420687476998167792245818781400836131164255195937753284185830321868382235", "1555153458340522383173824435718545771177056983547210273013219072135561351670", "830383350906804189259112282234353353608119182204451912387705203989961757087", "3456456284559078554508146465285483532222154488970420706158742089993140906034", "3671238262333634058973205736366985715425186279267598310447904053249922853719", "7405764832808601103711929445934321604116979188067454894812221813322922475490", "7593469988847892207884232876611533002833419361277957140461596326108643694698")),
+		[7]fr.Element(unrolledElements("4137824898590194285666074961589565128994125672725963044981003510292835072481", "8123524316822506389839988823183224921909493290119323233568872009084270935759", "3678638619593349297103604479772218342641348071724409374902846425087192531865", "4480175025246479133746279206422857025373263631098300777426299345601747002709", "1665333334942408675117838283810122509139451255623682654356281056741745847605", "6825032770884547870364827095941136814656245118800013383740443201823168355372", "93302708605752771400032577809696538155815798380647736995832995073251554726")),
+		[7]fr.Element(unrolledElements("3359417340608624162898658408956286189294127743380243454207081499618581612673", "7028496655273398671172395158474667041916231378840461235980340040146883807139", "1612024885140962802803680529119798987213924573486801427376405219829257052650", "4432622732158192977140269005261356459651503573460247812157348947803331784585", "7347726614280935207472751412467736967521608220419124825798685134005164056256", "2275564718237426779918817558328920283123722669878267073152295742787702504365", "2042118618486735390004430938217940358056505093397859879936401601617026670535")),
+		[7]fr.Element(unrolledElements("2174539996425559666826410891902127156721562825661764032133784573788648557786", "769248806812050516283801371439836604797755950190689340673851701602214018327", "2675108534474186087748721950283638103718426217979823986744458730333158817982", "484239727951207591417552519171991452663618328098922010975903490745815649731", "771595101981472953058810896565135008762469352363117779833598904370472185034", "1044782380823930343902684601198514834772684376963122371608530897699395332966", "2292437741777702734055940231229102241274442374524847813496630313228835284426")),
+		[7]fr.Element(unrolledElements("6822401393300855116879285139877907407888065600055085280099706605824930504490", "8236741597069294960383919545799191089447620562415116551622211968529054290005", "2948988990576160075655618796344161346248671903482386925364069446031509662159", "4927987915431003083735924942091663872689442004725074752099182543538781559525", "5067820357846738599078560193790331534070413522585988304352990283690165834052", "3740404546645824275910830029071616233755675437919341362169280304043321212195", "2111200376139609315307658664556561824110594055775683837622575644075659717842")),
+		[7]fr.Element(unrolledElements("5502532178759265731922186416182516703534981692927666071294802462928470062591", "35560602429301782167644924730904022195101978722665956370542867412801820020", "6288120325409583536815287773718836993665637550784543329270101530579045167924", "2626406123776297952320347407918527613620788484211418654778179254193178718441", "562820747307969563357403167937906730848485709882473765169093216403459074069", "1956005757288702414229669271786024402820651646481176857713366771349584073769", "858227876316805370593658907888007228450187522054302698566191984336756435088")),
+		[7]fr.Element(unrolledElements("5324236208655075021241096182211904237241693203573283720021860333722404902980", "4094213580406721438004663397518300270502593826351509547417470918983127495970", "6749186843058419358266132619994906322856735047708966524828454143835374429502", "723722231207513615028524829855221273358994271482865755442408338017731744886", "7150850632600139328249708382337907214476473094637731404400622130609232473383", "6548828407143582984841181386186939307913973375652499436454528279387602376804", "168810805010511092998729383838161121133729456921756120521404728421135571883")),
+		[7]fr.Element(unrolledElements("7157496258599630562443972470145215044976756443455976232702688889408096009174", "7520287540794388925603391285905662999662867195701633633495232082926266548052", "5865678293200537496037868265176039708372417614631306379269068101604940433194", "48004315796779157691424675110866081084916533064693197340621977788423547542", "678794553559570614270566850828831439399778738331790728297982871331582695195", "3665848980303301998938665417345118587489227783966860769406538529633068046058", "1147022452428813261950595937478921464670665000738494818578826520030558355324")),
+		[7]fr.Element(unrolledElements("4125103463970419603397915653877922802488806283516324893288974204174483056788", "5591634307151490754844500409194491577227754096314058218954740936405232230035", "3041461786294355221095900899936074454982148181448250132594371583680037319675", "1443520442884336543332900885259183427403063748640361379559162193352537692636", "3069759010130040043167675039546268816663342559067709434766787980246793735035", "7961014071743534019014632448301344184990745512052695141346453071736340876493", "18215202940431684213494834772814725774891716048434570833729449898243135326")),
+		[7]fr.Element(unrolledElements("7642892199182292173023175522572484012093196254898777241608588771772720163462", "3433840508679392671375376209567845274889554655260280739185505981916839072460", "1592423932310440230146378604626640092213187726585085264923403126541689131414", "1067672748385758625392479661696295307429418798809950746556323793442631948787", "1356404616469048446953514899679085784101960908561894133021765961085416743216", "4393349844635422641754579540656962737075487462085058301475752751294988314485", "6759796157382764528040137610573218386127935026179881706033917731793102622352")),
+		[7]fr.Element(unrolledElements("4730502537277752940588121584558756294403865542173129225143110856167856031643", "6893955669674486886581759798105165730772561974844595049036458705642028644437", "7107040296125890793195024914039920264826542368573246995877913824761506257896", "7789699459005903584349152278301427638773525354818791542919178568458284630759", "4546110736822651777310046028398708729766201966608524881508681707518874426125", "2060511813305880084114582417089748441359691618769269705763905261737275558681", "2791397867587663277090301095251556721321704086298635963805460370120822994894")),
+		[7]fr.Element(unrolledElements("3574936129284657137947148210970002706352158491363595460951898503884178385217", "2645177403441472977319190318136965589313267860656229331796029563726252613752", "2290263721944597416384490908094950593869119707645489202242107971524589380613", "5791632002335649526864557012489252809115071401905890338234871396931239040306", "794639593196062266821628409361492770613558676051338103517009785469926246152", "610352968679606882198703556973663980339738544578371485313426020880831163161", "4910144646748681105322539635150289921989139879696515742553249594832949530333")),
+		[7]fr.Element(unrolledElements("579073959925806491953540826025781735378520241546644982357934608498807236184", "2907226757671246798681581930419855667608471662674916637084392882988970852583", "8156406236938990361476914247603978213558239661537529232857139585183723547841", "5243026264413841311155283516691647864915859747889312836817177160806140861274", "6575655272740609656600320613123041637168155202248295957098678098769321491790", "4825320608297442975315222076964475406171816389454849516794472330236729339594", "974743027463604342780669477305383217358432018569005521612149956927566172268")),
+		[7]fr.Element(unrolledElements("7415693080699932064364849636074970636625742841193427172306106792355821729407", "5391905966602543289406683148770611740036208408712987814963626389129094231390", "7063566820767301122758024960012172736112562763789704908071087116185515244386", "4073359495093618330560810719601847054572773308210789257623555721217937299615", "4810356859393596811894340406879850492222203487617904700559785794130590739481", "7891574885194451625400293769476857514010232262187438024394094254239472968266", "3308634882010814802384259353585548921336022975300912838553343585716795852178")),
+		[7]fr.Element(unrolledElements("5219146497910590053876009857996928064530937783532720004765526233171176543574", "7899780168450127086835062905352785829862723475259935282824541722792671118598", "8067124429871446632935527648921999756408570143481961188723500863706443405818", "4429969631449676176188987442517409199991973239572039784208562210514978807837", "3498505526508972792512927050497411340209246826006802252908736972880120073444", "5696732086386662823969710914775098188683101104282602265196266433118929294697", "4637987270057961719928725688465944067528292177932567189877466188757148737872")),
+		[7]fr.Element(unrolledElements("4691367638571316902360458299323081406319944075085591015519574142176338466134", "7600015574485533381823942444903391878238309401638657445141710110325668315137", "2303035022571373752067861346940421781284336182314744680345972760704747974284", "7740756603642672888894756193883084320427907723891225175607297334590958469121", "7794887768703111160845069174259889105885445540142212764247907805462223912961", "7841285910183486822516766014582864636277620811214487840225573923351880007681", "7881497632799812395965569942862776762617506046143792906072884558856248623105")),
+	},
+	wHat: [31][7]fr.Element{
+		[7]fr.Element(unrolledElements("4785698396222281748435513577476884856184575759142443962329689696420127159663", "2063995161031395306645093700799473170679690097893142800516315229929439294551", "205654954383710933270456828880992684613259878453300057831437368876707573248", "7399910865345063292931400288535539953875220063541964509270851100757831806297", "2595678523883848790422648423438509719566910824151068669142015641736384514534", "7207370490454638207106812603067572089981569457164846175794006813719684799678", "6932010022199487662466309052117135454573992130730154415887157502747011442251")),
+		[7]fr.Element(unrolledElements("4828918993861831819965590131669175698286098122597383097509451147209578460795", "2398999624151656091311166758775063629882842912863357159645171755504082492332", "1857507650251243830302196135774786963822939109169258292691951914546285338594", "4869001947823583054618611464516298078230454774997288316889205611554943257556", "6879147386315389121984906754150953309217404731469506210864659010696503841164", "8232593534617146192159176270665904261385649930979502834219059864096961798932", "1382505528006092444278356277274570839872020250731101098345024763734206494637")),
+		[7]fr.Element(unrolledElements("3562330150389516353503071786623663120010722153569280423040902937353054570806", "2886333704961071712871884327463180140859867702302335094155198888968897500634", "7814881876898698126542491363119678800703671912971596677216786884230727364370", "5977402345687747326675770766035691314577396515250829650189027830840251953938", "1907332004071032020391007511261490294277591182367964017779706045054753304049", "7597941001877662280813412206939632407616609340329339016577843815269850505887", "3172571512946701860238805740931759804386612798898170576670888030370850064868")),
+		[7]fr.Element(unrolledElements("1077684492371111675934642649265884162676191599378783970366318737176363027192", "6602875819143041892796615556605356908841160959329536598320512527551679789184", "4962276641984552359079117089593963363296330043665029073179719871492465330621", "4572594765283134638692619207550610826706659883081830876150676118444907651504", "3576830796935768793629943514300990893488107714114106422530462297615120001803", "4288217861539662722265938936026331499074523352723546606789797244790174019846", "2753309121719585215636067389906767798495485248030815979016906082631596282300")),
+		[7]fr.Element(unrolledElements("4571887597600061581636032265491360720832674055634258856751594627684293652663", "3615517452247810838782256761448803817153217363669237369222855920322688432339", "4116906712510784840149164032627379024964522136734431654030117362519110272406", "7607250616054587482485157059974464806353753251474928972794845294247904194089", "7645940000598066524988684211467539145690814830286920284055490119050574537680", "2458144132282877388043045515095804621102154588266628053185217533284091997304", "701118472179862917499550629534733263230436874833193439017731526990562964530")),
+		[7]fr.Element(unrolledElements("2086717537168567595874528507628751697655869205210268390911719632623783724756", "6369128981308628045054712785020425567379558664203371354008928504176254805894", "902925663446395838458492495612352179135802043682117172546005917481995601052", "756249192600122628843112783551733107290231495487895821367221900241545813550", "3901946961785946014178360358513487464102920573692390346789637269723252566620", "2084372406648728595461083720076781426193443287451032762661871433214858962627", "203189105281363800138416804513711380834759656706287615359891697265414391592")),
+		[7]fr.Element(unrolledElements("2676767917380723618121069549278840020950830533956777842819084976061384007488", "7335850977875350919356878288231490448051704971333524322968310651526743176649", "5489275163586778597237393898027810529305453946733624560008563082558329672459", "7532825360330372649519441662245099012227235660983284423975518417807941768103", "6808696547028943210340303387500091993307571038348277524438851144542738932361", "652525946773627791668052817505802500179905662466782623712932464954635097840", "3028776668570346144076321985668953848899200404134186029842769031628265500993")),
+		[7]fr.Element(unrolledElements("4565256442346215774853772891913032281212779361339706061230721821018813326426", "932768097413820357711546811379894043449519611138770755121142047881435819223", "4582011657923209493259608444664710734944370495394739050752111203971168684120", "7201187863209331527279944800204443410932577259849138036545489553565494961524", "7429940763809531036239027016563518056485799281135049616686357360048025381476", "5880092413258951489491829990144250509075714129271728738681740676628721314946", "6635019251055222825513504926526960006567567868336673980003910633071737142110")),
+		[7]fr.Element(unrolledElements("7511608318142726670087065305750007700921745161598055138428567817400952878451", "17642875791981381489885329598606849204411780884655599671693936555312813879", "6180573009727882520553822636659239786453734655656434720374546424442378600980", "690524128603773153648197393085258757067241114046690794737006302047404566693", "5774711182959316157585498117763265701656350858063342519715224625900143562341", "6717155113726666935118443690867736171109457779116852415386382728363872905066", "7408704831345764845214264234026801997427335973510616930254381297980968056629")),
+		[7]fr.Element(unrolledElements("1372005354715420535202493632127767974500947270944223538169627561226094071477", "76117184258313660192786771042509509673890216216208183502539326831168477775", "5665114254315591950564981477518610259459923596771612852566518876915038978034", "7644790547456375147805321271568769250108391406619334054083690222378526082081", "3715940602022552339242883357774975732777012501020641148216096654330326129756", "2575510198638140464667791371138243953360289031989395447868121477240993561243", "7771043163864483906117395806038464308962844971301098522283673146334075988243")),
+		[7]fr.Element(unrolledElements("6431536127143882335643669716124729377553236599827642573468968751633695321743", "7948748758408944439632399787810057973890333576166338269165287179094153710985", "3643297833260159806732278250516499491379871897989670247070532201598000364129", "3108770067264661975454522377905005677646891740631641166611468183109876429446", "3659393035979706890154341166026954313167709844636842673138470367277606392492", "4137549957447539929454795255423720438610733304644394040174947213394186139056", "6245068119879337536401896390443737993562290722882889276353649888327283077907")),
+		[7]fr.Element(unrolledElements("208474123992408329007049099311695013855253221307133385207401001480901476151", "3862036979998462428666425073670485953395791661453978943314661816332483084587", "1860054712307019107151568851592732436986008083846154412662931648931405134295", "7727501830330413312796055332659721679160822282912440621974577136455275367087", "6576637429949023546228071485356312229506003314221597818677555821593564395192", "3609487875239270810461077597722386101400168010223784364498721835383576610682", "213457203808643633815104509907945709172481625382071750024507572599570798670")),
+		[7]fr.Element(unrolledElements("8045370883343592906319034832204469328968814009139709780825677784970470346630", "3432891366645624853283914793139251546667602864231526550938382005461455614402", "6943404575808555799702230502575082290739387233611384394314646314207131998755", "2968252319173345876070553339689063753959519154738465866411690585919511517205", "8155590636117151624723446019047124652607083719080945681199928612911914849400", "6103919022579245252160198203656503024087928320941264640496017641292774845596", "3963579110802215362848165655448465029470422864130708419310804850975765343313")),
+		[7]fr.Element(unrolledElements("1280541705404676393712433620141752742891865073604214841408428084128640715310", "4365792738234205776051640669852060282605657818112750212449979792150698660021", "2169654389356134963974782157472640896560661520459721934413850449529131221539", "6953155509425645347661530699343912834303360066102449102583284522213697593811", "5030756583598839172173627735755230335972342522521050163199401412566668421661", "1174984193043137818193184645792991272490089670987619264898900569369186933996", "7813247648535153376411270823539386289731187256077276187123568807942819337500")),
+		[7]fr.Element(unrolledElements("8033300570689542909429729311799665636833898701625791361001886151598908149785", "198887028740205765140811291119303598104175742644528804736142415737460662615", "5773907049303743368959522427927434190003227864404283801392623065399949263008", "7377923111711972976446685745675799818076285218032504430885934723620618949737", "1990885066843671740853435967237164068311713610738317005284480417776696273973", "1408060542912412797659601618849635411029239485868208213522765188130143030479", "4272667495053194768941907841266337914537387719689882877192208470471802028072")),
+		[7]fr.Element(unrolledElements("195882422092013103373602239951432786240054223535314554365144234683903267917", "5744661548076389989901764233438033940893474838392222207756754346502023963395", "3391275279365955662042825734079749257572921952004778258232817473700256719782", "5390756633253238752580139933234531698863653277792923229054615468401800582856", "8077043215884919260035274879680443117912777494381243752506986540510599052133", "5772964683743304509316738455191126313286622255913733148500235222630050675915", "8410995765739590443396857464908600912424888535603351745109715613585818826754")),
+		[7]fr.Element(unrolledElements("7772812954741869836637180023992453867931165204625440986065959296530160073215", "4465944305445855244757940279729436266880665716788599493785440359721261721506", "7065075864730562665936405311809470574554459892841370994127476218904330562654", "2826258677922580420700412165088961267240983736469142652351925834777780680067", "7095033285770044778643379560526017253450847368462382990132036819941366863511", "2986127768027870011657973171881583071473857397012430712159793436707224411733", "6340549894141248021660397979807074529771874236367321828946816037204437911362")),
+		[7]fr.Element(unrolledElements("5851641428992944498225970191676011139927616628382479729715293600824089291924", "7106532003787095148705386568560879842741551996552928948111492320079116096015", "224501820112054270363380600227247039917499544972766439329884912842413414050", "1127162082711690829045222078599691586821587330400944834069240790795699899068", "5798822439126016187260713499025778075862089305814308478555434149304976901392", "4209737562173919317621194630314244408012960707336110779235272838398593739028", "2974234494097530292928176143106939971184117701227568930243967246458227551317")),
+		[7]fr.Element(unrolledElements("4720791980586431679090467685511873181209162992218402917963914757979790369710", "4678932308204327931969490167601179542933374435055101859351752284526243548707", "3469751346316711443071121746828347796311287490344298484531510947618630292138", "200349577196065912196957843459407175599426422635831565862013047540795832475", "7395709417877530407579880809346613907852482476327971582355683191980541696765", "554364021947203954692525123695668644862199005429734478709495476085331439258", "6838805576060167543497530740136757904988146292234405926954502031399115866974")),
+		[7]fr.Element(unrolledElements("2694546864817821170546433352762998120203753915754548284320719903681828218308", "130981943402589242051027096717266657104612439970581678367300489610199718719", "4776928240540258167706248126827500258919145325148334201458412666978683010782", "4045432725957393234017489535469875038992605381438392185042200708474417370908", "673714763111279057507323596434839870645185224265996472658627949025168210763", "7493422158560634062692208084649709757062990990163728086145358223339978489284", "8287878695162135331279729726965915160400130017415074650681865228016285102516")),
+		[7]fr.Element(unrolledElements("4910632304406185473004058610353957462752801700756329602035494194172187281494", "118391449438002460990304077187569321654231640788733944870191602291824494867", "1513102093412661056661025570319663374641451139852041759617866411948786033234", "6144666681799863152161805012227692428147095423027212442800675796813717186498", "2581771351480587226498764208976386873377051431992415012778297532442748316168", "3926916384010785158645453315811629925606996394996208190932284825957541429100", "5787049311028145409943698751945095705096348906393912278149232817210124395136")),
+		[7]fr.Element(unrolledElements("5179691420155578585406107595071523172436905318526091719240735214486928072175", "5372691709048573392973211037722420346553633341064829379048939858341364833644", "6412480196738767123860661896935274531340568713622224785750041485021764730231", "1876087993515156849197580045358369481490811946296405834995593030983210414024", "1592314610138122947598448528315408251910836000489053235812566371448847239752", "8062110400404906455161329394361574968761434187383684847371694238983093276486", "1841867413146832890668993267315702904994180092227700185928827858142063575433")),
+		[7]fr.Element(unrolledElements("7840238751651172111315369018969012586573478175686246090882431772771293058969", "7634051586755370991827493225306990673382943825574572126978367854745928059606", "2305450724082239007555748390907063191255141221405967112821727903767276325969", "1421278435935707711954065605572558083340539268830915540578626373104471532114", "4719813761205374918095853450469223782954902447418206915711414459794089682848", "6855769349651750698302136475949302764207280870728778064696355383564701893080", "4385057805214878540075232250422029703370108714796245851266129166540079479345")),
+		[7]fr.Element(unrolledElements("1729565466604918195154479677200796809978101943095013566904965953994961504272", "833944992161644786107658298864925414856158117984751724934838393992941514330", "941357990821498245833242093841393955005889889527688615335027719936849380273", "3253209925215144859995781254312415112132598506806876838621021573241936411228", "3200399487414284172624656113953681437295262223130511720215402697409152108104", "7575037554886651505716791436244547803360374756952605023249991880668693603906", "3624213020401957045328050862151116881185438564867367062590678688829450989023")),
+		[7]fr.Element(unrolledElements("6972172146678521646521066467591452770941415852427147751321957654196549786534", "2347145495980781705499203082181571214849452120480286352139140144970505477357", "1047568412247903836923187409208220232812136970086040508140224750056493420925", "4254698862624943857504879382001982149696583163008759658612088952586733163226", "6315724568176583725358769253035722528512823976618810746178178949160582808225", "1808433681143102398596263992246537263717393877526593947706580140042983806051", "6843905112036599447213157812378286454152663935373616742808805940508695305177")),
+		[7]fr.Element(unrolledElements("4691367638765922348505423816524139023476165361506542695484181104583152617245", "5629641164437155592816387068688323147753438089075869791870972562874505188694", "3070713370503762439009237301418464522683398272130995948634360329226078006924", "1279463887394362913071717151070872368042983174217235076478638512008472662885", "7972044324171824104064096186155445045013212005033835133164006886912409668745", "8444461740759459060495145807914439351449455218504439619235371916455879639041", "5629641168296342910514168466499301086206267018139985757291273012023859172694")),
+		[7]fr.Element(unrolledElements("7506188221714107043776755198137225704302301452691470069439610796715417563592", "5629641166285580282832341763431709994046159475425538184112360852222253028694", "5373748385999872088159140059265887620864401844143310311532857965386390688117", "511785560571416389346832904844148528158598915245801513580781857931654793154", "3897443884351555580424254613757089002584166097452827235892132265594076125342", "944834881054922564949941060147858147922611614540486226224274701050365732592", "2381771262659283965813997617132079911750436851670610825625736214110550870909")),
+		[7]fr.Element(unrolledElements("1876547055428526760944183319729232564996830558882921370085200635914161757298", "5629641166285580282832549959187697664133979269129411577729222157622700660054", "1535356681714249168045240897960281277282898449313996749172572207011231438662", "5117855605714163893484136326534270447032358682750967335661760440047530741140", "3602182984021892278875372875983736825998991950905668480067448289259195809907", "5491852746131737408777207827319467214623371274702956479872978501098272951891", "3031345243384543229217526901101068011131563559922350480013285743181620779586")),
+		[7]fr.Element(unrolledElements("4691367638571316902360458299323081406319944075085869094283047544128965097245", "5629641166285580282832549959187697687583932890100067942747205993134377700694", "3838391704285622920113102244900702968807226970534684532047125900347329284655", "5885533946571288477506756775514411218837748021450960338988386640059762345271", "1889669762109845129901834951335730692335865585510842301499652689757663100384", "767678340857124584022620448980140593761445394092527664623665177688863847331", "5629641166285580282832549959187697687583932890105582445746383624538643981654")),
+		[7]fr.Element(unrolledElements("7506188221714107043776733278916930250111910520136945624831318658871097313992", "5629641166285580282832549959187697687583932890102709218623488672684285930454", "1535356681714249168045240897960281187522890788209829786897316313475061094982", "5117855605714163893484136326534270625076302627366099289657714986643616618580", "2066826302307643110830131978023455444742352984128617020823311345096204840493", "7735835588637178500535636832030647521749949740595680849367240442898918223437", "1732197281934024702410015372057753134641210120031602836499535391175025879992")),
+		[7]fr.Element(unrolledElements("1876547055428526760944183319729232562527977630034236406207829656870535386466", "3940748816399906197982784971431388381308753023071896453036442279428124311489", "2303035022571373752067861346940421781284336182314744680345972760704747974457", "1279463901428540973371034081633567656269075656841524822414429311502637763217", "4015548244483420901041399271588427721213714369164170211885285839177509288748", "6436687627186659973728125302987332670769042150571978721992590536328654594667", "4200578408690009903344287277240051351504934541076636878511372539610198442011")),
+	},
+	m00: unrolledElements("7388904030749824121217721821433853214953911918259805849443329273927733084161")[0],
+}
+
+// permute7 is the rate-7 permutation (width 8).
+func permute7(s *[8]fr.Element) {
+	k := &permute7Constants
+
+	// Full round 1.
+	s[0].Add(&s[0], &k.arcFirst[0][0])
+	s[1].Add(&s[1], &k.arcFirst[0][1])
+	s[2].Add(&s[2], &k.arcFirst[0][2])
+	s[3].Add(&s[3], &k.arcFirst[0][3])
+	s[4].Add(&s[4], &k.arcFirst[0][4])
+	s[5].Add(&s[5], &k.arcFirst[0][5])
+	s[6].Add(&s[6], &k.arcFirst[0][6])
+	s[7].Add(&s[7], &k.arcFirst[0][7])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	exp17(&s[6])
+	exp17(&s[7])
+	permute7MDS(s)
+
+	// Full round 2.
+	s[0].Add(&s[0], &k.arcFirst[1][0])
+	s[1].Add(&s[1], &k.arcFirst[1][1])
+	s[2].Add(&s[2], &k.arcFirst[1][2])
+	s[3].Add(&s[3], &k.arcFirst[1][3])
+	s[4].Add(&s[4], &k.arcFirst[1][4])
+	s[5].Add(&s[5], &k.arcFirst[1][5])
+	s[6].Add(&s[6], &k.arcFirst[1][6])
+	s[7].Add(&s[7], &k.arcFirst[1][7])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	exp17(&s[6])
+	exp17(&s[7])
+	permute7MDS(s)
+
+	// Full round 3.
+	s[0].Add(&s[0], &k.arcFirst[2][0])
+	s[1].Add(&s[1], &k.arcFirst[2][1])
+	s[2].Add(&s[2], &k.arcFirst[2][2])
+	s[3].Add(&s[3], &k.arcFirst[2][3])
+	s[4].Add(&s[4], &k.arcFirst[2][4])
+	s[5].Add(&s[5], &k.arcFirst[2][5])
+	s[6].Add(&s[6], &k.arcFirst[2][6])
+	s[7].Add(&s[7], &k.arcFirst[2][7])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	exp17(&s[6])
+	exp17(&s[7])
+	permute7MDS(s)
+
+	// Full round 4.
+	s[0].Add(&s[0], &k.arcFirst[3][0])
+	s[1].Add(&s[1], &k.arcFirst[3][1])
+	s[2].Add(&s[2], &k.arcFirst[3][2])
+	s[3].Add(&s[3], &k.arcFirst[3][3])
+	s[4].Add(&s[4], &k.arcFirst[3][4])
+	s[5].Add(&s[5], &k.arcFirst[3][5])
+	s[6].Add(&s[6], &k.arcFirst[3][6])
+	s[7].Add(&s[7], &k.arcFirst[3][7])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	exp17(&s[6])
+	exp17(&s[7])
+	permute7MDS(s)
+
+	// First partial round: the constants of row 4 go through M_i.
+	s[0].Add(&s[0], &k.arcFirst[4][0])
+	s[1].Add(&s[1], &k.arcFirst[4][1])
+	s[2].Add(&s[2], &k.arcFirst[4][2])
+	s[3].Add(&s[3], &k.arcFirst[4][3])
+	s[4].Add(&s[4], &k.arcFirst[4][4])
+	s[5].Add(&s[5], &k.arcFirst[4][5])
+	s[6].Add(&s[6], &k.arcFirst[4][6])
+	s[7].Add(&s[7], &k.arcFirst[4][7])
+	permute7MI(s)
+
+	// Partial round 1.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[0])
+	permute7Sparse(s, &k.v[0], &k.wHat[0])
+
+	// Partial round 2.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[1])
+	permute7Sparse(s, &k.v[1], &k.wHat[1])
+
+	// Partial round 3.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[2])
+	permute7Sparse(s, &k.v[2], &k.wHat[2])
+
+	// Partial round 4.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[3])
+	permute7Sparse(s, &k.v[3], &k.wHat[3])
+
+	// Partial round 5.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[4])
+	permute7Sparse(s, &k.v[4], &k.wHat[4])
+
+	// Partial round 6.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[5])
+	permute7Sparse(s, &k.v[5], &k.wHat[5])
+
+	// Partial round 7.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[6])
+	permute7Sparse(s, &k.v[6], &k.wHat[6])
+
+	// Partial round 8.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[7])
+	permute7Sparse(s, &k.v[7], &k.wHat[7])
+
+	// Partial round 9.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[8])
+	permute7Sparse(s, &k.v[8], &k.wHat[8])
+
+	// Partial round 10.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[9])
+	permute7Sparse(s, &k.v[9], &k.wHat[9])
+
+	// Partial round 11.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[10])
+	permute7Sparse(s, &k.v[10], &k.wHat[10])
+
+	// Partial round 12.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[11])
+	permute7Sparse(s, &k.v[11], &k.wHat[11])
+
+	// Partial round 13.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[12])
+	permute7Sparse(s, &k.v[12], &k.wHat[12])
+
+	// Partial round 14.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[13])
+	permute7Sparse(s, &k.v[13], &k.wHat[13])
+
+	// Partial round 15.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[14])
+	permute7Sparse(s, &k.v[14], &k.wHat[14])
+
+	// Partial round 16.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[15])
+	permute7Sparse(s, &k.v[15], &k.wHat[15])
+
+	// Partial round 17.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[16])
+	permute7Sparse(s, &k.v[16], &k.wHat[16])
+
+	// Partial round 18.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[17])
+	permute7Sparse(s, &k.v[17], &k.wHat[17])
+
+	// Partial round 19.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[18])
+	permute7Sparse(s, &k.v[18], &k.wHat[18])
+
+	// Partial round 20.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[19])
+	permute7Sparse(s, &k.v[19], &k.wHat[19])
+
+	// Partial round 21.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[20])
+	permute7Sparse(s, &k.v[20], &k.wHat[20])
+
+	// Partial round 22.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[21])
+	permute7Sparse(s, &k.v[21], &k.wHat[21])
+
+	// Partial round 23.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[22])
+	permute7Sparse(s, &k.v[22], &k.wHat[22])
+
+	// Partial round 24.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[23])
+	permute7Sparse(s, &k.v[23], &k.wHat[23])
+
+	// Partial round 25.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[24])
+	permute7Sparse(s, &k.v[24], &k.wHat[24])
+
+	// Partial round 26.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[25])
+	permute7Sparse(s, &k.v[25], &k.wHat[25])
+
+	// Partial round 27.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[26])
+	permute7Sparse(s, &k.v[26], &k.wHat[26])
+
+	// Partial round 28.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[27])
+	permute7Sparse(s, &k.v[27], &k.wHat[27])
+
+	// Partial round 29.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[28])
+	permute7Sparse(s, &k.v[28], &k.wHat[28])
+
+	// Partial round 30.
+	exp17(&s[0])
+	s[0].Add(&s[0], &k.arcPartial[29])
+	permute7Sparse(s, &k.v[29], &k.wHat[29])
+
+	// Partial round 31.
+	exp17(&s[0])
+	permute7Sparse(s, &k.v[30], &k.wHat[30])
+
+	// Full round 5.
+	s[0].Add(&s[0], &k.arcLast[0][0])
+	s[1].Add(&s[1], &k.arcLast[0][1])
+	s[2].Add(&s[2], &k.arcLast[0][2])
+	s[3].Add(&s[3], &k.arcLast[0][3])
+	s[4].Add(&s[4], &k.arcLast[0][4])
+	s[5].Add(&s[5], &k.arcLast[0][5])
+	s[6].Add(&s[6], &k.arcLast[0][6])
+	s[7].Add(&s[7], &k.arcLast[0][7])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	exp17(&s[6])
+	exp17(&s[7])
+	permute7MDS(s)
+
+	// Full round 6.
+	s[0].Add(&s[0], &k.arcLast[1][0])
+	s[1].Add(&s[1], &k.arcLast[1][1])
+	s[2].Add(&s[2], &k.arcLast[1][2])
+	s[3].Add(&s[3], &k.arcLast[1][3])
+	s[4].Add(&s[4], &k.arcLast[1][4])
+	s[5].Add(&s[5], &k.arcLast[1][5])
+	s[6].Add(&s[6], &k.arcLast[1][6])
+	s[7].Add(&s[7], &k.arcLast[1][7])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	exp17(&s[6])
+	exp17(&s[7])
+	permute7MDS(s)
+
+	// Full round 7.
+	s[0].Add(&s[0], &k.arcLast[2][0])
+	s[1].Add(&s[1], &k.arcLast[2][1])
+	s[2].Add(&s[2], &k.arcLast[2][2])
+	s[3].Add(&s[3], &k.arcLast[2][3])
+	s[4].Add(&s[4], &k.arcLast[2][4])
+	s[5].Add(&s[5], &k.arcLast[2][5])
+	s[6].Add(&s[6], &k.arcLast[2][6])
+	s[7].Add(&s[7], &k.arcLast[2][7])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	exp17(&s[6])
+	exp17(&s[7])
+	permute7MDS(s)
+
+	// Full round 8.
+	s[0].Add(&s[0], &k.arcLast[3][0])
+	s[1].Add(&s[1], &k.arcLast[3][1])
+	s[2].Add(&s[2], &k.arcLast[3][2])
+	s[3].Add(&s[3], &k.arcLast[3][3])
+	s[4].Add(&s[4], &k.arcLast[3][4])
+	s[5].Add(&s[5], &k.arcLast[3][5])
+	s[6].Add(&s[6], &k.arcLast[3][6])
+	s[7].Add(&s[7], &k.arcLast[3][7])
+	exp17(&s[0])
+	exp17(&s[1])
+	exp17(&s[2])
+	exp17(&s[3])
+	exp17(&s[4])
+	exp17(&s[5])
+	exp17(&s[6])
+	exp17(&s[7])
+	permute7MDS(s)
+}
+
+func permute7MDS(s *[8]fr.Element) {
+	k := &permute7Constants
+	var n0, n1, n2, n3, n4, n5, n6, n7, prod fr.Element
+	n0.Mul(&k.mds[0][0], &s[0])
+	prod.Mul(&k.mds[0][1], &s[1])
+	n0.Add(&n0, &prod)
+	prod.Mul(&k.mds[0][2], &s[2])
+	n0.Add(&n0, &prod)
+	prod.Mul(&k.mds[0][3], &s[3])
+	n0.Add(&n0, &prod)
+	prod.Mul(&k.mds[0][4], &s[4])
+	n0.Add(&n0, &prod)
+	prod.Mul(&k.mds[0][5], &s[5])
+	n0.Add(&n0, &prod)
+	prod.Mul(&k.mds[0][6], &s[6])
+	n0.Add(&n0, &prod)
+	prod.Mul(&k.mds[0][7], &s[7])
+	n0.Add(&n0, &prod)
+	n1.Mul(&k.mds[1][0], &s[0])
+	prod.Mul(&k.mds[1][1], &s[1])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mds[1][2], &s[2])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mds[1][3], &s[3])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mds[1][4], &s[4])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mds[1][5], &s[5])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mds[1][6], &s[6])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mds[1][7], &s[7])
+	n1.Add(&n1, &prod)
+	n2.Mul(&k.mds[2][0], &s[0])
+	prod.Mul(&k.mds[2][1], &s[1])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mds[2][2], &s[2])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mds[2][3], &s[3])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mds[2][4], &s[4])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mds[2][5], &s[5])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mds[2][6], &s[6])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mds[2][7], &s[7])
+	n2.Add(&n2, &prod)
+	n3.Mul(&k.mds[3][0], &s[0])
+	prod.Mul(&k.mds[3][1], &s[1])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mds[3][2], &s[2])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mds[3][3], &s[3])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mds[3][4], &s[4])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mds[3][5], &s[5])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mds[3][6], &s[6])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mds[3][7], &s[7])
+	n3.Add(&n3, &prod)
+	n4.Mul(&k.mds[4][0], &s[0])
+	prod.Mul(&k.mds[4][1], &s[1])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mds[4][2], &s[2])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mds[4][3], &s[3])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mds[4][4], &s[4])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mds[4][5], &s[5])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mds[4][6], &s[6])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mds[4][7], &s[7])
+	n4.Add(&n4, &prod)
+	n5.Mul(&k.mds[5][0], &s[0])
+	prod.Mul(&k.mds[5][1], &s[1])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mds[5][2], &s[2])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mds[5][3], &s[3])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mds[5][4], &s[4])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mds[5][5], &s[5])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mds[5][6], &s[6])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mds[5][7], &s[7])
+	n5.Add(&n5, &prod)
+	n6.Mul(&k.mds[6][0], &s[0])
+	prod.Mul(&k.mds[6][1], &s[1])
+	n6.Add(&n6, &prod)
+	prod.Mul(&k.mds[6][2], &s[2])
+	n6.Add(&n6, &prod)
+	prod.Mul(&k.mds[6][3], &s[3])
+	n6.Add(&n6, &prod)
+	prod.Mul(&k.mds[6][4], &s[4])
+	n6.Add(&n6, &prod)
+	prod.Mul(&k.mds[6][5], &s[5])
+	n6.Add(&n6, &prod)
+	prod.Mul(&k.mds[6][6], &s[6])
+	n6.Add(&n6, &prod)
+	prod.Mul(&k.mds[6][7], &s[7])
+	n6.Add(&n6, &prod)
+	n7.Mul(&k.mds[7][0], &s[0])
+	prod.Mul(&k.mds[7][1], &s[1])
+	n7.Add(&n7, &prod)
+	prod.Mul(&k.mds[7][2], &s[2])
+	n7.Add(&n7, &prod)
+	prod.Mul(&k.mds[7][3], &s[3])
+	n7.Add(&n7, &prod)
+	prod.Mul(&k.mds[7][4], &s[4])
+	n7.Add(&n7, &prod)
+	prod.Mul(&k.mds[7][5], &s[5])
+	n7.Add(&n7, &prod)
+	prod.Mul(&k.mds[7][6], &s[6])
+	n7.Add(&n7, &prod)
+	prod.Mul(&k.mds[7][7], &s[7])
+	n7.Add(&n7, &prod)
+	s[0] = n0
+	s[1] = n1
+	s[2] = n2
+	s[3] = n3
+	s[4] = n4
+	s[5] = n5
+	s[6] = n6
+	s[7] = n7
+}
+
+func permute7MI(s *[8]fr.Element) {
+	k := &permute7Constants
+	var n0, n1, n2, n3, n4, n5, n6, n7, prod fr.Element
+	n0 = s[0]
+	n1.Mul(&k.mi[1][1], &s[1])
+	prod.Mul(&k.mi[1][2], &s[2])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mi[1][3], &s[3])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mi[1][4], &s[4])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mi[1][5], &s[5])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mi[1][6], &s[6])
+	n1.Add(&n1, &prod)
+	prod.Mul(&k.mi[1][7], &s[7])
+	n1.Add(&n1, &prod)
+	n2.Mul(&k.mi[2][1], &s[1])
+	prod.Mul(&k.mi[2][2], &s[2])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mi[2][3], &s[3])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mi[2][4], &s[4])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mi[2][5], &s[5])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mi[2][6], &s[6])
+	n2.Add(&n2, &prod)
+	prod.Mul(&k.mi[2][7], &s[7])
+	n2.Add(&n2, &prod)
+	n3.Mul(&k.mi[3][1], &s[1])
+	prod.Mul(&k.mi[3][2], &s[2])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mi[3][3], &s[3])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mi[3][4], &s[4])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mi[3][5], &s[5])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mi[3][6], &s[6])
+	n3.Add(&n3, &prod)
+	prod.Mul(&k.mi[3][7], &s[7])
+	n3.Add(&n3, &prod)
+	n4.Mul(&k.mi[4][1], &s[1])
+	prod.Mul(&k.mi[4][2], &s[2])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mi[4][3], &s[3])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mi[4][4], &s[4])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mi[4][5], &s[5])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mi[4][6], &s[6])
+	n4.Add(&n4, &prod)
+	prod.Mul(&k.mi[4][7], &s[7])
+	n4.Add(&n4, &prod)
+	n5.Mul(&k.mi[5][1], &s[1])
+	prod.Mul(&k.mi[5][2], &s[2])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mi[5][3], &s[3])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mi[5][4], &s[4])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mi[5][5], &s[5])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mi[5][6], &s[6])
+	n5.Add(&n5, &prod)
+	prod.Mul(&k.mi[5][7], &s[7])
+	n5.Add(&n5, &prod)
+	n6.Mul(&k.mi[6][1], &s[1])
+	prod.Mul(&k.mi[6][2], &s[2])
+	n6.Add(&n6, &prod)
+	prod.Mul(&k.mi[6][3], &s[3])
+	n6.Add(&n6, &prod)
+	prod.Mul(&k.mi[6][4], &s[4])
+	n6.Add(&n6, &prod)
+	prod.Mul(&k.mi[6][5], &s[5])
+	n6.Add(&n6, &prod)
+	prod.Mul(&k.mi[6][6], &s[6])
+	n6.Add(&n6, &prod)
+	prod.Mul(&k.mi[6][7], &s[7])
+	n6.Add(&n6, &prod)
+	n7.Mul(&k.mi[7][1], &s[1])
+	prod.Mul(&k.mi[7][2], &s[2])
+	n7.Add(&n7, &prod)
+	prod.Mul(&k.mi[7][3], &s[3])
+	n7.Add(&n7, &prod)
+	prod.Mul(&k.mi[7][4], &s[4])
+	n7.Add(&n7, &prod)
+	prod.Mul(&k.mi[7][5], &s[5])
+	n7.Add(&n7, &prod)
+	prod.Mul(&k.mi[7][6], &s[6])
+	n7.Add(&n7, &prod)
+	prod.Mul(&k.mi[7][7], &s[7])
+	n7.Add(&n7, &prod)
+	s[0] = n0
+	s[1] = n1
+	s[2] = n2
+	s[3] = n3
+	s[4] = n4
+	s[5] = n5
+	s[6] = n6
+	s[7] = n7
+}
+
+func permute7Sparse(s *[8]fr.Element, v, wHat *[7]fr.Element) {
+	var n0, prod fr.Element
+	n0.Mul(&permute7Constants.m00, &s[0])
+	prod.Mul(&wHat[0], &s[1])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[0], &s[0])
+	s[1].Add(&s[1], &prod)
+	prod.Mul(&wHat[1], &s[2])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[1], &s[0])
+	s[2].Add(&s[2], &prod)
+	prod.Mul(&wHat[2], &s[3])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[2], &s[0])
+	s[3].Add(&s[3], &prod)
+	prod.Mul(&wHat[3], &s[4])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[3], &s[0])
+	s[4].Add(&s[4], &prod)
+	prod.Mul(&wHat[4], &s[5])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[4], &s[0])
+	s[5].Add(&s[5], &prod)
+	prod.Mul(&wHat[5], &s[6])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[5], &s[0])
+	s[6].Add(&s[6], &prod)
+	prod.Mul(&wHat[6], &s[7])
+	n0.Add(&n0, &prod)
+	prod.Mul(&v[6], &s[0])
+	s[7].Add(&s[7], &prod)
+	s[0] = n0
+}
@@ -2,13 +2,15 @@ package poseidon377
 
 import (
 	"fmt"
-	"math/big"
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
 
+	domainpkg "github.com/vocdoni/poseidon377/internal/domain"
 	"github.com/vocdoni/poseidon377/internal/params"
 )
 
+//go:generate go run ./internal/cmd/genpermute -o permute_unrolled.go
+
 const (
 	maxRate            = 7
 	MaxMultiHashInputs = 256
@@ -17,6 +19,9 @@ const (
 // permutation implements the Poseidon permutation over bls12-377 (Penumbra parameters).
 type permutation struct {
 	params *params.Parameters
+	linear *linearLayer
+	// unrolled is the generated permutation for the parameter set (permute_unrolled.go), if any.
+	unrolled func([]fr.Element)
 }
 
 // newPermutation instantiates a permutation for the given rate (number of message limbs).
@@ -32,7 +37,7 @@ func newPermutation(rate int) (*permutation, error) {
 	if err := params.Validate(p); err != nil {
 		return nil, err
 	}
-	return &permutation{params: p}, nil
+	return &permutation{params: p, linear: linearLayerFor(p), unrolled: unrolledPermutations[rate]}, nil
 }
 
 // Hash applies the Poseidon2 permutation to [domain, inputs...] and returns the sponge output (state[1]).
@@ -54,21 +59,88 @@ func Hash(domain fr.Element, inputs ...fr.Element) (fr.Element, error) {
 	return state[1], nil
 }
 
+// HashN is Hash with several outputs: it permutes [domain, inputs...] and returns
+// state[1..outputs], so HashN(domain, 1, inputs...) is [Hash(domain, inputs...)]. outputs is at
+// most len(inputs).
+//
+// Reading up to rate limbs after one permutation is an ordinary sponge squeeze, so the sponge
+// bound still applies: with one capacity limb (c = 1, ~253 bits) the generic security of the whole
+// output tuple is about 126 bits, the same as Hash. Reading more than rate limbs, or the capacity
+// limb, would not be covered. Outputs for different counts share a prefix, so uses that must not be
+// related need different domains.
+func HashN(domain fr.Element, outputs int, inputs ...fr.Element) ([]fr.Element, error) {
+	rate := len(inputs)
+	if rate < 1 {
+		return nil, fmt.Errorf("poseidon377: need at least 1 limb")
+	}
+	if outputs < 1 || outputs > rate {
+		return nil, fmt.Errorf("poseidon377: outputs must be in 1..%d, got %d", rate, outputs)
+	}
+	perm, err := newPermutation(rate)
+	if err != nil {
+		return nil, err
+	}
+
+	state := make([]fr.Element, perm.params.StateSize)
+	state[0] = domain
+	copy(state[1:], inputs)
+
+	perm.permute(state)
+	return state[1 : 1+outputs], nil
+}
+
+// Permute applies the permutation for the given rate to state in place. state holds rate+1
+// limbs, capacity first; Hash(domain, inputs...) is state[1] after permuting [domain, inputs...].
+// It is meant for building other modes on top of the permutation.
+func Permute(rate int, state []fr.Element) error {
+	perm, err := newPermutation(rate)
+	if err != nil {
+		return err
+	}
+	if len(state) != perm.params.StateSize {
+		return fmt.Errorf("poseidon377: expected %d limbs, got %d", perm.params.StateSize, len(state))
+	}
+	perm.permute(state)
+	return nil
+}
+
 // DomainFromLEBytes mirrors decaf377::Fq::from_le_bytes_mod_order.
 func DomainFromLEBytes(data []byte) fr.Element {
-	reversed := make([]byte, len(data))
-	for i := range data {
-		reversed[len(data)-1-i] = data[i]
-	}
-	bi := new(big.Int).SetBytes(reversed)
-	var out fr.Element
-	out.SetBigInt(bi)
-	return out
+	return domainpkg.FromLEBytes(data)
 }
 
 // MultiHash hashes an arbitrary-length list of field elements by chunking with the highest available rate (7).
 // Domain is placed in the capacity slot on every chunk. Supports up to MaxMultiHashInputs inputs.
+//
+// The tree does not bind the input length (e.g. 49 inputs and the 7 level-1 digests hash to the
+// same root); new protocols should use MultiHashV2. See docs/multihash.md.
 func MultiHash(domain fr.Element, inputs ...fr.Element) (fr.Element, error) {
+	return multiHash(domain, domain, inputs)
+}
+
+// LengthDomain is the capacity of the hash that derives the MultiHashV2 root capacity
+// ("poseidon377.multihash.v2" as LE bytes).
+var LengthDomain = domainpkg.Length
+
+// MultiHashV2 is MultiHash with the input length bound into the root: the final chunk is hashed with
+// capacity Hash2(LengthDomain, domain, len(inputs)) instead of domain, which costs one extra rate-2
+// permutation. Internal chunks are unchanged. See docs/multihash.md.
+func MultiHashV2(domain fr.Element, inputs ...fr.Element) (fr.Element, error) {
+	root, err := lengthCapacity(domain, len(inputs))
+	if err != nil {
+		return fr.Element{}, err
+	}
+	return multiHash(domain, root, inputs)
+}
+
+// lengthCapacity returns Hash2(LengthDomain, domain, n), the MultiHashV2 root capacity.
+func lengthCapacity(domain fr.Element, n int) (fr.Element, error) {
+	var length fr.Element
+	length.SetUint64(uint64(n))
+	return Hash2(LengthDomain, domain, length)
+}
+
+func multiHash(domain, rootDomain fr.Element, inputs []fr.Element) (fr.Element, error) {
 	if len(inputs) == 0 {
 		return fr.Element{}, fmt.Errorf("poseidon377: need at least 1 limb")
 	}
@@ -80,22 +152,36 @@ func MultiHash(domain fr.Element, inputs ...fr.Element) (fr.Element, error) {
 	copy(current, inputs)
 
 	for len(current) > maxRate {
-		next := make([]fr.Element, 0, (len(current)+maxRate-1)/maxRate)
-		for i := 0; i < len(current); i += maxRate {
-			end := i + maxRate
-			if end > len(current) {
-				end = len(current)
-			}
-			h, err := hashChunk(domain, current[i:end])
-			if err != nil {
-				return fr.Element{}, err
-			}
-			next = append(next, h)
+		next, err := hashLevel(domain, current)
+		if err != nil {
+			return fr.Element{}, err
 		}
 		current = next
 	}
 
-	return hashChunk(domain, current)
+	return hashChunk(rootDomain, current)
+}
+
+// hashLevel hashes one tree level: full chunks of maxRate go through HashBatch, and a trailing
+// shorter chunk is hashed at its own rate.
+func hashLevel(domain fr.Element, level []fr.Element) ([]fr.Element, error) {
+	full := len(level) / maxRate
+	chunks := make([][]fr.Element, full)
+	for i := range chunks {
+		chunks[i] = level[i*maxRate : (i+1)*maxRate]
+	}
+	next, err := HashBatch(domain, chunks)
+	if err != nil {
+		return nil, err
+	}
+	if rest := level[full*maxRate:]; len(rest) > 0 {
+		h, err := hashChunk(domain, rest)
+		if err != nil {
+			return nil, err
+		}
+		next = append(next, h)
+	}
+	return next, nil
 }
 
 func hashChunk(domain fr.Element, chunk []fr.Element) (fr.Element, error) {
@@ -110,8 +196,19 @@ func hashChunk(domain fr.Element, chunk []fr.Element) (fr.Element, error) {
 	return state[1], nil
 }
 
-// permute mutates the state in place using the optimized Penumbra schedule.
+// permute mutates the state in place using the optimized Penumbra schedule: the generated
+// per-rate function when there is one, permuteOptimized otherwise.
 func (p *permutation) permute(state []fr.Element) {
+	if p.unrolled != nil {
+		p.unrolled(state)
+		return
+	}
+	p.permuteOptimized(state)
+}
+
+// permuteGeneric is the straightforward form of the optimized Penumbra schedule, kept as the
+// reference for the faster paths.
+func (p *permutation) permuteGeneric(state []fr.Element) {
 	t := p.params.StateSize
 	rF := p.params.FullRounds / 2
 	arc := p.params.OptimizedArc
@@ -1,7 +1,6 @@
 package poseidon377
 
 import (
-	"fmt"
 	"math/big"
 	"os"
 	"testing"
@@ -17,7 +16,7 @@ import (
 	"github.com/rs/zerolog"
 
 	emposeidon "github.com/vocdoni/poseidon377/gnark/emulated/poseidon377"
-	"github.com/vocdoni/poseidon377/internal/params"
+	"github.com/vocdoni/poseidon377/reference"
 )
 
 type emuSmallCircuit struct {
@@ -69,7 +68,7 @@ func TestEmulatedHashMatchesNative(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	ref, err := bigIntHash(domain, a, b)
+	ref, err := reference.Hash(domain.BigInt(new(big.Int)), a.BigInt(new(big.Int)), b.BigInt(new(big.Int)))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -143,132 +142,6 @@ func valueOf(e fr.Element) emulated.Element[emposeidon.FrParams] {
 	return emulated.ValueOf[emposeidon.FrParams](e.BigInt(new(big.Int)))
 }
 
-// Reference bigint implementation to sanity-check constants and schedule.
-func bigIntHash(domain fr.Element, inputs ...fr.Element) (*big.Int, error) {
-	rate := len(inputs)
-	p, ok := params.AllParameters[rate]
-	if !ok {
-		return nil, fmt.Errorf("unsupported rate %d", rate)
-	}
-	mod := fr.Modulus()
-	t := p.StateSize
-	state := make([]*big.Int, t)
-	state[0] = domain.BigInt(new(big.Int))
-	for i := 0; i < rate; i++ {
-		state[i+1] = inputs[i].BigInt(new(big.Int))
-	}
-	arc := elemsToBig(p.OptimizedArc)
-
-	rF := p.FullRounds / 2
-	// First full half.
-	for r := 0; r < rF; r++ {
-		addArcRowBig(state, arc, r, t, mod)
-		fullSBoxBig(state, p.Alpha, mod)
-		state = mixLayerBig(state, elemsToBig(p.MDS), t, mod)
-	}
-	round := rF
-
-	// First partial + MI.
-	addArcRowBig(state, arc, round, t, mod)
-	state = mixLayerBig(state, elemsToBig(p.OptimizedMDS.MI), t, mod)
-
-	// Middle partial.
-	for r := 0; r < p.PartialRounds-1; r++ {
-		partialSBoxBig(state, p.Alpha, mod)
-		round++
-		state[0].Add(state[0], arc[round*t]).Mod(state[0], mod)
-		state = sparseMatMulBig(state, p, p.PartialRounds-r-1, mod)
-	}
-
-	// Final partial.
-	partialSBoxBig(state, p.Alpha, mod)
-	state = sparseMatMulBig(state, p, 0, mod)
-	round++
-
-	// Second half of full rounds.
-	for r := 0; r < rF; r++ {
-		addArcRowBig(state, arc, round, t, mod)
-		fullSBoxBig(state, p.Alpha, mod)
-		state = mixLayerBig(state, elemsToBig(p.MDS), t, mod)
-		round++
-	}
-
-	return new(big.Int).Set(state[1]), nil
-}
-
-func elemsToBig(es []fr.Element) []*big.Int {
-	out := make([]*big.Int, len(es))
-	for i := range es {
-		out[i] = es[i].BigInt(new(big.Int))
-	}
-	return out
-}
-
-func addArcRowBig(state []*big.Int, arc []*big.Int, row, width int, mod *big.Int) {
-	offset := row * width
-	for i := 0; i < width; i++ {
-		state[i].Add(state[i], arc[offset+i]).Mod(state[i], mod)
-	}
-}
-
-func mixLayerBig(state []*big.Int, mds []*big.Int, t int, mod *big.Int) []*big.Int {
-	newState := make([]*big.Int, t)
-	for i := 0; i < t; i++ {
-		sum := big.NewInt(0)
-		rowOffset := i * t
-		for j := 0; j < t; j++ {
-			prod := new(big.Int).Mul(mds[rowOffset+j], state[j])
-			sum.Add(sum, prod)
-		}
-		sum.Mod(sum, mod)
-		newState[i] = sum
-	}
-	return newState
-}
-
-func sparseMatMulBig(state []*big.Int, p *params.Parameters, round int, mod *big.Int) []*big.Int {
-	t := p.StateSize
-	subSize := t - 1
-	v := elemsToBig(p.OptimizedMDS.VCollection[round*subSize : (round+1)*subSize])
-	wHat := elemsToBig(p.OptimizedMDS.WHatCollection[round*subSize : (round+1)*subSize])
-
-	newState := make([]*big.Int, t)
-	newZero := big.NewInt(0)
-
-	for i := 0; i < subSize; i++ {
-		term := new(big.Int).Mul(v[i], state[0])
-		term.Add(term, state[i+1])
-		term.Mod(term, mod)
-		newState[i+1] = term
-
-		contrib := new(big.Int).Mul(wHat[i], state[i+1])
-		newZero.Add(newZero, contrib)
-	}
-	mul := new(big.Int).Mul(p.OptimizedMDS.M00.BigInt(new(big.Int)), state[0])
-	newZero.Add(newZero, mul)
-	newZero.Mod(newZero, mod)
-	newState[0] = newZero
-	return newState
-}
-
-func fullSBoxBig(state []*big.Int, alpha params.Alpha, mod *big.Int) {
-	for i := range state {
-		partialSBoxElemBig(state[i], alpha, mod)
-	}
-}
-
-func partialSBoxBig(state []*big.Int, alpha params.Alpha, mod *big.Int) {
-	partialSBoxElemBig(state[0], alpha, mod)
-}
-
-func partialSBoxElemBig(x *big.Int, alpha params.Alpha, mod *big.Int) {
-	if alpha.Inverse {
-		panic("inverse alpha not supported")
-	}
-	// exp17 via square-and-multiply
-	x.Exp(x, big.NewInt(17), mod)
-}
-
 // Debug circuit to inspect limb outputs from emulated poseidon.
 type emuDebugCircuit struct {
 	Domain emulated.Element[emposeidon.FrParams]
@@ -133,117 +133,6 @@ func TestCircuitMatchesNative(t *testing.T) {
 	)
 }
 
-func TestConstraintCounts(t *testing.T) {
-	ccs1, err := frontend.Compile(ecc.BLS12_377.ScalarField(), r1cs.NewBuilder, &countCircuit1{})
-	if err != nil {
-		t.Fatalf("compile rate1: %v", err)
-	}
-	ccs2, err := frontend.Compile(ecc.BLS12_377.ScalarField(), r1cs.NewBuilder, &countCircuit2{})
-	if err != nil {
-		t.Fatalf("compile rate2: %v", err)
-	}
-	ccs3, err := frontend.Compile(ecc.BLS12_377.ScalarField(), r1cs.NewBuilder, &countCircuit3{})
-	if err != nil {
-		t.Fatalf("compile rate3: %v", err)
-	}
-	ccs6, err := frontend.Compile(ecc.BLS12_377.ScalarField(), r1cs.NewBuilder, &countCircuit6{})
-	if err != nil {
-		t.Fatalf("compile rate6: %v", err)
-	}
-	ccs7, err := frontend.Compile(ecc.BLS12_377.ScalarField(), r1cs.NewBuilder, &countCircuit7{})
-	if err != nil {
-		t.Fatalf("compile rate6: %v", err)
-	}
-
-	t.Logf("rate-1 constraints: %d", ccs1.GetNbConstraints())
-	t.Logf("rate-2 constraints: %d", ccs2.GetNbConstraints())
-	t.Logf("rate-3 constraints: %d", ccs3.GetNbConstraints())
-	t.Logf("rate-6 constraints: %d", ccs6.GetNbConstraints())
-	t.Logf("rate-7 constraints: %d", ccs7.GetNbConstraints())
-}
-
-type countCircuit1 struct {
-	Domain frontend.Variable
-	A      frontend.Variable
-}
-
-func (c *countCircuit1) Define(api frontend.API) error {
-	out, err := gposeidon.Hash(api, c.Domain, c.A)
-	if err != nil {
-		return err
-	}
-	api.AssertIsEqual(out, out)
-	return nil
-}
-
-type countCircuit2 struct {
-	Domain frontend.Variable
-	A      frontend.Variable
-	B      frontend.Variable
-}
-
-func (c *countCircuit2) Define(api frontend.API) error {
-	out, err := gposeidon.Hash(api, c.Domain, c.A, c.B)
-	if err != nil {
-		return err
-	}
-	api.AssertIsEqual(out, out)
-	return nil
-}
-
-type countCircuit3 struct {
-	Domain frontend.Variable
-	A      frontend.Variable
-	B      frontend.Variable
-	C      frontend.Variable
-}
-
-func (c *countCircuit3) Define(api frontend.API) error {
-	out, err := gposeidon.Hash(api, c.Domain, c.A, c.B, c.C)
-	if err != nil {
-		return err
-	}
-	api.AssertIsEqual(out, out)
-	return nil
-}
-
-type countCircuit6 struct {
-	Domain frontend.Variable
-	Inputs [6]frontend.Variable
-}
-
-func (c *countCircuit6) Define(api frontend.API) error {
-	out, err := gposeidon.Hash(api,
-		c.Domain,
-		c.Inputs[0], c.Inputs[1], c.Inputs[2],
-		c.Inputs[3], c.Inputs[4], c.Inputs[5],
-	)
-	if err != nil {
-		return err
-	}
-	api.AssertIsEqual(out, out)
-	return nil
-}
-
-type countCircuit7 struct {
-	Domain frontend.Variable
-	Inputs [7]frontend.Variable
-}
-
-func (c *countCircuit7) Define(api frontend.API) error {
-	out, err := gposeidon.Hash(api,
-		c.Domain,
-		c.Inputs[0], c.Inputs[1], c.Inputs[2],
-		c.Inputs[3], c.Inputs[4], c.Inputs[5],
-		c.Inputs[6],
-	)
-	if err != nil {
-		return err
-	}
-	api.AssertIsEqual(out, out)
-	return nil
-}
-
 // Multi-hash large input tests ------------------------------------------------
 
 type multiCircuit16 struct {
@@ -438,3 +327,84 @@ func TestMultiHashLargeMatchesCircuit(t *testing.T) {
 		})
 	}
 }
+
+func TestMultiHashV2BindsLength(t *testing.T) {
+	domain := DomainFromLEBytes([]byte("Penumbra_TestVec"))
+	inputs := make([]fr.Element, 49)
+	for i := range inputs {
+		inputs[i].SetUint64(uint64(i + 1))
+	}
+	level1 := make([]fr.Element, 7)
+	for i := range level1 {
+		h, err := Hash(domain, inputs[i*7:(i+1)*7]...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		level1[i] = h
+	}
+
+	// v1: 49 inputs collide with their 7 level-1 digests.
+	full, err := MultiHash(domain, inputs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	short, err := MultiHash(domain, level1...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !full.Equal(&short) {
+		t.Fatalf("expected v1 collision across tree depths")
+	}
+
+	fullV2, err := MultiHashV2(domain, inputs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shortV2, err := MultiHashV2(domain, level1...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fullV2.Equal(&shortV2) {
+		t.Fatalf("v2 must bind the input length")
+	}
+
+	// An additive capacity domain + n*2^64 would make these collide: the second tree's root
+	// capacity is (domain + 42*2^64) + 7*2^64 and its root chunk is level1.
+	var shifted, two64 fr.Element
+	two64.SetString("18446744073709551616")
+	shifted.SetUint64(42)
+	shifted.Mul(&shifted, &two64).Add(&shifted, &domain)
+	shiftedV2, err := MultiHashV2(shifted, level1...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fullV2.Equal(&shiftedV2) {
+		t.Fatalf("v2 root capacity must not be additive in the domain and length")
+	}
+
+	// Single chunk: v2 is Hash under the capacity Hash2(LengthDomain, domain, n).
+	var n fr.Element
+	n.SetUint64(3)
+	root, err := Hash2(LengthDomain, domain, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := Hash(root, inputs[:3]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := MultiHashV2(domain, inputs[:3]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(&expected) {
+		t.Fatalf("v2 single chunk mismatch\nexpected %s\ngot      %s", expected.String(), got.String())
+	}
+
+	if _, err := MultiHashV2(domain); err == nil {
+		t.Fatalf("expected error for empty input")
+	}
+	if _, err := MultiHashV2(domain, make([]fr.Element, MaxMultiHashInputs+1)...); err == nil {
+		t.Fatalf("expected error for too many inputs")
+	}
+}
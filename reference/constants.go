@@ -0,0 +1,1645 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+package reference
+
+type rawParameters struct {
+	fullRounds, partialRounds int
+	alpha                     uint
+	arc, mds                  []string
+}
+
+// constants holds the plain round constants (row-major by round) and MDS matrix (row-major)
+// of every rate.
+var constants = map[int]rawParameters{
+	1: {
+		fullRounds:    8,
+		partialRounds: 31,
+		alpha:         17,
+		arc: []string{
+			"5966135783811619788946141430599740285333890476176239487053701755282483259229",
+			"8248386181461625724624937953920458307783934991095828229311948662109703846229",
+			"7899697948171006775608679894258092311173776732034376699480766172940143332902",
+			"6159903727631813124658505727742008686518139735306098276802832804806992180150",
+			"6603147245625769955556264626336290925453294494417468042132447023388340299439",
+			"5452037251482288884459408199998243903142580394917869276146144672081622843033",
+			"2115708492564482930864434704366485100751738113026748722601682745504297806604",
+			"6133269380405971624163032902959596862025161741886995971748777100528132299512",
+			"1721373298675722117940847155120516423706154044622883045243024016434015697761",
+			"2532176072218405331661057027566225726033916848962631064036767622968370620421",
+			"7524067696224680757975098555540410845710016011660137050724377830134384345101",
+			"429871330152690529194971512200321767443467690984017972743339762825407688724",
+			"4822017484452655916856912744980710231803358265071943154730711311103413373365",
+			"824805971881810943315480704737108941911470981122775059328188495378122732116",
+			"329423367343883915514983034403099471446131273787671146509854540644107913806",
+			"3883570534464466369989900277196782028657057428551976312572809240832449868758",
+			"54572309533869666713815545961978943833125965191367899718872564380412367827",
+			"104379966757231160002849091367321006399239678017811970878118738543783012285",
+			"3858794609418653642223132852951715558391493166064725133511836315778019207762",
+			"7972137249246669918452864163262970209957043950169362761933011015101944889612",
+			"8039476336099168668727095716808108897423850098843706006244337078630780735875",
+			"766430462054504684437076620779515009510608446229290910406705826112635319521",
+			"1157637743005900078763045828583815942936327706889163293509285927879248613852",
+			"183934423281730235616875364700958253948344322765356511034233191547769363772",
+			"1201562291895585824017244732773114229792892359638996784519703395328110117361",
+			"7310830976022972124006273496057736723302334623714327408809145379602290638605",
+			"2276872920099944672856296669350324747933089853087959749074024714270320260388",
+			"966186309314558308244732353285518560026022414047505874760748721011885636552",
+			"4329502709342970939316184021870647333452252664602520071765724342507829556028",
+			"1337917261255725418250807303026010004761301733304867049551577706155074758165",
+			"2839123367356547347441073953599915821128375251332356459441250279757074887817",
+			"224869059332792965060195408821564586206434504942125985103234074196709224834",
+			"2118280724507514011418932238720730003985992189023324349392738827408813419470",
+			"2024746817632649160329225850453502881580459753222774798266674540254096291210",
+			"5307015204756219408960369558840758352147099769857833209947994256747928257137",
+			"2407188252312221860580438003668742664004234794617922976059521488991595074312",
+			"5700150280886935087009453247680311201121208454493198279022438029607234143353",
+			"828542545916769704315409945347237208572285442892223193990879248700065051975",
+			"6424020944846567619673399158265606226806728227047720977493059829313969166558",
+			"5956636828116256451736357524320841700115703677198610564962237869663858071864",
+			"6933622218433329082021136266165495108542662690546278978263672802079585858771",
+			"204406226257651687195615478017955871586120607733975319305559723816453526010",
+			"431036448527621354942248671784563679512406095626139552665028723514906107279",
+			"2016406250235180438600581178120561107077239174595547290770499452866017307935",
+			"2447571947257624755418643779781470875695669518474076004121556310904642337555",
+			"823175697495712519803645407488370527154708792984128797959297156747503221464",
+			"5808340764084155658475571734374743816943273999143774630733852180823715703009",
+			"7355041960620166894243633138047951214299390310721833447536481770010924182637",
+			"2120887415217279631479397749634460783917137667543869946213939195926282168460",
+			"4106047313079529846051904718092551567497277064547982407065468562206559262083",
+			"2869242011889231404909133868698817227497579259211963396424277007676681908030",
+			"5830475615944010394710658533945042878499619156056253528544887424328284533333",
+			"6445135510747273379638655974958831352681217556501517433182431280009829767448",
+			"3039442732831840251297958163526128870429881856309417478361276294396087226871",
+			"3750943880896182174971677063560735365855431148595700647372739176486892105360",
+			"6501105879101363238574742431707671377990863722951661844266502035398320078655",
+			"8762940550008698267921660361508082078549552508484251072871737389499251780",
+			"7849014182562314090744458575516477908512244379446772221807253399687719915819",
+			"7819370335680637242579036089736241212018135042303573318142192088258427233896",
+			"747638661415129403364618594175025354868001628934615356530406778821361740441",
+			"6658828034743151551710649802496724888729361547906353112540091368274558946461",
+			"8328145462438412881643804075368556833926038786901905782513874650031190195327",
+			"8320331650667342323383974431797077522417503304594901121399889421397646071868",
+			"5449933697382452826795510354601320641289019263865712754592193870057100394478",
+			"4008518250088803388817191682256320373945471106849545040689587699114729889527",
+			"5879283194171533756539189745601792737324595298734552274741868717138815351564",
+			"6626699161525777173055182114141370998077087893099077308894619252233744717846",
+			"163741410170388028377206341187105220978890212501940049275941086737474403489",
+			"2289406808035365551509331312793292709318048121336289318211070950581668590781",
+			"5430151020513142173055352979265745660781724536468437563956825250123186769437",
+			"4040004656752387138938977569185095852881985565015580941465394860026553994036",
+			"1901308305061675634226724460540357576851952962685703065906892481358446930441",
+			"2686273196094412130593768635962284067098909691815336855637195251162980171156",
+			"1123632222954904617550891255304448941562187156316217306121520482993732652419",
+			"2124462098522865366594185315832211935844567922720223671250422450277331767386",
+			"3968009204129455601037289639721574073403531972013488960906721718883864047886",
+			"1041450153030980593059805000659658173285263988288148401600145800517153364524",
+			"7964222143364674108039807011723483055868039993122214029137966682187499721189",
+		},
+		mds: []string{
+			"4222230874714185212124412469390773265687949667577031913967616727958704619521",
+			"5629641166285580282832549959187697687583932890102709218623488970611606159361",
+			"5629641166285580282832549959187697687583932890102709218623488970611606159361",
+			"6333346312071277818186618704086159898531924501365547870951425091938056929281",
+		},
+	},
+	2: {
+		fullRounds:    8,
+		partialRounds: 31,
+		alpha:         17,
+		arc: []string{
+			"308026635595114235070436728341841505234226384644787941764356225291780075012",
+			"686850750308311448868354907988153221833589417264043199872750834851275630399",
+			"5458865526113744175375673481036999502881423789202235030915223710930508573500",
+			"1216889461603982466063117074419064018993731784023337352219629352951004218382",
+			"4580374146984258872617301222640071415348151863297599652351895199406510624060",
+			"5287771711632967718595430906600106184880872479312192055075552617141548501299",
+			"3037526442503690560777271665669625925917538366486234291090702161060916614832",
+			"6275277408809697928512465960441767403986852341417079924634963619646806124417",
+			"7335650489313165022076032570688161581492191665821494053773844209042883340886",
+			"1627952039309156476645184308670263708019542166435650091304574646631569460339",
+			"6094265973203525089006037274771888959193635664689776329087130682272196094008",
+			"6490696528492405721785907440795129872072544933360586449368276289112880330670",
+			"1838969713611020994526552299650788115168140980815959904769759411371437475085",
+			"2150051935070463751493305482799641725706245753717668882294497822824388319578",
+			"4575572148360756475907353900681447955312802247778987169199474643005130716935",
+			"1851688896784243289932019446285888654652306219713142676419351172260600922714",
+			"6973552312635424934570970915726931835821737587964001373829651198305564713244",
+			"1813003948570365248649794664717915544584605337173583303735237517614852010262",
+			"8263838364638315756819655988900910418618512340908078920307324632543945164500",
+			"757943113036795101065823745544829115197604837832768830141778672857097207622",
+			"659325027542376965992085727974783614933698097545036724992593606308630964139",
+			"1225384975372888789772257703659435489309718895398738845602578994965191972389",
+			"7047321089114218473742436051763144729708458704973957631376463469541098276305",
+			"4896435516099794294013100518299784209896728776935133585568100103105644366269",
+			"2205611631605917342771043739003168054091728635889814050523595535007173267903",
+			"4474292819278150418223394712870944390214136577384608881113789172943901756185",
+			"5587482766701385940251260739243227887661785673403464848010833438080581211679",
+			"2641477529317380932697589512697916502009860625009330516388196585651681493176",
+			"7659241781098366386155720168632612014434481871232768282168172586344498585340",
+			"2213394414496632001850413419138630033341488354994088997070037377687913045198",
+			"8225722503382752487995602487837074631802103163654291534420243738097561923211",
+			"7339897388607796780814184350408038307549544998106959208719480969830754355714",
+			"6063557771009805901369588877386914456032427713266832819067913917532377663085",
+			"5509459114334322933080334148673613015218318202961864814558888525860239977647",
+			"1068773010324768699264514407746331217127187152280245817647555692667272524805",
+			"1475085787102796648525415834131411151818489668007757976944301855541062325957",
+			"7806695333926830350651265019724920668446960202327785464297697432575564892268",
+			"6262615427970190003802435633045752362752019983944651481699206198107630667501",
+			"5882310218697746646752824849544270655456741743515414997582197895094710858123",
+			"4020331411826639688300623264550213776493766044074688280569180938277859091874",
+			"5121255494220880264468029924121174240167067523779050306271979286616022983384",
+			"845210955510933566002116427536045841286540286530946099066621065119722796675",
+			"4111647319312005247588888076266870242762057231362139607364774953978018302987",
+			"974256221217239393715990077542507764506970329207579698526122990643033824957",
+			"8159194581608557426538516014063257877781718792172378782923721536338075157415",
+			"5801330700370167469894729208361580066110395695280140377467309877650005609388",
+			"3394916168528704906797115121684038788393911457592974647637601320052880312678",
+			"5972250532491093184876275293339222434229671963274971711757113836479449305852",
+			"577370828757987434131060765456679971208926305673729814931934265779321773430",
+			"507369807706785879972719468470742776325023232536230874775576358969660471171",
+			"8145685735375135873236047590991377004841377786797564751928308568246623719134",
+			"6266812424655557804232577725379349619924941193610964604533176490248029261290",
+			"5967069394383625926592344942286040375814323449026607622149927596188761075273",
+			"3098449309447928770326430524623708251070761959504942163830346543311329812530",
+			"7427687102404419444527513714902552301778528290974214220713506219999675675333",
+			"4042876682716610044719894233707888314141403055770188287172821864822020198057",
+			"6837118584037052064438949987261070301544840685414744015869031742089822922445",
+			"1590095428593559863629820253130343351544841134884391502296948548443287186727",
+			"7833716475035809798805452573207732340626115218296146847285921472768509634541",
+			"1978766321165168589630826892562241348143601476846182828696106373368314589991",
+			"7868843219594886750750407514795570643227076640179151649282544892407534950592",
+			"3987117632709535432627489164941561521764371714110622944508310419066492323867",
+			"4076651335938465583820584717335396202979827471201866993402454539589670076574",
+			"6097748422352539177536678843480748867533252126316441163794334575472761192228",
+			"6078805585843131510577316268189212746081092327434755953371890725413311537300",
+			"808152962788527242286089686410977174198722494768279927846476444721346446780",
+			"8245045639104682160533738142044010415064219273511255106133773733199124521153",
+			"4927398668407453175049439565878572621130445066312119547147153299334208840274",
+			"363071701490644073034803917900446378362169536640995003365310347872935927086",
+			"6845633336116955356908527722458493089296092216721078117361029212334156034899",
+			"5094161176623110722538832591662719638820846893811655692681247282139572370575",
+			"7895316786827468299318427673897436206788315682717422447392995386534194809245",
+			"4619732789620735261100885573296112045445141157388178713596060188742622627289",
+			"8422122828670140480638067294204606309187570277720043969595883578063866777035",
+			"992958244258733465815289318950280606760995861894444863716516601726621951905",
+			"6764013719997591825457793918699801796014086921189886289817845875572992948924",
+			"1769631866057478207885307356771710476532650071643225238577433500390003064798",
+			"8016530745695127030799369163106442821010868795114179395122623057639374511985",
+			"8047402967616037894798331978704326272668112374178700484265954166189443934757",
+			"7958790794400926032963336530767233388628124879210206645872619788929075456478",
+			"173252929061996890901264974543899995780593767283643983973014427458683001541",
+			"424155452932847713908278173876758151545515219770842719961252496605244210819",
+			"4963998855511100561599552018011798722633814204866173360361168413573963102186",
+			"4924076301193420725308802072131338491797915911003604974321332671027931187176",
+			"87738268021093433851281834491522887096402113566660018960879318272510245409",
+			"8189285308779873224020359279990375150459733757976267012947719806969677070800",
+			"7140245732247889400232233586175941378463217319557254345847453782157236936894",
+			"3743951945863470078600584990270648056104210006488008873331665117840812797880",
+			"7758108064106000033154897821365721729228420055510189063189732601086961159068",
+			"2942331573871054342107123741050322394352958878052608362425041477010616345589",
+			"7534481797769283402135041398750790178261361935710363254896834970993454697586",
+			"7432765185094764662620235238828375611563632895456898300218670191109969003682",
+			"6291527872301459316564295970257606704192931633697240620667776646184893228067",
+			"5813315419101033685338589591740539737255593497183538450695253259569871183939",
+			"5526672082176263237417520469010132662746348759226334285738656831590234548753",
+			"3332801859738332252857912215019184013542060534668701681483001518406654964330",
+			"1405201308866890283812006656161598509681885029723142585511085641864028595396",
+			"1492494789956752741222490333391378456618539215379349797045428691266085997365",
+			"8071212912433625994454068244446084774815031240954408676949101589537116165412",
+			"4776554854519122517933592399764150620363707384114137316897908201016415856781",
+			"125733739368601178383657902963503628562164711974256889561227003875613464767",
+			"6095291956682197100095438891582653659020834282561205807326323590973913331048",
+			"1222469665268606145803729120426500156401953546226735560453940247824615064170",
+			"3553916981699410215413275982884160504425690320454695706786765040400435191673",
+			"4597893035276850283590548632351419824369725156115729763845407308233992233881",
+			"4098945751589637585349322721645137714901277398725764380413825257514636605490",
+			"896241425392348667316141178956455644228372721471824108535546494759067376393",
+			"3992502368943038378368897680594056578803163431114935312687627167974272071263",
+			"2916896099606045408059702536614926909593404178656347225235372099792450298246",
+			"120269880148157352408037220674298509372962320809264336091966259007633284713",
+			"7722392890376228197239026921734213343834699657441777356614625170525296088221",
+			"2433763979138972299522164212362097495457810156842620348670661190742330717004",
+			"983738301417603757808483219105286248075595155162538643758190406964355750696",
+			"209202405659177692545688490614016438006505568346018036573546364833013030573",
+			"4653243085200282579438307546529783706597045845312243944557671219043378566385",
+			"5337014110345479543678006017350943272815297410632902615031016645483782346794",
+			"6325608705322012724565293795590543306557376953836287094512934948871034460300",
+		},
+		mds: []string{
+			"5629641166285580282832549959187697687583932890102709218623488970611606159361",
+			"6333346312071277818186618704086159898531924501365547870951425091938056929281",
+			"6755569399542696339399059951025237225100719468123251062348186764733927391233",
+			"6333346312071277818186618704086159898531924501365547870951425091938056929281",
+			"6755569399542696339399059951025237225100719468123251062348186764733927391233",
+			"7037051457856975353540687448984622109479916112628386523279361213264507699201",
+			"6755569399542696339399059951025237225100719468123251062348186764733927391233",
+			"7037051457856975353540687448984622109479916112628386523279361213264507699201",
+			"7238110070938603220784707090384182741179342287274911852515914390786350776321",
+		},
+	},
+	3: {
+		fullRounds:    8,
+		partialRounds: 31,
+		alpha:         17,
+		arc: []string{
+			"507014295002340130094051641853152875478157627773318139164018329180924405874",
+			"7491635671712014457226444359115925142756691872583683345054285850544197741427",
+			"6428238367987262728380227088231207564575448754570094797343562439968130973414",
+			"417784945642189241683731513330527942532284498692605186769747085266175822763",
+			"2460473050623699025207425440478059302299840127402356580032311810234352192553",
+			"6739526644189243304596281380207849534959661650376903866949397817731628238273",
+			"597549483098771783017881992848590630624851676141528707360377946657258324767",
+			"4344854910230270044421510722988181256819181450723410913296668891739456698878",
+			"4470966059082111196154549519927954009195386775161045009249761095179400738385",
+			"3473585183550757121590696749322044239715986752544880348555956596981389357863",
+			"7430297707987557411189895556541208022085393472000735227995498626328989684346",
+			"5069778819917269726812261651516390352533805494152825991716992564077618201433",
+			"6174290539348443013815085618635508535362670264359346323016750542543831671611",
+			"3653200179921208842362414440137382634990868113411395862128462165430232768762",
+			"738082080048556788240923937267344369599673172037328036389652736930755015716",
+			"6044628729794520171281155572076229146090871806225211668717932778346109567300",
+			"7736716524450328636992649732099229308498454296838040636411888315240829896039",
+			"445819555352805914532097292182472149144078763817859669741975662939570903079",
+			"8394092794612157949817946063023932613000340794281965315017284578429245458889",
+			"7466238759822922447708730702706401935849907824276279332055688787440996717722",
+			"8133406852969123956605817319998510162150586162953352059663647357307786450170",
+			"424450599417139666683298435198002681589862354265665464641715001351384167919",
+			"4342275863437731904622617541643601785172909643082646844261023898203105457997",
+			"6313051476010503451749544338183153756551815502439779051641801614566285763459",
+			"3169994258510125562695300946459466516753097859237027453101755698752871805159",
+			"940104882892277443613394347861702508275167480844292912496756850082344181727",
+			"8386913227127502132530495363118110304777579547923274644980222467857412952486",
+			"6136114103602406791267451042734253266954304008297597483742365775019490301827",
+			"5707913825835366556092824230880552633384012284396562419512114568454781766272",
+			"440149260913441211009696064751955962692960388523825331963054262767537488240",
+			"6543809035845574998097033320920161403566283178673858276395077027543247305226",
+			"4600299844965080059869604598802213965503797803928746919640456727327450953335",
+			"5829619330419527894551885400021968400890923326407352286968146522526991995959",
+			"1072126727148622232260315955319281060621012109578478738112207748069980924969",
+			"3541737964424380109788267613233390154435200667195460548613319704497734217622",
+			"7993868742943365022564653431815772923975390402981496881445664963672494637843",
+			"1796466469602122660444104004440279863940114358482936061911749791684733710417",
+			"7023633298532372935362664409341485229404704070227604977879965907688274511839",
+			"7753638232839858655663800027938081730461292782758006490345237997988322545788",
+			"758435719045098942234564604489742572402395570729286075893953594831824781575",
+			"6431648459299330670260885711956057556031697438773821997572300185978034362991",
+			"1793863535383713398998984265535284191852386753019751835416578014488181663643",
+			"2570169913181254021375023805159346796230408912121297755395210687814552404613",
+			"2797182392859745476123817612074916088434710562567225848500623100435110261495",
+			"1165553002412302230779686368446969386757102793871148064084092221298371093471",
+			"3274799046118891303394494158966162986774122770904320227955921678384730506800",
+			"3425305392261908437651357687206668799558419234911004724372509238407711129269",
+			"8346825084462913909605033242830887555310938617925477009138394819302909845329",
+			"3201635684356589303957559840868578463993422412405939241547886338480364890847",
+			"1666877490991395391549048946911053473487849642250360673170573745319764829033",
+			"3299223839115383023937717000826182296440529851590453764583865472885058307271",
+			"4996045171356088098584327231799712971775597657789563922144692970174095423805",
+			"5071229823755602861503104143449120972698947454618730827722771444554899574441",
+			"5858861478624288658440425205329788699584645780558303913274042715926810521114",
+			"508444268856364400271862689259837405153055907779532163763868097792229098594",
+			"4127321280996111497968160900412774190878522914715965141361433671646956206182",
+			"7071549460375534429723718824195498396217592262267586094225421947143692617968",
+			"221757864860979622060033742864884615648747499118860122322915691012175980536",
+			"7211341158106856382678018041894821017323750814749698727140029811204083468036",
+			"6943529232381915752136480093930327392085725525820410661513290697754052993128",
+			"4763423807814348282157990050939516748361529693043317899586102822833480841072",
+			"2190796128952812697528358809735858832429475305043240058677673214750853313800",
+			"4523486207206187598811299893069396745625184885730514405177502246670614392198",
+			"7513070905246698816156621160386478407835811285350595304295303126723875640085",
+			"5836445124808494589808308534928421375948343800058603896518090167146916599684",
+			"9171283736409506717264663313119516065621209011238514108295556449743839518",
+			"5859622888361586361788147390136702225977160026409963821685898671611280693070",
+			"6837150967281313264151089579941327056671858284386786060051686295738377177661",
+			"7221723246834615183030010235724717210151616905800718798232072222980084010419",
+			"4329910188707893158470284002559145106125732906317923389051817193092777131264",
+			"5792427906543765244466457975121734042332467472077182673783118623181520425094",
+			"5346006532457755733171734500999687838915847058928477694547101342292499179303",
+			"4597392548498607574017560525094380847055006449556755439725487970445213590020",
+			"3303281267589969370080082514639135770191199060552521462772350753997314837047",
+			"5558462823705511279040205586307603100081635933586313909352118861980698297302",
+			"1263748398138463803734503961078041548483514945249248474635138633752566447795",
+			"490647985265779623654821894501654868324039080758503716367653671834799811982",
+			"1898094513926360152052411590761718544591903834954818536874699766719875033822",
+			"6053343692389373368077507471812372847646104903461406638701093256406259345939",
+			"6554421296177225080267952576334789681668030171857233913558626523231627259509",
+			"3721920025713798370498076140674600002053797988537455641711174690390895385364",
+			"2745387728902807242392789462319546074221167499837764828319375019918018876633",
+			"3097028366181661019594241699127474134306432976144442452032323656757003850653",
+			"7823557720905459296103980883355311123601028854508692383778861145275594636924",
+			"4106060455498591909999696539072262778095731079248778115501584357839174902332",
+			"196573194431867132075882873593364223623802278987784511493225925606554999399",
+			"3881453561547504705609378527741929789591284764516319602573825600113769419329",
+			"493690773871566059847594074532948552053765521932045410259746939671486257862",
+			"6849267447668928646234210841379777456966734633732540873989202454970094962426",
+			"5555522384395102841761900123416437935052425409768071672675868156560502664172",
+			"3164690097391885553665673896220385018942443913813415429561095784307993877675",
+			"751997789925724099571719539753731795077203163786497928556573288276983857799",
+			"7797064805359378923378263108750471488988996670366203564906966139953797786476",
+			"2621058208535806748929478936820062512558754775621817172089881590533438911657",
+			"6970001120193851908531728965538133691525883363792484682581417337692500902764",
+			"6826842223394285672424196404992312747730620966214863886225498038478833682358",
+			"7280753522324306125661480239652564884224736370406844144390901625192570466562",
+			"7451304684849463043611764007732773140659720225850924442956893639278925508688",
+			"5253838130724544974396741090168351524672297373390836034550060653680140034410",
+			"354732178706565624123373355953225841445298387453652117348719218243221471628",
+			"5979597818162747734929373098808636593825085498843018786696665538105657844167",
+			"351629572524479389143320902578994665388426714276868909352158880699229770814",
+			"7109929775074769204456615920975367722001470815061690578400757577370356475759",
+			"5806079662751268574438201372400910735431542199334746046612215896815791065563",
+			"967046392054835084545986605942012533206399069425868131391043504592936582378",
+			"2235626016383653135130173827578754724403655434369809542054094514348720144681",
+			"118551840213415715186180270494191799120979955929396152586751740139954770674",
+			"7940037426759603018350847912350302513354348154694424346903114718921056800033",
+			"2993806947124341224488676547808115780756133808041816969561399929323091199988",
+			"3167498406773851843298096921587059973841966635480368418683430217271170320658",
+			"7675462210513623403638112459070981475591788141512594573391686976617804748800",
+			"113656520646986566305770873856403245064118770850240394572766400596130519231",
+			"1006225388831407597002513026923357368201575577664986063363565854366603986973",
+			"1526282785909026335633723225294164750933182353012114115386394391821211651299",
+			"1264354730649648485153348016668926773357340160928809433923690630326979915982",
+			"7910412318739090470833358955102625881685684659552089299133733767719163523061",
+			"2090970125570254288079670536393427121579040289101998075443870771478924283370",
+			"8311681734940517270883280212822579178795688925328896292462357879779746187187",
+			"1174147681464540438326971085327070955639510958622116967715666013405346402206",
+			"4175215095126474514465222550636824494555503973937772844090349895213411476196",
+			"4241759696766954462463723260157362033085821297582753950078581155537557151758",
+			"1546282720224443972828836964335088925724907089163291901383796489940020003342",
+			"5700204554208422586827147566009516954101695508731242493166780209623191931157",
+			"241928117549199144060953691071944032603403288483151133697470756177198473825",
+			"3245948103288826434025898701130893665522312031093434026965851273769736723698",
+			"2846744319360715391507045130826276684180415630670742056543891047181223700176",
+			"958584337997387979912117947775051127593595525170298235342160144609264176973",
+			"4169252310805842903830114064888506388073860203737522330389533365930747683745",
+			"3318846374911785204190875758231457337043668093149524076485922702513345093073",
+			"7615914970203196798794715474076214531002652446692907123137915773970750728959",
+			"632809892316086468848078173763187501355955399168541884899267678517741089919",
+			"2084579918265637786725360934802652836635649879932874680862991758229384815880",
+			"4075324628198618501449806408411880501101368939321234063136596854430148275736",
+			"4002543445225357459503590699152084209735684603803494712821090386774180750357",
+			"4606083947254012673682779207557774120141220789108725392708225901954954318275",
+			"6458161122821480138812881055057431187332515758131902148536758717159076765198",
+			"945660987383748932101735057848419013789434411052835694568224008001945197426",
+			"7817623181465516166905237421295720105924125839613693222466377071701802776589",
+			"6550683822650732117819888043108879305261914159459025109565974339199133829888",
+			"8047502109396113498796473893603681668180133015895331201918878122680564584923",
+			"7527592443870070416769159161840175145920858357524990578830014410783569808116",
+			"5092456597786987850309117489482478313382405139827972441408647246472962756834",
+			"6350597027022709417452502607358026831887635557428071029520622433982691427012",
+			"2802963479241809964305385036387099199586209492879271476960143416190639640353",
+			"8221597608726879712015358263079163240151837220833337984106403382169293661236",
+			"4113355626280577616600236093811592553533103473131581188028563119065229189086",
+			"5372776432646713299575551380876512719814813415800817038026997728135205964237",
+			"4786357963899522354925243599250308006789219843696074990338183430509312871698",
+			"6136402023627867107328780042258341451909381526863697406971910076649425863577",
+			"403917435174867072656735572934226709672919820691101390561081554994951410328",
+			"2197307939742823984581382962133936215707127960884165372495222916141872574366",
+			"280402617002073624427675333736860754609331352944830300201501643212477955908",
+			"1341193910735288834156152818207813295161416180387476007289323480480850290318",
+			"6430227820490268278323837184448206513428639661392170572655482468189896623489",
+			"5381106858655589782618523649345652522506825219090054633547753330769140464666",
+			"6815943890781966378087179499267196955390238260135387729266185493827955661877",
+		},
+		mds: []string{
+			"6333346312071277818186618704086159898531924501365547870951425091938056929281",
+			"6755569399542696339399059951025237225100719468123251062348186764733927391233",
+			"7037051457856975353540687448984622109479916112628386523279361213264507699201",
+			"7238110070938603220784707090384182741179342287274911852515914390786350776321",
+			"6755569399542696339399059951025237225100719468123251062348186764733927391233",
+			"7037051457856975353540687448984622109479916112628386523279361213264507699201",
+			"7238110070938603220784707090384182741179342287274911852515914390786350776321",
+			"7388904030749824121217721821433853214953911918259805849443329273927733084161",
+			"7037051457856975353540687448984622109479916112628386523279361213264507699201",
+			"7238110070938603220784707090384182741179342287274911852515914390786350776321",
+			"7388904030749824121217721821433853214953911918259805849443329273927733084161",
+			"4691367638571316902360458299323081406319944075085591015519574142176338466134",
+			"7238110070938603220784707090384182741179342287274911852515914390786350776321",
+			"7388904030749824121217721821433853214953911918259805849443329273927733084161",
+			"4691367638571316902360458299323081406319944075085591015519574142176338466134",
+			"7600015574485533381823942444903391878238309401638657445141710110325668315137",
+		},
+	},
+	4: {
+		fullRounds:    8,
+		partialRounds: 31,
+		alpha:         17,
+		arc: []string{
+			"3431064144647154854906922246941506182222173870772679525880648837636528462927",
+			"5974593340237813855065980531800418392229182384079544474645411722799919145826",
+			"8348426486881635683182335045339260090794248341687902711787330562710527330030",
+			"2956151886560243922621332166670498751051583313292219792564998383355563424069",
+			"7202553704068066642115231966909806322861037568682203181517551877916261334156",
+			"2216708299265206033085353198252811284979409151554807145353180393005345443076",
+			"159335743353116328456368467219460934058466648656727531382446971993029171408",
+			"6358764046359684972893522865101698862289416136086499847308829648457169884877",
+			"4565669615674819431036641511394858343600542708840534960333061188699120679516",
+			"8134340319422717456174214595572028038604616932665913260921485003742433978940",
+			"1524401355336014828526302364873243668741090019654727936642087684528900198959",
+			"4002982571574570934955320971167280988768820199359033883158331219862407490167",
+			"268956367875787697972162318645634654752917110456095075021347431474721377550",
+			"5539077933627315832517588545053557934921428562542219829976540617497684332768",
+			"8163159707574771497074276335511382452957675096097737520190925328662359500075",
+			"4977563953454029124270220726210548316848814835999821114089004208776001409529",
+			"7008664649215929946792472256484126474139232333206086319663168135693982854505",
+			"3318343362844228852737722392858921343864694811396087052570286467757103682210",
+			"4587685140645381977725355169261358248758918671032202397129872095189579561454",
+			"704287003752591898133347460228078707207754728734442590948457672014774127322",
+			"5586295855761311502524744594863435129218163429541929947376690582426001205171",
+			"511183681092274132577691562117725290358264643096152647157355941362092811890",
+			"3791674481171094988809522263427975797797362874776318037160568007353522634738",
+			"4965556154466909844381620649257570389832386474047974618620218104354064063289",
+			"963971635740506695524350086639812612091454527172531163980246521861028438569",
+			"7451992112096607760070806231419516306470951591954925177908399061124274263922",
+			"7295020835214661651956415729023504830971467253215761505201474665911455830460",
+			"3280787858384144985438140612639447429324251039365462088829648101852984550543",
+			"2723069157469016916952012980902663865513886975368952753054991125338417988749",
+			"6733325546431357986554722827687834798300213011526397736613489805927905039872",
+			"56441798850784206915280729725313063705563358361043754962920378642471323078",
+			"6242175416051197478965577816668352024312546274687357249134970893857683637801",
+			"7229059325557971513848440629134335379833660584249795111754961422333313607445",
+			"4356997736710300451650893760872593825295996966836948532384189247527540050988",
+			"6049177819482487340461587244657185732646063354529443080122436335159416018307",
+			"7576032712525336013506180916525388378290421050900955766685149445245599068280",
+			"685881833411823963576399873668926648431518211440759077000731288930749252058",
+			"5384977628335230749636022233644848516687146330097069641749995476117414428050",
+			"5066626083526655559235268649106935359036992877987015973958141686441893981615",
+			"3864160983193434022886998306815956641466289665128616501311023249260929552808",
+			"4983941881243182382419978602436262452063279277632038979947467554028069255963",
+			"2380948917082021193414549859774890757211307109486149249055872709115860184599",
+			"1301850928074772735515957946025843493965124551321884817764442241036689460031",
+			"5076897091243437097374948423452785437285304227681926632319204340544971351169",
+			"838127818681109643111332745898553870614061735572194444363970004242071077373",
+			"2648735541215544391998877172183084494605670598556435437159619417296822227871",
+			"640905846278351353238009110145641369411677691778563522917583241258624649457",
+			"5808802512212087745976031058783426731700713016299428748650928703458711004704",
+			"5749520874951533296314184729627523989610545298994103723357863453360184626972",
+			"7525445582965390300114302021019560132409820399129242601557247805779238307844",
+			"6273694450692809401014139700242486665748135838970313017557107436600726458407",
+			"4905871617426663938319313104542034003803518601136283126064218461920650549964",
+			"1534940277876083668919293638051191975711259917242845683774534821664526343485",
+			"955425033646804536515201484147592192926177121204720097718766516611829526501",
+			"3894150193314733660812750390338205165198396325338470786690315652243619523859",
+			"6801866375963986689874975385127139228922087767812185493056701864750823046750",
+			"7684017331242214245294327500647474342164357536170689226027885852245154849139",
+			"6533613910909897757120178781711524384929846151303957276027775562196300302214",
+			"1287778348006793753354264516775910784675578173563856312788659291170395524532",
+			"5216915770215863705409380567169020200209418379575908663400005214787797370438",
+			"6927925275343061862302658799552674983671506001213383012269858960006499515307",
+			"3093431225583285580046103032589984161893627925253127114586453327601657530861",
+			"4876508992669306157807478179122786499361974800002342258880758732306240297585",
+			"2219651447961251376486863950578960860487881410606162814751517519200897892087",
+			"5668773160796938127082941580620585286942699223431101979348874059922774012818",
+			"1684893093402299091081200444647548834947240031341162586465732315478226990729",
+			"7799057167703178248117746588920357705512026304634271300319889557354880371241",
+			"7035962059299232373282594000188009708423815232513861608267397492058368514655",
+			"3557841071694962744222128553164306971763173861341821760759398010110916817379",
+			"4919604360126837358236378759740095094734090251750427077694652342742015916333",
+			"3651964097696714101760373286565395286045981519509724735161744858315540816702",
+			"7752385399247926357527056380360808208180081668017775007471560982218033946237",
+			"1560948170663949249269952400678295486888598242822198620325709945477214797198",
+			"316585276003266717671190676495491229976216737090878145310002581328591539440",
+			"5974898654158584463139271462320546617841212083549718367214959777352959495333",
+			"5607004686501853872982207933339023766399967231475941595727751773525628758232",
+			"263583497813614807388366950168851578800754468135269590784469615476535128717",
+			"4871595315337310536264086178722040094415050759013585894612923767087751908699",
+			"7173031190250859550777460363163861192761573301345074535057323242442324691325",
+			"4723171842604091011176330449498704226263418780555412349754368956504668410122",
+			"3093548779175445905159322828510949810768292210271136674694133809205853946145",
+			"4988936223801567123535323200615892415167642586821298105957911780645181694254",
+			"1270686137321942870279052672278309294560572841737399669722561513209237380672",
+			"3027941487562378123721922900099852691404951041633521671398104427545925691668",
+			"4250367193013370954668903232462054699730068538781572254097226173154986215943",
+			"3705604675524974825735991257641779214522844126919760251465625870683806117431",
+			"1551260208361803690514780672787033034618654224039606548555845537861754046538",
+			"3186113032606723168090432328426823707739232147505302872305888065960935844821",
+			"4618271499236252040457076109295953262458005612124073479720280966407947860574",
+			"85068080339364143050775823084986400709929505436687224865850800645071087587",
+			"3507988017613409133657367864429411179865920486795500996497040824217097292460",
+			"7970107102198616736711602714867013223500032535700603296666154312414237678784",
+			"7203145370944655952837572275579114842061587777862317485337984480478802632840",
+			"2626923365577867419861067699874226380794382981678256410128947046410867593454",
+			"4070982642487328836564600268954969237493687432432450985795431483735455900743",
+			"4515278409974508993564172627125429774574614756775077151715641820058462905237",
+			"3425014569349371453834589829875283358719921330009811776869097687279062879137",
+			"2515566255852496064002951003892564743824841708305449827422382645027069070193",
+			"720525006140603161540193448814302617294040974256009825178936921367639778439",
+			"7367663655251566472421325885202560742025124988743048966983734178477823972279",
+			"3320751538307738217634627783201795162745408603787789711772266685694484944916",
+			"3386040192515644244212831353306931760973595850302406027148713774545286758291",
+			"3761448177836405784953534531695469075610578058956622870681358568372925658970",
+			"1075183077135395450365708159427330084848191667324652044966444911821388696629",
+			"2882738091025915095886776926625385766734704795692036818933191859919697476809",
+			"4617677246175457778854495806811172394981680905637257748781941029853717258322",
+			"5771461794324243654526297158425763003330735380725612289050705359730071239278",
+			"4558031763124841766669372193496818071724865493971402982085377903444474587687",
+			"8141117664279670265207049460468667350204471729029321520983857087648145502125",
+			"6195788751946064104383103983543994507568126076571249838849490543170846659025",
+			"1059599007031118919301902365295124708521843594472237888863204210743524454267",
+			"5335364043712877346408190295692418434066312211048115548198980855698882158076",
+			"8316246925389556857059468387404509035053566680272307083454543154571323861085",
+			"5490090299085350778121282949237451297326343519143725131342180387720889359589",
+			"982952375360847129724490417720756319295038857607393974322522066631510583362",
+			"6912541015779228733393242589316461907046987802589662174925807063866005360743",
+			"1129593556827455837973325656081058550489681406224509075914475234919198185107",
+			"1937618904212510512354506408985123382700952246502520470325321212671150347646",
+			"3199581281309144873736171191664914342004216739448977171254740671523117441555",
+			"3882731022638913010359310673652139990872120547585706475765515205937556342189",
+			"5288481863589845207506139383646039384958247973884137159418420627552127931970",
+			"7942130591592945706895315481799734433741602413592840551908769964795782608968",
+			"5051892235665720020018826618535299857851085694800649824271468315719378181649",
+			"5607212903747583926526697928256720784635337923527282496989348766912942994673",
+			"1005884468890121805544667086279275166920615705097956006974287867360116510627",
+			"5741330397180945829428504832247215836616957161262082878639916347436553087278",
+			"7113157446624997569554789611450589049996386977337105981325987667831539826179",
+			"434966185410632135185943986364783375571726296729610745781632287888723457988",
+			"5039802488048963835006624051407996449630084485148632435276076903048876374844",
+			"6277394771555254841201693053364694411785262956950074230877477457050146145350",
+			"7908099502852050062687385226488258695798940263199015330802932154592406094334",
+			"7059803414292720524307725442485511732964092444131901934868290305628576267102",
+			"2139460395975167664251483842973329242173648967205322907102676387523657330407",
+			"7713833445621910172069259123836985620916656881299879820225971306156722385150",
+			"4702697580661123243003468809750572064033858150906769608766261234379440378345",
+			"2058405693863273982847268534108655874807256557976851621785238654039467515836",
+			"6674457497299429663710230591569139514263534876095498106116913267093663780315",
+			"4394349719596574551549596214262928047125802364147432112238594736371319101217",
+			"6195297631158703521528049804908561074583920087242571608462634484611500167355",
+			"3242541329035091341128246584277113195113553777004100613079937311868015069560",
+			"4488773899079486051170785239065153620809431438600471596626517435505102118937",
+			"6681363412038194140795429174155979405046271053963301138999269362805654504069",
+			"7662242546205542398356134731758300460600315553573879682432583813859452836200",
+			"6453549740536358454504175636457946145686596519078014824866622902795607732647",
+			"3434027548019966853693323689350917152691087689665937771604554535965235520912",
+			"299082112673478422764754620971284657027144192562393535511453660105206035107",
+			"6395155327227537426486482892833268678161195655526514574121190746939478388987",
+			"3039381088162114631674331586494105962238771530597574588375796494928669649065",
+			"3334675545472410818743467442080239750505805749315744278475211560979296636737",
+			"1196983096101857709598574279556244852300477701251449098484534290024518924230",
+			"5507347578032242038087982316955699262813359618027103132775673371110788299895",
+			"5560080148677213890507165547953752780519856814277143049217221680351859825399",
+			"5633835642182107458165072565312817304740338606963238548211749078832908650630",
+			"911614257936736591336424182716942211452433652908193813700912113362046712210",
+			"3705543798273043191211792935279311741221524426394035211803972447778519619347",
+			"5785036548626352245177226786910695334555198251668119386253275602234189604340",
+			"847971323320355442301334363553408559594153445121098722071959354840694732649",
+			"4197331512837528156704994381832276430816359714883660325065518557698023560377",
+			"6576028574423396238526342400139289607047025524619052131425825974036258801606",
+			"4676155251689568093012135115787731342950221114177190158364860256454529471785",
+			"2714618045143938619898076578560687636406140388449464388759363386241966607181",
+			"1962132004800323563469839832001946314177856513333502258694633410368622937183",
+			"4060303721139745185753003702242452853892567577701929945051705636841605394426",
+			"858431967366800259429702342421555087572913382935050196019572402458455337876",
+			"877911187548075026731669736278785759327577474797844900633096057140615081125",
+			"1019450753652996323060985769610208687502135057729861941495498967001564647316",
+			"3851788025399437417208275102395317775772759364153518812991305264015991388670",
+			"4560279417429841423093566701490605206447881605499847195977491876525780099107",
+			"3134667173774338523992012898495588034817629209037681071601829750586253871388",
+			"7832266672200870525897695994933278406523270595861155510261323829849771005035",
+			"3712183088067883479150433844090205722121157548492007881527072932869040454605",
+			"5801903878751677471106069179675135554571578499505816207389793919704782653936",
+			"133357046720968940217288532780758703863340792751200985876521675622988577868",
+			"3001592744243106968297835231782573528844685953002423881375060097320656955350",
+			"2464111491719539813619225696061559882817211545600468865635378210539198159455",
+			"1322652077946933817114865278733093858990311234699275468382653833423050745492",
+			"5645215415246962740356127494957015951514599603859670006653165433427164693060",
+			"720189655435077226693228902608508375844861111998842869300519328297727792886",
+			"185200828324752242433717554979366507039533746746071572779724308985924103781",
+			"5062274726469822209812100498705646842753986247467163742638203593135146892394",
+			"4985147731701280516122195490346401914592823270137114236360234768104112278629",
+			"2887769780031221320189923358950180519938336707834750936438152238605771538185",
+			"4215828315610408367904010758539873734811771712189857070943669192637602517003",
+			"1543632720656628126125982421130157017436958675131518792186811247089700493653",
+			"4832500060396630035991245052563913029405538648780355784888612789791420607598",
+			"5930257493267574500042037208093725122451773768067833261193177835090030231983",
+			"6502901560147376485847653671976628642790817732181058349910853757232107023811",
+			"3813185417582493541776744320547162746343924349873110184732754873079797171153",
+			"6509768824040100638521305078471095204921310149573479294107390899486522843069",
+			"3605647195366343121022521058580251119532209828115204053018233085119170067569",
+			"6769819025968586890565365669631295740031055074504963563488334106735520662613",
+			"8078839985317492794953557685153658953919163145558294969245187929666873992250",
+			"1394681604050412530833331610672526944507542631579572154759809162329384560638",
+			"7104554881094887062843081388750064718663992515537917313436084280540113848013",
+			"480898963303594644926304962428204837944253172605670175725141207630587527124",
+		},
+		mds: []string{
+			"6755569399542696339399059951025237225100719468123251062348186764733927391233",
+			"7037051457856975353540687448984622109479916112628386523279361213264507699201",
+			"7238110070938603220784707090384182741179342287274911852515914390786350776321",
+			"7388904030749824121217721821433853214953911918259805849443329273927733084161",
+			"4691367638571316902360458299323081406319944075085591015519574142176338466134",
+			"7037051457856975353540687448984622109479916112628386523279361213264507699201",
+			"7238110070938603220784707090384182741179342287274911852515914390786350776321",
+			"7388904030749824121217721821433853214953911918259805849443329273927733084161",
+			"4691367638571316902360458299323081406319944075085591015519574142176338466134",
+			"7600015574485533381823942444903391878238309401638657445141710110325668315137",
+			"7238110070938603220784707090384182741179342287274911852515914390786350776321",
+			"7388904030749824121217721821433853214953911918259805849443329273927733084161",
+			"4691367638571316902360458299323081406319944075085591015519574142176338466134",
+			"7600015574485533381823942444903391878238309401638657445141710110325668315137",
+			"2303035022571373752067861346940421781284336182314744680345972760704747974284",
+			"7388904030749824121217721821433853214953911918259805849443329273927733084161",
+			"4691367638571316902360458299323081406319944075085591015519574142176338466134",
+			"7600015574485533381823942444903391878238309401638657445141710110325668315137",
+			"2303035022571373752067861346940421781284336182314744680345972760704747974284",
+			"7740756603642672888894756193883084320427907723891225175607297334590958469121",
+			"4691367638571316902360458299323081406319944075085591015519574142176338466134",
+			"7600015574485533381823942444903391878238309401638657445141710110325668315137",
+			"2303035022571373752067861346940421781284336182314744680345972760704747974284",
+			"7740756603642672888894756193883084320427907723891225175607297334590958469121",
+			"7794887768703111160845069174259889105885445540142212764247907805462223912961",
+		},
+	},
+	5: {
+		fullRounds:    8,
+		partialRounds: 31,
+		alpha:         17,
+		arc: []string{
+			"845774603178492790430819572559948899425806472242990359595837698866402459283",
+			"5104113060992167207950156191834052800250377917110335646977277694909225606639",
+			"606930867215997902681408163383822852644874536120525401359262311657265176286",
+			"3246189740533673369325580437595636125853593448497295559526133710916170023325",
+			"2228557437175639577799724834018880269762506939154314745471148408315031040782",
+			"5248714497354123146476603303736275602516846846345343189695992364680008748274",
+			"4468660183396113816417160760392174946434715734046476615756949516900460245783",
+			"7393542747111210649333111074982387945081968569023142501741239756021903723895",
+			"7199392773674619989102817361994783385540000190771324931792999149811086723727",
+			"4179131455162240247318123589436646198639084830612188212185789176671108496032",
+			"24442689501566866103376042248929386407192919728660696030608041405438957133",
+			"3097787510685557034777264781234655318746563426795026202239222093602417399558",
+			"6574238658366226506536641479793831030684930753068170934418220861949357860591",
+			"3230736603041831131224401646930510293150560782678475479524520009468240625703",
+			"4449397682136210685399058399525408353602443323667935079724525731105410330857",
+			"1462805245535093331717840709705014862484623276551060803969576565098857503359",
+			"5430193997486659445858726501908887547497708817666434922669358130281149310067",
+			"8182992080105598491896178755005741632616297373995328206793063427977612612424",
+			"2470198070508873630088316330360042099497336224174355851511490652176698623455",
+			"616202094790463990913669657355701717237705251976201488136276464144059917047",
+			"4300832028822930023146879288491662568841103305714742296374296369784204253894",
+			"3476274661803244432899568134341751009082965077671317942410157824155554772073",
+			"5064187415312733563044421812957719834749578846215859205757776767013163169207",
+			"2785720285300546669174088113375060468705507039789824059878564962780494844393",
+			"3955439879096535600770596087986987521484177541728792312152110382971886552324",
+			"903856851374014040347659555593632700335680221489370005350941124277345446123",
+			"4895401528580538578197095433262526637580426196822434256427161231133334295675",
+			"5648346646350183867284790510877028718205611511413394919080061462693730281546",
+			"1355370740565781139942863802725256415305660265636533288615995983856809414029",
+			"4492648249860957650851177365194984343910427247177102136729895011065514916187",
+			"4329492389108771825144079889394256488249153510854480955288949134855093604169",
+			"5810883949128212012849651527927095153625927912982491102336953941756119383024",
+			"745230929536301694839058434825580209210952065402030795105052872210695113978",
+			"6824796306474603258822493495675887167444932740597690201928301954595067792786",
+			"5862361504652656026849345150631994357849056901114555854828370594245684576589",
+			"5689114263606895263929252423354025539068244060577412456834090100133202449501",
+			"808996924441865833437445466881261877339104224701775307380191367855500551709",
+			"8369018150288133225788400978082154796228251164581602665345878758411724734440",
+			"5422892039043420257946460796978469383542115840049823331066028321773489553840",
+			"4759532913919471748077109277312912270920142620933001277672083762634782304860",
+			"6635834972740869401402846471957760389835104503078639687189505646883078267050",
+			"2180357492069277982978421447716964286298172906113645264525526539513647929451",
+			"1543790854439663004266297482741275425236694694794837346043593830373950964684",
+			"934463790260438488912680781683319399046678080815540371325926063726693258316",
+			"2186851943069145638222582980805398139896784251442482314015542120928261450486",
+			"2412160749224996310088578134225153622616811224475337205311590933677253673878",
+			"7695670784620822879010065180124901695635154588003320830927767666974648056338",
+			"5922342388326275454416533801936916843303810548091342035450746483214299239433",
+			"1626796117599038929107160841446540268065680312095803823646386071293624738956",
+			"3723894365042872951008952527495970802881113713273866444298801207152897112399",
+			"3124762799615037750505825747310314071538244722211644570130000309355042335236",
+			"4398142109789539554397950800974126093455781299196527221772449847244505962068",
+			"5191924005039724187421181054530943291389774141755095477416337174609761394244",
+			"2785691573715023678345146226582781727453009049889859397213505583302164176974",
+			"1692865380955225891313695435924848311770152603367254817109708783517944701458",
+			"5740996178635184840130794938793759737845291624356154466822199165827559215996",
+			"629048164528450855828641693378949447919081668499695702800776487092117499681",
+			"7838040784187025691292915248729484438528565912103486033688447138210990601628",
+			"277825800544950901375905897976554734011179501252710007984572644325060195668",
+			"3573950673351768341596829285012110326873174826710921664927116238368645987265",
+			"1954385223571002660618067888551643335278824908978569230924580545853863253296",
+			"3280591263674701729230889786293421229344217394582188890523979353297481519915",
+			"2641145634481240269631127178194359324994015540110388568200378466009164847634",
+			"510014107782217049609305419554240375108180404505381192548757091140846836195",
+			"5791727637040073168347254632756741113350426678148566497620918219062449626066",
+			"1515530830048633560956896860547539068191730708819568650745448852244645668565",
+			"4062117359039639099790825319573396597524575522991965004241027520315175746769",
+			"8077604972923302071609856302702548716244843142972321053845777990299628343924",
+			"6883469805232035047303165681612355811006463914153298651225845064283571480407",
+			"7069733541849801646056409828059140471389948859025556787594300665570570640093",
+			"5111488081820384284582456604902295506474499510148161974044782999536795472678",
+			"951739538321083558162954190716831881445104353478325886809807144890602444880",
+			"5576968375586952492715372518216353184444829783871889310474622851012373412727",
+			"3738378363114658873850673065632442970572354982406771981154818174118783760351",
+			"7085217053698299540943905339943013879591867088664807037548604127718635170607",
+			"1243770513927337778182422448361198850137863107625225399527564470585686277778",
+			"2455706756578844172374999537652164934241896709505121508439861969323756753548",
+			"1192232316586916994784972201990731490438791718559527129470126178591533976493",
+			"4517504977719076695469211964474074773739696135499467806638247340490078446601",
+			"7553708361104427886890847840974837551257803206243713474691780065106433271079",
+			"2587075644848123870074792242454603061838992298077876642730434622712692686270",
+			"173588832775839102721672024190884329602241291800352973909370376510736082221",
+			"688472569950966756053552347244458704900509840525673302053163309999091579121",
+			"2352046706728596036488802195059640933035395261457352632670222145121490161643",
+			"7073575640855838071763652945175738332390047626204526711901743359026021113742",
+			"2046389921981736047805298636213531692386373936005050836730626898158653505324",
+			"563321919927185801891684586810044445483326043795854450295190605906301369521",
+			"2889560537114800351260900365764145025271368172807980502228650924152700482686",
+			"3666589997079555397305833747977806903409036424008849706574979198788716406192",
+			"1918355409774704608237986221225759376350370097933394351471037522396452825048",
+			"4369269652222651377930121722266159133596441533744245682724913998469020685239",
+			"7837833457952524445039685470136995896897287733616574115849057519215223782346",
+			"628213450553258613851440709956500749794581186558724188918468103441806105330",
+			"2644954814082884292817254547594534999080939064892852771810159803469141128660",
+			"7859812225594642554440576251126430923621572949680294169949667671791095952475",
+			"2464974871554679933883652897027654206618138056032028622174969722545768552379",
+			"5132270167540347614041702481962392404041377447418996321667139515916634164383",
+			"4598105830574976900645044571546715789904336050221613120678525927302780728037",
+			"3808501141538620978353097012892379364836506340699026351199757900236242566613",
+			"1891544728221108359734223022373179882263705759938125476452464221325816178498",
+			"6005030543218026726459052340654081530413236195762174005947985599626093524736",
+			"1240332940925172223780697145452602160665050403038368531584945872125564048652",
+			"6705885002056761550002268384728119172344212225730006658468643832688992534447",
+			"870837793952435415833012579028217019216352769807065186182707161903249365374",
+			"596272418044934975814628040788830716009647699332221675956979368500086249769",
+			"590861359490467631502512021552521037752701710394631946974031331319157115545",
+			"2770526343670399800546133608119769774079504653574715594043898973680079888138",
+			"8268687277875236079426782059572937996067109214725376871100880774536852862519",
+			"1453016007424520027464263999937432978604227655266267727016149187553644944843",
+			"7235861308242939841519205133571690399636877042720031807266145091504550721031",
+			"5126147988300793477390589565054838817540212084762587014887829332327848369169",
+			"438854660004065569962723300303346605327281161374837025576031680377685082432",
+			"91951995490099536724921221454897283566677877944037951784180382949300930772",
+			"1107661872194632786253588128163989817213101209276790729233683013026264806076",
+			"1654806774558560978350082606883489436628379535257346078063478260232950936001",
+			"5299015818929677169233319120340539340265058652234073886970067026596732756240",
+			"7772032344557055789473144533013363290423925670310807731772582414686518246983",
+			"3781965444202188790948716527662927985928671682325757229996041227860932259893",
+			"3345484155609065046923654366504419685132775997107976310100855047314629537945",
+			"886945731469951937230186365026270061024133910511162189208804592908807421134",
+			"6735354038022791413411799972681524306841369414753589465401652145649893222116",
+			"1935117914710639506839948974155446287660212703897621867518465397455687997317",
+			"855836548574080114601916891505984130878216928022975612750979799120091434536",
+			"6541068365002563151205073625711854613364910696189682088601091986677675751677",
+			"3498203401466608248029771452067242050946831044174273574437971472156132555545",
+			"5113365157282840449327935310535501855639019267017306230851155734274025341646",
+			"291100427574399729560780717351972080591539393086517336307028577912593013831",
+			"4951010832572423033521238427671762783591568531387570598358269339024866827335",
+			"6935368986020846114707416514328424867573175695145493395077868865185617799391",
+			"4421971408527793575481957827895041934289426777376804824177340219920981801657",
+			"4784361067181816247789889520295070061832726789026477505365383939286519144408",
+			"6902379648170088933617436373897616173595882356130186403791413056270227800502",
+			"6890311521046371152310648533652283251426783710484642145760005912415621394712",
+			"3300582426830885345413237962331861645988116189322590437878618036229655228428",
+			"5090277775105731415197015754947566338715568795794847919279947457422265443449",
+			"396921746521892469461557335185976382781853380654969258411823431243806455688",
+			"8384501355732847401218401102526488908702450320580023201077894184976420556785",
+			"4764574141621557660053903705971467036411372552800329876657296245830060443992",
+			"7786943704826706588463473337942405786044210321706142706927435732999415767183",
+			"1817473587524440089144895391903436778798535173783973332406851648812698487555",
+			"199768027164126325197499205773349092784092142502602563137991122024578099815",
+			"5592784808658901953566444970229204675934909742023516487415562778712500171029",
+			"7620340343787977874067631290920104590169271224142858442645657016104413457398",
+			"4531502143772496384774062845794045410365749164589015318655428240554926597995",
+			"4993305698422614856289671053519926031361089612904479987286491040391712482429",
+			"1890101427505160393147728749480064034516466297677175077112633380526566236058",
+			"5777281016333369264986610261188346396030534666250672641152082126185804749740",
+			"7839221969235308534078159764872000639821536123875603912702299684145204380160",
+			"3205057772845384833329206892928677349487498971289086382710347717915882818387",
+			"8119267339349926842126372240333651422996583825113253720424775804781521986078",
+			"3541274357438770457278740081604948896951607173096751567588339188240343587595",
+			"290757239501256464281974319850268179358662536223305681014142855521000605129",
+			"3071795883807429601760312489207584712202767955806390155178335149304952398825",
+			"2376618124397754632570020367749950740904737073526939363500757591197899617122",
+			"206798018418970104427289899440162500428696692501901429830471906604011912270",
+			"4755391599331725804664984944827163791721202205735446451644543027687031821412",
+			"825885866173484121307436671573108094221492254598722927119615006712194124911",
+			"2554638268213382787236481747062958843950147861737617670383068148152942457822",
+			"2846332734288761728092533071935216073869730549808584348860720441846764249060",
+			"8140056372785622325821510019216173231544546860946218530108241178917141036125",
+			"3708661993392803182560096909194707704900572251782516388035351978778086482595",
+			"5462469894352895012387443184076510991117644168796409935593089390964595954058",
+			"756893933329149216114995933232110216914946913119357650801068709290047034518",
+			"2447945711614149209913513234270533470460209666560096392318685246536968254472",
+			"1134198837651298699993639361275019476115730882147922184917166090565013467508",
+			"1755288660598099185052035115110542043284452173265064594131632137624805738180",
+			"510538634282373743904146284199874486762693661809757989711020777647075293075",
+			"826966302105312470674240268858124246133696705864093299788439721923379016305",
+			"1750809347796748589511123598922662511635446465703935272886403540563467330233",
+			"3626991385382608566768761890341220044016693242780076470174738941265485425314",
+			"360348584317309909985270834673815135682631720292975971545336619603481745762",
+			"4564839267288916786501013466229926140412093800603133844524552065387906801716",
+			"6731962821686325580491822590807595591516010492426925869422925491550114043563",
+			"5884996843015305279328027270671228828244349412683455539628045212132549103434",
+			"5189699219035443729248026969423575778754558635620920805212526651474704902578",
+			"5325976490193957822927622455671647646603185177010209181965411453981815963049",
+			"8189755814353767680068199430929447851704481078623723570153513525669822406097",
+			"6157055685635155061816282753616307622315156952439123462858148972106996453338",
+			"4201443354832317957691366223622197686387205675733895050729606292298190689853",
+			"849597153897117936797701767259263904590444676970302608546075483402824391328",
+			"2945366299097873820253575618779513867059321098836127479959682115946982771917",
+			"7946653222569265397764318698413928169499651050649268009911222688601500390672",
+			"3955061461222581068601244439388826478669033795790473128916746329755786289284",
+			"194902351476461639988128064826878155874357885737577568105691315784520420076",
+			"2054875403271951293619717373098091903658609846623347825919751394462777938388",
+			"6441120904147472372550187739425848354244468105458500059042779636280801202457",
+			"4856788126042824068308010187982525046239888485345219768329006082892068367665",
+			"291355279724374270206817442744099818390821254539005941893927823600431090853",
+			"1987858570061722835269997391515207714420109886745501944587207694341545573745",
+			"3213696719405985950289957594066603035440198598621404524099958706191996916055",
+			"3005981521615064443184046401148647754512179755748924576301732266415319201297",
+			"2621411314517739021870228316134584284694091320967256272368122750867976114943",
+			"7044578135885591725348038581874780601165738393226558075855473709253694858765",
+			"3003220922495241282655735413996630729519553770972180584442298080972720748264",
+			"7723261051877303220236858567809477006874896024734639539531973748526330317016",
+			"6404320974745578378302587290517107323819782685010985251583355344679861736033",
+			"5473811960341434292420779879705750079331871157096848113243437846687173829015",
+			"3489362154903554568885782086499864957754221063165434871466496609450654757851",
+			"5738878929953126406493977001869762275333317480749038212477224999749019607973",
+			"248384180902759531389147295685029971514643266708326177840910974710306996426",
+			"5448949389711611701165263801434301259910541121459696467135117978579062982902",
+			"2568952643386068771964897899771681909344031860769927373561450745675519140028",
+			"3670604557663154805581972806834034192285098072136928082384930398916206366631",
+			"3879288298425577583449478309021200331439459668761362199264042221912999932605",
+			"2516761260434817086752098107305443628592766938470739054153150146506703792471",
+			"481359630814196002065503484532281005658851213692155491829138154224186751948",
+			"2019987491733925540129962876580261242273467964980586095277968008478003510520",
+			"5502277878902047247504174581984405311788850074338907672427435023620684656285",
+			"3133867741450637227416157482193433460720476157087105946893409719860592365407",
+			"8400900706408490632518330034988512166255356777660834664898578191621637827580",
+			"4424355663759696797083117401116579382210148092564273386055340689619958180062",
+			"38181968798540411178433004489330464377413440568580946670698824537964234740",
+			"3985137582625427870914337711862008955159518565586436026102511255896022545247",
+			"2686597946680903067963389814411594898511296256126226466543512771762628518380",
+			"3912072963699923538135335881783459711445809699833034464235046836295051637157",
+			"3768948985107031263554991350035396579658231033668291305113893407107999590132",
+			"7209840459207478084934132990507426086496015722161589774417691869050002492357",
+			"6470232135949884401983050741535994050038392389090501339898509456281439988861",
+			"1403782228835667896108593023720179504199984149882443104282945790037828677887",
+			"2616692368426935727956976017730416940577328529933157623691062413188068132680",
+			"6013155465106742700343824998420749144547684411124338994627587197137858317900",
+			"5046057668721457926386883951512447209843468155520782995797315336934626297875",
+			"4699213848989418709226497398340240243077331984560588410432125105042384834910",
+			"5229593958715996992063094437959528963051688396408152717883918637659886098311",
+			"1951976044069223056158754588030180422847727493829048238776399717813979759789",
+			"4158688722225908788153206659322854682351209416161193960155253054969298046740",
+			"6729156920345996334287429817597914498081658415518610887789376041974889047771",
+			"8001080793346807220307430636768987640386922596264252165255164305011067417921",
+			"805049615247355128632817272390362068051853601888800837662896676244202957816",
+			"6954697886862123377467715908414695350592349300176823211514354407092860264474",
+			"1037357063158990576124983865369468459708807060409999263024817001922664598244",
+			"6274173843835872888849453577522195998097492776857839215359837309285307683859",
+			"3829234834194025101976400998836257325560624583979963442125678498831465993617",
+			"2634048471413514276664575681345205309356785859870536213890046907527525143930",
+		},
+		mds: []string{
+			"7037051457856975353540687448984622109479916112628386523279361213264507699201",
+			"7238110070938603220784707090384182741179342287274911852515914390786350776321",
+			"7388904030749824121217721821433853214953911918259805849443329273927733084161",
+			"4691367638571316902360458299323081406319944075085591015519574142176338466134",
+			"7600015574485533381823942444903391878238309401638657445141710110325668315137",
+			"2303035022571373752067861346940421781284336182314744680345972760704747974284",
+			"7238110070938603220784707090384182741179342287274911852515914390786350776321",
+			"7388904030749824121217721821433853214953911918259805849443329273927733084161",
+			"4691367638571316902360458299323081406319944075085591015519574142176338466134",
+			"7600015574485533381823942444903391878238309401638657445141710110325668315137",
+			"2303035022571373752067861346940421781284336182314744680345972760704747974284",
+			"7740756603642672888894756193883084320427907723891225175607297334590958469121",
+			"7388904030749824121217721821433853214953911918259805849443329273927733084161",
+			"4691367638571316902360458299323081406319944075085591015519574142176338466134",
+			"7600015574485533381823942444903391878238309401638657445141710110325668315137",
+			"2303035022571373752067861346940421781284336182314744680345972760704747974284",
+			"7740756603642672888894756193883084320427907723891225175607297334590958469121",
+			"7794887768703111160845069174259889105885445540142212764247907805462223912961",
+			"4691367638571316902360458299323081406319944075085591015519574142176338466134",
+			"7600015574485533381823942444903391878238309401638657445141710110325668315137",
+			"2303035022571373752067861346940421781284336182314744680345972760704747974284",
+			"7740756603642672888894756193883084320427907723891225175607297334590958469121",
+			"7794887768703111160845069174259889105885445540142212764247907805462223912961",
+			"7841285910183486822516766014582864636277620811214487840225573923351880007681",
+			"7600015574485533381823942444903391878238309401638657445141710110325668315137",
+			"2303035022571373752067861346940421781284336182314744680345972760704747974284",
+			"7740756603642672888894756193883084320427907723891225175607297334590958469121",
+			"7794887768703111160845069174259889105885445540142212764247907805462223912961",
+			"7841285910183486822516766014582864636277620811214487840225573923351880007681",
+			"7881497632799812395965569942862776762617506046143792906072884558856248623105",
+			"2303035022571373752067861346940421781284336182314744680345972760704747974284",
+			"7740756603642672888894756193883084320427907723891225175607297334590958469121",
+			"7794887768703111160845069174259889105885445540142212764247907805462223912961",
+			"7841285910183486822516766014582864636277620811214487840225573923351880007681",
+			"7881497632799812395965569942862776762617506046143792906072884558856248623105",
+			"7916682890089097272733273380107699873164905626706934838689281364922571161601",
+		},
+	},
+	6: {
+		fullRounds:    8,
+		partialRounds: 31,
+		alpha:         17,
+		arc: []string{
+			"3267179889377925711574658284856732208025355841040084473551461173520230225381",
+			"4218970795463228197327790213244921451375713052676530804173314273248521302251",
+			"6558452507257297773903880599933734956650828395452896303007568859251089292382",
+			"4042547271226656281806908526176747617793733742101121262910694724314042815131",
+			"4484826253675472206510819347554710738378387223810894155307581506069733612810",
+			"3340623787106332728769891522629193546852430705194948956506410107893436206270",
+			"1146818540713897566188401511637220499025428484560997690844890982000538808551",
+			"6089924376626192718256476404089056274813344438059373355846067526383524489588",
+			"3252380459144218718661502728554356451451872089835332429937606714939592080537",
+			"7116956469008263816717247030121065061393433609954531462053914466227296297033",
+			"2749312272215231848139078131254509267239590195342230643928479893117354148584",
+			"764882704567509245651596837424209709274533314828826071535700095515278197679",
+			"4932281286580045638368979604177064776138370306619513783360199241458597325911",
+			"6484320961953933323289746320919349728579597325051701151281300835155595148740",
+			"6682606457331543519450813892692923338913286820264911373641321435621952784002",
+			"6625780871179973490233386959951589849631496582163936124259260687776843181994",
+			"7949225290500346406153571792115001941134688057907882657603244062341345143557",
+			"6392844449548821017972820206673777102358896581926384483680180004397700038029",
+			"3392678655944756762416562388041708502386246415055289087688321666480356102352",
+			"1482525589417638728292358287918496174732548433885907700008322735579133317611",
+			"3098572574557576897289665910879403912332252678168883273111538708481992361738",
+			"5529056592387037922896478601046572040782992911318540751019232411511652047969",
+			"3748164104379232094417838431717964236407138591232998705172215282549268275626",
+			"7993215220832663678736550799426833055975676440107252656509024603970443366873",
+			"5554706095086365018531517384733334573065210312805294463584717615914523071948",
+			"2443631285780523173220046820359095033333463124538739189832478296145755085550",
+			"1220796011041198056582991284907417463980599290206522252014247996281492269358",
+			"1682760707303747404170762352671876629726555466723959746802386893894433719465",
+			"3051923729738325569700743140016346599799580971673827402271729235767984866691",
+			"2306114475196942948885846961345874495553950366022761412913304148926205742794",
+			"7746531378288971852126267511442677698173623354735027172401259811680773677501",
+			"4834348935899900190962719656110102273565189568342358560766997905456677506493",
+			"4121477957201856855020021268777282556154316264943378279859646884279956546625",
+			"5575807550520296672911438114601856894098726849257140030239298020170971947928",
+			"3682128341703072221204143569718024537702786243388400094766498270296969014264",
+			"7679831197978575465040991913842964145832157435230444452299494544524271515522",
+			"6224324156159351316973219159779237306960681933357110721696536378511625557820",
+			"6716972566783841934337221569574810873921873374075667326370709901646642238214",
+			"331863125818962027849727084710128595901952559887141675460236475296575670268",
+			"4663158656903340398961997518330483675433576645796066939978101537393126236287",
+			"1780987428986694219663159184569680255824138046786566932338476969603773193909",
+			"6555911628267551041055141725443469778285906923105440033741455960911326872840",
+			"809372144904751477263627950613192967177236230076728989515509424384305487889",
+			"7305902313317020177507153076171625792448911923503154116802216557394756122090",
+			"5268822999707269827407339715973554572570612616129733347318036728572184654948",
+			"3018506590499702332012032512257843792606071542944543063407346349775575179477",
+			"3292293347930095499689375056317626925489770555588338556704334208279999009786",
+			"7278948590638525441055673811145598489392128201468045545432013785450263812202",
+			"2651219506834522135247663906152585680699730347040704708795695819433536460159",
+			"4049871798415892850292053159369322239639717064217267206769239087430652128521",
+			"1070872611186538390812558975137728519255174942814423256791469831355363156942",
+			"7517075790327820458034096818201755116436064837902052505878372331077772865173",
+			"7744854507826397265628488800096049691937910230067727866432530786480132897330",
+			"5054997164098946349027235669636048371962424483708460159918560587069489460743",
+			"4123593762906798031355294874781146632153575444108905647909680676460963021500",
+			"642555179756975070792262146698437285785700252794598311698444854576082921449",
+			"1474201646769061795637139543552299140787447911392614122151581681723780827175",
+			"4212568704852508283980453597704490038956121060474692240185258403772331644477",
+			"6397288324036196424314380852844673578090610085948353686890058637783411223794",
+			"3926625536493244336520729985316719395802482408018379865607766101821321257466",
+			"6494276335913220607535921507434608972757919719702229797506445903210927716283",
+			"5320470266033494993746366608444378391394670338412382615589469413046126557288",
+			"6117686116131219682710944574172802707239969160152183376211631059358916379367",
+			"1472192601751717226475542483856590637092751471639236684263555043424557298110",
+			"3407374614860915726110082446680235615472399192552972898066705560664452864268",
+			"7097632494384239686225722363540947688115013970248135296850172001025685517676",
+			"405555215387397080358792030691448762321362110444583662742586152878306772781",
+			"2797246277136220424988367220099402498943986192066681298035435469455971365355",
+			"1301969262102706030379171132712694989707633665827297255503262456004474105482",
+			"1397623280922117755063539725076974056510922107015670278700003585831367272689",
+			"1621864990000588473764758637554032692163114006449898027981531408954257926569",
+			"5523069392877897519069194937670134918409922376855507214149728762101988295862",
+			"3128262740207889923593854580055755244674436906871019049380300833517963919899",
+			"5880577997328292695218951482160938378283909233099231240404059253367011774188",
+			"938018438906400457609104987429229825715503710544925730807846597879752026051",
+			"8180648363546978340378424820545183381887654241356952735017082989972282004843",
+			"973565803135161559341796479689996390462685801950645731002550676231356949773",
+			"1266322597086896596428679773021416432928623248766812760920353696031870525214",
+			"4481040487734984444390892104405593343014990582427406918878370674372783757640",
+			"2287309951912776067683878730494659441403705886007744344532565229041269989541",
+			"2510919603508448594054884582441093851279046517977406102988740412366783170982",
+			"4153419277329403751434099480264221438776930418380474238290776206005522222645",
+			"362519893513163783662695449327103940664483218608553039161240083947454524050",
+			"6787390779957107456205989918649340550821341848611172474005536139005002048707",
+			"3650144587367896813545891920584818718516339938095004817230865581614784032053",
+			"2935036940547088635724369295933694282647643967108863416789005387769458625751",
+			"6492293215708535938147712003242471403132870788285177400412319174948027025505",
+			"6295287253346450493341974493956279052104329349296295200760155236847312860073",
+			"2084101461936596601273317584125565752189208365795350140462022289030055037096",
+			"4977410808115603748307945445485222689287172153224405441544470603301428334134",
+			"2344874562161422983209423908460315191420241481992907912428584304998035118004",
+			"1239264108942923639382961610696195205010295393263578757939750849042317505694",
+			"2846462673800452908561358693893424081687331873586928116695468457020866844148",
+			"4998710939870529508437520796881236042643404905471423422130413918470467627114",
+			"1934815681475082223595638619789141370487567616982162160120672729382453188351",
+			"7228165027186431524071955942612119086518194519147361973264148553454917900033",
+			"4016166966365973011618037409323422154344251456962319920844284414914034315238",
+			"7546006907780092520254550596647283724078529297292645883247661304496683447550",
+			"1399477873854976905939132841850266306150030196502298772795760414705392805491",
+			"1636394793218559052913610540712274482920561638105294188116175896049821206934",
+			"1324058075072012235434487435432475167138038307020697692107109639449221355210",
+			"2280741586349055747506042609895124770561131703291773597606167229292714672283",
+			"8342642686801067599151745804543073218431430128510511854097519427228830935902",
+			"5804872450137030484881222816375011671611891409172767315903836302547355567211",
+			"1722975888377867150377754546007358852321683900371859149617847398983042108699",
+			"3844785829542385783596485235685426296560729005822502996342136441867443806644",
+			"7865524733672471629545807438655769994905653621084293741534228698704915032305",
+			"4604870400118197846627410365227003116095051732843106288070444355142171193697",
+			"7396663240102246685326983722783599910069406483521352376003066677682593551529",
+			"2562251907048161610505002011941872579272307640845060634968151143665985063641",
+			"1549967745873012439728796357319537856510341052605362255447810692239409569126",
+			"2675361235318267782852831143842463470129323681745345795892150710073625490024",
+			"5952515328531662133506278749908523813168406237456441333231348726158737827993",
+			"765149058941865036128057932286357225650850527698629692287260337544263580183",
+			"5107861549007149521309417064185782159016437664360561322217461449735749521355",
+			"6054811289965100918685675875806076772391117764616285251043014274951360940458",
+			"3021150257892221823371145623069280544210802519558821573046255803434215307106",
+			"2349951148273884555754223610972718476313734727994753347922140275202789759113",
+			"7464741757222950146597728692311078353722285050789484130848122040799839804081",
+			"6143440374630397589785524239524890437846196407245378580042786079554917100025",
+			"8108869199046970195135784965120398051817520859906072836232954830507497472406",
+			"4543330615312325891995206146091963393605906906268134526982123268349972979568",
+			"2416699185013214620616032235584891462719848166930738211630142953290398760570",
+			"6422588454652037573796395198458204568192948027316281885057781958698635703784",
+			"2753176512591911295642849883163821239600979873944474999642751847294836125734",
+			"2084242927899601214521889885294931755978686991565116968491943007020511139147",
+			"2073403215075963274916333121594353434738627172574846372799465748874465646086",
+			"805727462485829947460569158211183759487011967387029548079796392777127663278",
+			"7644302733498043178859808207462153600958948633629480017304790743683189989149",
+			"1055919130198517540677260002949855933313800140168942055706866400774505675317",
+			"1270807549011244426622360195030010445777199224103252981978753484750810535001",
+			"3062638832815882182814299737629784444387312083771780182189407511192704930223",
+			"3250605218683250291625962462113654738367568191068479927550634735093675105684",
+			"7195689585449903644060107748428768993814054351515763868878127631552001026484",
+			"5463684701783637297023776329763188568955181914914150040480647661825126078805",
+			"3461741402412621094453736054184632951503469520278303384994481336947474824068",
+			"1825640719952814107102672700227871159174928756902824776781836379445075820336",
+			"2601613051444961803779204735149397391414858191088745010671304265692474042879",
+			"4635969281058658188207006058860837118753838882447160244887269391138702554407",
+			"128997914215362395420238092910764569274900897937939764490088963171545037935",
+			"1712146771380151054166812188936377224268107361353746408400546416401991093887",
+			"4228629634182564023134680984877751386044679584931632240991276197848924809923",
+			"5035047909538639326991442481480201165188624039344297682104370694535201375906",
+			"5103741083485721500723163060345084352286937901681930969012118407941812832628",
+			"5412640154510948062250753766003593471086045830019769477921622649572758290662",
+			"2405236901114815911147993145659583854598923748830673527384107282217535511842",
+			"5566370615867273353620724182178190374785514908721339249649586264478907881961",
+			"4027744204203615381480770314335107290237478652684941731297832925030615680486",
+			"6523936250787347355860458330629003743651200477397862558009991269206545113984",
+			"6161686116234237281161352475441616341443248794869437839681572835745959074548",
+			"7525575876743208897110620505884260332655833460843082825897622700976946482511",
+			"2237115972267978312675963521166233128471504215495146335397047992085352245814",
+			"5554537721437364039978862510773636297073784845524305938815624627527759212546",
+			"1131366061396525831286555020799942358342052981232713177886534891207722108832",
+			"4158553907476826357661996188932265121778048152400351572575015534666147504197",
+			"17291503353027079924384639322443786353644223754131148087952592790142765953",
+			"4397212047494248946146852690590744203216326878971468465379745990495475437080",
+			"7949173868582810488858730663334200738606901324929895429004423503793821632462",
+			"6215459342559560592187451304936304076944923986964941923799070745053426079642",
+			"4977440247830475722238149494554019042533943518099077511620681665001222436026",
+			"1779517902117469124113708415314398895793325142433203050379855378860591465901",
+			"1229476860669761756739374745317572569061568435911189046597965584762230263405",
+			"1206217883105746168409894675089263079747174441121864564981192169579319775140",
+			"668483225958754803422145467337449657507124472229215338363158498570144089070",
+			"8018593627904594737743996851069758892988858792719921037072184291504879074839",
+			"3097974004402447269527481357369894882867485227134964809919158640069796229244",
+			"6276251394932785315058947331559117228863052088611714658027864001977607355634",
+			"555157249776195994243133409895546885838817035421047469555273421939070131758",
+			"2253983755027777744446994494595394425023580055676366069172288601186472131593",
+			"5480270040441175242185972267659433587152906298166249721977047210293679189253",
+			"1501266407699423757633551540657886078948465047196058532929756167119070835283",
+			"6797535251191422788515387886057930780181051375445398590377107465128629258406",
+			"2410941480083866070559027300608645459741000163583004540996868884902668865858",
+			"1018566537460601455328115662216312963813925705698652520831854517210712109109",
+			"488786294241410388472809950053197107818795454083949867713246321756215692899",
+			"7722288006991271497149153298287616418813651114986036699272102593544332009488",
+			"2702345857074801038143192167202209111713156214492914703918944916809593697353",
+			"8275767366974083682922883722457100803977644313140884358110266464189724809815",
+			"7488421391296753451684892427964653636746495938417703324788663703515172705174",
+			"5011282101735262556600567688696705956364600100832095301130729311304936508599",
+			"2039161627862584880582888476628667644939736950155958101998515338704689103824",
+			"5965312308601731980104502870666258098491921582177160592030785741105323126137",
+			"6786147106206644543457961626731860588522029844679633868356093418275596964557",
+			"1605969801093612748158968935200204013845304331084157759240264951960851130521",
+			"3308495084830203288654798084517860939546944110847646553718688840783831023357",
+			"2304743572883697875845502324127187583081549252425459886030329897652180384036",
+			"5053417863143098266797397829076327321004836486860601550827330324811543775148",
+			"1675473735979033097123386334847156269681794557828238529484799470552225496137",
+			"2538157987899153070608977361610885936892455639778313040440089948575929591565",
+			"4948398789369353954337623145831916828353787132851990877645952029711902020296",
+			"6509805435878007802995395018106302549412314131803604852450780880595405984596",
+			"7040418453499481250448760178064445230449991478933641106912031883139318665246",
+			"7524831420484976071546904290033422473044384203280286266421365652166844983530",
+			"1389065656052332336786507198030494076633531096787803558400643683126275991587",
+			"7334277487252239082136841592734559841523488242116900563357252923726780819366",
+			"3220452037809119927291396378526660895790658398338636419237195342728474722926",
+			"2120958141785025555786988853446248372777455545071936982907568222534167917603",
+			"2046365321313950536784835305340390884196460437114581964521058265228142267748",
+			"4166070780259213324434433673427335156400462888169220287495172888761445278512",
+			"37448246733393365744439618142361611474131350746404852396478650806384235476",
+			"5066722662302357886694573395748819304180873031511933349751045589118051645384",
+			"8327008435126539448188216676070465661064681825560792258838135930928453596426",
+			"4126570936530606761552005116597648851221954548140776575366907901442481766240",
+			"2028599072563441598805340146983867902499209957772160137276005300150334111247",
+			"5492957911255821366974039414762376663316392680836056511321374570785040180490",
+			"5020498257377109227360618249542544949930229710187954095428553305398665775901",
+			"2252630548150556956680527121375469588106147747615475242476933730099408444682",
+			"7396677961238332186675955003292154481064857263001980517838630741126598313090",
+			"6725677987493295852696979506928516045154122184920259849807312201468108103021",
+			"1206359698294544036763360509088334857107693279934542380940889091295128090396",
+			"1090722283218218775472717466212814567315311824192500303613486537818957988813",
+			"4424974608315390535058154170976181286352444334315751158893690742064082684329",
+			"2503229292079826210103565525568283454571358589977885542137643537897451000469",
+			"151572031617036280958609617848937531067149659852080773360494521974119403314",
+			"7516224108274259455231609500125023273547005538038056166730717572682409757715",
+			"4344383705122555518091078149951116336723976647809750724518701471416796902283",
+			"2824023219149555903961443341842900177548959113459941561410262147693965931528",
+			"4634028328191934175614802722381059035705370841891448293086970276006956193156",
+			"2611240062937291166447085564269954738286850618471577865235963570865155739996",
+			"7363270328574318967120591373131512017815542399499973348227212494088321794708",
+			"8013799771208258129893075767577404816947984333135154678609227276232471999020",
+			"5044716502087801967806354858998409546955627036251833922200547389536706344268",
+			"4196070695349536502063197465323363626105684126022957408228735638757755528197",
+			"4622854284014393527351955824917406410362905247807106315987602360603655240879",
+			"788090736677799874230790145926853966465102354464240070942045526762063669154",
+			"4402627153697217910832669133492815480105350792694609175719573022112801001051",
+			"2012019704378814790366429813720542390744363343380156080331524639822604627555",
+			"5857191983966235070006491166849102594312083180294916349019608402906838822636",
+			"3767250396658320261180385235930425394211692167694341092584034642903525266399",
+			"1246677100122056393160190289384915942913903553035636523507102727672559151072",
+			"1532261696895114662547020765504208022098763838254524526221882062432517308451",
+			"3808732515606142777355391895027880381618676506069900187942407587064290794242",
+			"1353623476693847455479948941601601547113046326418692919598595779268402998581",
+			"4568034412324271719121325064468070213724889167849650919135763419284902226766",
+			"7526510426616559056662572333389144971303046771067900617866542671167191231461",
+			"1984090496354770469005916597848116103497584047469610223736896795951346117351",
+			"5541331787863042853282779497076079033660627728184507336806134271359483726681",
+			"1123848900066418393565590218457318554330813861593937062840629257573991618437",
+			"7119595159662415994152114190752555708079664776543939176828711488509346925273",
+			"4402771748854939356784761249919895162348707751181116782165024772616961372683",
+			"1238265437447562740111980649669713181292200355777789670554101826300760772353",
+			"2348497862084264554632767212774488376307766499049237756254491391649565468261",
+			"5261453224553581615160311404160249386402808097114542597496885462964979654036",
+			"5263827589381544755339163957749628136213310351975965597342349209572693870177",
+			"3560060697657925012926708814205229707654047798512692267374898529520880562411",
+			"2373941392307013801383592832488158577752891975962531227890579346355584846451",
+			"1961718924193031296798751690610974386828264605504889890771349325463025336195",
+			"2868124060985675920436079106596156284905943204632191942776718861373436542214",
+			"7845669997742840217688401931462742306009286446506113818599869436449377367754",
+			"6173407037353888350243445875635080301447444707547969387574700153706191518628",
+			"7098672234934132886939827569385283896802454444867206042797084120044836023173",
+			"5351899839977801032921348451916620591965842541634687391321577183284412726675",
+			"5620070496211129683300134579883602187317512502813714244130767313773035697719",
+			"6979709989426911001555340276783571500889381472387839399740190281565272894942",
+			"5135679336879799934417470005708054630248446694753779453216003661110099588738",
+			"138682133719722492420465554190750590837692301151943132427693112118115423576",
+			"3151429238189687747957628376177893410569754087843736384164577812731670460702",
+			"121814244732221808057679271483647003115742086673430961846079912667911157285",
+			"8371534681354007650628559426369748872592875254339532946571231278729041076560",
+			"3224012810937385216119822993567522300423926201642509833393672779197095195785",
+			"6906061554583095104670274977270927222784149562340469632678517151372610188027",
+			"7241632034261962184770214288273863345887509924743840645741009488606102609959",
+			"1777064018030106946519734883392052222472885181372642200849135017704677431530",
+			"8324217430862472098854213825386877833955599293369985729017003228770288665572",
+			"5729830751513293833514450194921789300271973437013945551402502294027950049854",
+			"2187758249046601765496974874050556156501868988788103300613705176170900124540",
+			"3661666483638282285384645460120103900291506320035168165941968172560553131692",
+			"71172694711798875087336054947012411069704167761224496888177661616309271064",
+			"5479753513109529765878513587350339376225541758759421668496911367601412633643",
+			"6657339706920780308636317262842926498794391181237998790979567359048507409879",
+			"2844473401317966605062077825184314014360268580545792284786608033783639709289",
+			"5533028151199355284748909539327941418386476750051202689820514906007980206539",
+			"2147788020290210661458158885081344432970895350439383460330782349760173328255",
+		},
+		mds: []string{
+			"7238110070938603220784707090384182741179342287274911852515914390786350776321",
+			"7388904030749824121217721821433853214953911918259805849443329273927733084161",
+			"4691367638571316902360458299323081406319944075085591015519574142176338466134",
+			"7600015574485533381823942444903391878238309401638657445141710110325668315137",
+			"2303035022571373752067861346940421781284336182314744680345972760704747974284",
+			"7740756603642672888894756193883084320427907723891225175607297334590958469121",
+			"7794887768703111160845069174259889105885445540142212764247907805462223912961",
+			"7388904030749824121217721821433853214953911918259805849443329273927733084161",
+			"4691367638571316902360458299323081406319944075085591015519574142176338466134",
+			"7600015574485533381823942444903391878238309401638657445141710110325668315137",
+			"2303035022571373752067861346940421781284336182314744680345972760704747974284",
+			"7740756603642672888894756193883084320427907723891225175607297334590958469121",
+			"7794887768703111160845069174259889105885445540142212764247907805462223912961",
+			"7841285910183486822516766014582864636277620811214487840225573923351880007681",
+			"4691367638571316902360458299323081406319944075085591015519574142176338466134",
+			"7600015574485533381823942444903391878238309401638657445141710110325668315137",
+			"2303035022571373752067861346940421781284336182314744680345972760704747974284",
+			"7740756603642672888894756193883084320427907723891225175607297334590958469121",
+			"7794887768703111160845069174259889105885445540142212764247907805462223912961",
+			"7841285910183486822516766014582864636277620811214487840225573923351880007681",
+			"7881497632799812395965569942862776762617506046143792906072884558856248623105",
+			"7600015574485533381823942444903391878238309401638657445141710110325668315137",
+			"2303035022571373752067861346940421781284336182314744680345972760704747974284",
+			"7740756603642672888894756193883084320427907723891225175607297334590958469121",
+			"7794887768703111160845069174259889105885445540142212764247907805462223912961",
+			"7841285910183486822516766014582864636277620811214487840225573923351880007681",
+			"7881497632799812395965569942862776762617506046143792906072884558856248623105",
+			"7916682890089097272733273380107699873164905626706934838689281364922571161601",
+			"2303035022571373752067861346940421781284336182314744680345972760704747974284",
+			"7740756603642672888894756193883084320427907723891225175607297334590958469121",
+			"7794887768703111160845069174259889105885445540142212764247907805462223912961",
+			"7841285910183486822516766014582864636277620811214487840225573923351880007681",
+			"7881497632799812395965569942862776762617506046143792906072884558856248623105",
+			"7916682890089097272733273380107699873164905626706934838689281364922571161601",
+			"5464063484924239686278651430976294814419699569805570712193386353828911860556",
+			"7740756603642672888894756193883084320427907723891225175607297334590958469121",
+			"7794887768703111160845069174259889105885445540142212764247907805462223912961",
+			"7841285910183486822516766014582864636277620811214487840225573923351880007681",
+			"7881497632799812395965569942862776762617506046143792906072884558856248623105",
+			"7916682890089097272733273380107699873164905626706934838689281364922571161601",
+			"5464063484924239686278651430976294814419699569805570712193386353828911860556",
+			"2345683819285658451180229149661540703159972037542795507759787071088169233067",
+			"7794887768703111160845069174259889105885445540142212764247907805462223912961",
+			"7841285910183486822516766014582864636277620811214487840225573923351880007681",
+			"7881497632799812395965569942862776762617506046143792906072884558856248623105",
+			"7916682890089097272733273380107699873164905626706934838689281364922571161601",
+			"5464063484924239686278651430976294814419699569805570712193386353828911860556",
+			"2345683819285658451180229149661540703159972037542795507759787071088169233067",
+			"5777789618029937658696564431797900258309825860894885777008317627732964216186",
+		},
+	},
+	7: {
+		fullRounds:    8,
+		partialRounds: 31,
+		alpha:         17,
+		arc: []string{
+			"8272449376473671765296008869302038521165312284059868547350729170495609308478",
+			"4148638154552987643427927719929617623033282419205047107576105870710636474516",
+			"4610941420378965310285838612448425703694278187368438665958951036771382090951",
+			"4189826392798972095615428009133315511437859427098777387981389252767021216956",
+			"3485778653302006026931053379631767402100167194043813051334318884790009606746",
+			"6997258294599821948176438428013771474496501637817875041649844838098869986336",
+			"4727315047627304951694923894487815521672122955066864851590793419420877448838",
+			"8323215308029035830922479600341609342422153730357233252273677915504737315769",
+			"5637158139100131712830467651283357933314259485688016797833552952753722230574",
+			"108652494334494423537470670066593093589367691856837736916781504579141415881",
+			"4282654110357871098442082930601101276537207779575238008006989562579070025596",
+			"4983986459778055581371939303652208870578018890190948885506698845403601706443",
+			"7812942429443051975589393178689314192901424482402091373617863947800041031380",
+			"41843404634003821257499508246384846359567653827738009587074364821357556674",
+			"3256367381652958574022221764563986866005281747176483523562910892352385700536",
+			"5081754897888927450572755485400127827962724388364782188548629761403223168208",
+			"3140662389046800714717134038842932929914881789234256624133712658493702862986",
+			"5837678370345291060707921053934078274570658514314699024317340256646194510315",
+			"1758249205614064761923397241362751226590619464566451174621990840706075711533",
+			"2996424966666775948197322316539263332388876501081218038331262962889143767323",
+			"5569073755001022846727381324947502843907951568584887846136479111303763947571",
+			"2152697470307011874759937686919219515105381348752365467161409416739810705705",
+			"2547478791649003851472374459274423878735641266844974068366653679604458605992",
+			"5819954505684844235667713476731504359909606655725958006022735818802065644880",
+			"2946723954639143698985169687050778926763705251783882355884017269111316721156",
+			"6674853737187547917453936531282787623469969017096699291523892007866852923446",
+			"5128641242163000635309430370963825992485844601443450009390057810268350954415",
+			"7352715836632872274247822067257986059394276266477211651891582858684335942428",
+			"6217878791689924076142875699523164926319528131418439289154670832402836449354",
+			"7597096949831323643078864071580889261097889487612663379874935302362723251908",
+			"237095701117524079797711198067928714601748963673162707716983647008814265398",
+			"81542366906477747415301649039728726195369083982626300684546056109880517297",
+			"7969630902758093833683878012450239872858160201838806304585343230952248744893",
+			"7316377572719821258453016885761550936433699619018709722118975759663969037436",
+			"3207827889377644662547841949258701640846910482047864903394795911204521625717",
+			"6413940547842866445385786420255165709131889744069745080884072064648094675572",
+			"4470034231322139393244401663130930843870170862250053073895724359300231030494",
+			"5985167286477158071662871046181300194905193138887251008228776699334161724649",
+			"4452904839488101491301112737515512023767891716505993453839350251808627474304",
+			"7860895867021231156914226137129456862426923892375642308547029895248541661553",
+			"6630686865763006697479097101020958994896044128307934854628091355832658931207",
+			"4575237871949309158131429656894197089450967121612001149478762082600131288476",
+			"766939745831065248189916028819932463734747817275069322853142547284848119411",
+			"2521363578568164921332548196959960367230186196038809440843174398050883733227",
+			"2233887665030634678034714762840607924432402341879481284411152084913926243816",
+			"1363145196169027166624365704741212262168466737920409271006019942527593579043",
+			"1728748828489523871369663443259576335445953252043747674734851978163284572347",
+			"3589764404206988851048143499419191311969029478266107959684865529873240501288",
+			"454582061846842940537911725193464848400438118719936897433155053207150006163",
+			"6097880848523255981783663207288828946664464748659437953833943301002070708011",
+			"6606494069007891346510409957417828767102023178333954862905901017371032576703",
+			"4107615390589339392820548815643444727694000077684088530907935118807954536569",
+			"1003918606062413360733488776720819899764655273788616417267649862117087160569",
+			"4967481022167399504714248462864334298293877052822767813298305699437148004423",
+			"7505807054377625488069618334595947210934444806903660876361926590185586442625",
+			"2937235658203904617712096065495213327167588881165918700771977595840739785540",
+			"3743920883448077512632614147297013095277830755810197805164568256886272231293",
+			"4675256943483187667445429177126032505760078656735578452557765669667886631950",
+			"457954792243890278352137958612420889090804294568854585984572595286485729090",
+			"853849747687579901321157781987563742960717711795759914963161381658119818700",
+			"8222541276506899231460690122857140339276358286108222152936800844854572097455",
+			"7438406943200357502672401610848443965649812236955334945447954905769718300513",
+			"2640696199554128576427187811969038868579739879509924283967463389130356425075",
+			"2655652588635129222042210269984915965810480642384939386497721975915298799897",
+			"3217782621522243223548045738370772617329805040816918825611164431186047161493",
+			"947337883334508960509785476955419483097351316459470904704471240368983130317",
+			"6893498773873549648445806320954175770417825628673932018859169967779061669525",
+			"3549512494769711136877093045046220046555277099241366676305738541088437234800",
+			"188595022227591198772700874154043466570753818796304743231710175490064810553",
+			"7926280734182825119014941098863511068008726025720447818037681597094028288687",
+			"5328823006174239298836209859004707742902620901161932353646613955574632849830",
+			"3643132442411531550762707210036054181111950121816801222793446946975671426944",
+			"223811283886641278441633783763730335821391676025225789605640185401047180328",
+			"8105880403956334966170035890059708655437042366031673977270600203028614932716",
+			"7291355104533494809155393706541735791575586647249043086018796878886658168102",
+			"7611701800471868248724290484349188144353618836337988703536700402080844390332",
+			"1436786789990417548761477983450497180735471335332880597317434589805856427771",
+			"7600085433595855824996872008078098226260886015198064396437327207276917121720",
+			"5029449320917522052289391657447529701127506383746674724200291418366055244112",
+			"6886825880948667116018395022681308947452993793907932102687592989125186041774",
+			"5845241369239092051204667517323930061829070088554172171792110724198606401412",
+			"4162725373069625128551984577973151037992554335378296833000957757364090719940",
+			"3237246111767816396368458173267299399557492443072947364702415679925636571187",
+			"830701079037121106433992803311368087413846981956837462414498630581651793402",
+			"2196364324569118898404019786967816611441428716833788431451140967866871605803",
+			"6753922090288340120668448254869264195438903583210381863317254582876604059215",
+			"3278166685154456830901506486886389406639525283727907954935200636096028010850",
+			"5786959252098271000208967629797263716944656467823397231149097680939487648632",
+			"1908697537089514501783359248626973918401305040507758964257539878861345830490",
+			"5623482144375131023825905339360417675361217284375813015987496790143762179693",
+			"4069696247470142907808851317942521531222429930330519094286917875239205096382",
+			"7473708033898226549502377310715405001631633226016676732225225246104006304064",
+			"7968097074364896190426873307203920680578508830659346047441212030754294890547",
+			"2750027194169860594301948270681671971768983322995676262915003574138222334293",
+			"5926992445108463225458163957180508831154768105404460564971380259827882483888",
+			"8236286391698466571493128303242200865463018961214038675236970119480621222788",
+			"8334780562761233153941257145079302303490120745177090320723723636435406468801",
+			"949719520023679378768851557518699756924522256471503862479759499601220369534",
+			"5372483877960489505551208473749141362207477310379540533072313319778275921752",
+			"5749187993829590544609286084993648337986047815732954868582710978731469866982",
+			"4566976547402342111696723933810183167604073795281476956095621435462238669144",
+			"3258609544217448574198621491388339190182925542526479883374007068796823160057",
+			"1877893633883368995460699521630970116669587569933569267883836518571897778215",
+			"5946567171632824236333963154051878983043994432907168064027273967134014341427",
+			"105931664511336149957584997375530129929108061604718816766850425337966483819",
+			"3334436208623913462800910444002593149657843770356076722012842774818858193788",
+			"2542525398722164928349556023984418941288183915531914678385788069164453572484",
+			"4325729638094798167888033500432941197696802068677268118169676790569961279556",
+			"5086221959524628151988325986854153595750538180228152044781036556066799929135",
+			"814053785175139316093814632246124669245448274155786076572025583537106284178",
+			"856559262938027158334811547682069668183819901434802027583664868534912374762",
+			"7098002372386064108546794749664794680069755615559518896946628869991990938200",
+			"3692175837436518301650623692887323065336827487996896962549174591686028336225",
+			"1007582158016437529205780524083115117449712152139569389821288332592983438016",
+			"2847781526242572782394249062992590975075600946998798020950451429876955455347",
+			"4683135938877485667218909295587020784482020063366509400878909769039963402194",
+			"3071223860731265748299223217826645386000956812064841287233076908524780113711",
+			"465336005424349322459410135527270587484143218066190391405764495653890307638",
+			"3492521239902589258215993550954350302878198081888018022034188315933417282349",
+			"4652503015596864532936839963102524688315689115828904201661779672404133596246",
+			"6684493595002463532648655455829581530070483011366463933884714421685168062108",
+			"5144815691459069012290739686961880509034734568672359075047700851046282192984",
+			"8371739448006008802462855315414529155151374415405948579037680330595176885289",
+			"7562265460651226242904474681477097810256092178931499606342797263210024328912",
+			"2173115990036758502424877991024231517600619158057524800212336697746053937701",
+			"3250667032841248111949415525977085654656554385364739778848554685344763670402",
+			"964319544077259865638943155040799869001767127798523470355645153608731787358",
+			"2430625397940759018828403325697234910709308356345530717148345000606821958076",
+			"3001535549948948017968051344910179825469022106767548021637053637992914172619",
+			"5315142258465964331422831407649081078928730784491878179959989170251813258669",
+			"965329642546226660858115602501751516967460844522828532063227378062843450560",
+			"5401374926816885496189951921489312093848246583610832737254098205111592584029",
+			"4589429967151333056465198809951652750181579966978928736484472069481903686820",
+			"3458261861557917590305563421230461439522295470636639786838452072955923791588",
+			"3540986098747695482229933888622145098982183585657249365327909297854697622982",
+			"7470309027079141098627625264292527283122575469971006686552366285421096253077",
+			"2179322880286147185818971352290290535398107814740820178158943851622770567223",
+			"2089024225624099915154884013014820565635001045727245004973844249532877227137",
+			"3745196152859075670103663786165296848991734820226608733576975765763784214197",
+			"5672117491393732267161232737901625070111283663814490501970587252984671104420",
+			"5535101561762086991739090706628408222278165295627513352446657788234735178470",
+			"4440412540504162202198729017209308004949607304407749784221311782337858576119",
+			"3359457225420955510670545823689885262117252893127204615784464590776623693182",
+			"7718700411772965015689480507781316141517838375440370022460377848627123433547",
+			"2715335394270831764022982421053388805904453348572352072511789215823634657140",
+			"2305394127892986712263611635091515963372045710527019930680959161755991581436",
+			"2104972175624447794213330228917071364628371857711624604067665770479050713317",
+			"4990408798088167893471930814242652526162152619546041242615151653990184623175",
+			"3546351782556761966634238365300793530101766032734247847631348153218054433710",
+			"6941379896394926157279545496034560776204185627880417585133134949693486894967",
+			"3765682351607970622474687645495718516809053317470313795212873610836687492641",
+			"1207533003481997657312293689910907907236139141655579992322510033847857304531",
+			"2367608536896159625655496931554378270669809712765549804935381107581639335217",
+			"6740684757519218497781122306839073844591800192518192807966040074697494708307",
+			"4825078264829092308849020398835091230150958669789654848183245719996217810228",
+			"757849457700891267639946788002659929760787593114261537886517453442631326884",
+			"7617772044426127670284892056579815733041062017845578841457124144997188700609",
+			"6126914317054895618513724602591620020763139973453381199031734626160282424363",
+			"7309843634867779812093280088790495003505753384616149220486277128321443360023",
+			"1259045021150840298617452894914942995550334738680554788989111034227499491886",
+			"6908852663530609931869772676856572326587751586644415647416984824204508461868",
+			"2671163502279357383610023212372912845574427645391569743630053050134656449368",
+			"2253946543527456223564501046546653180581332524942313474306216527047916362566",
+			"2030100334146239207771413242471735260650620721144598972699602321723136370634",
+			"40136492577474487082578317934995153095444291472418348784208268307823576963",
+			"1328502896116213008216090269243206830476875304891514589862470805735960197505",
+			"2175457100448366043468948306824876374571255415169204367917523352636059523500",
+			"848096714151848638058077696231402045438976521686198724753397956243263361176",
+			"7326707703146548191924309921234510923213429298978752715858856593375670975031",
+			"8020848049439135363046795113229123697335011439425849583690097857796354293406",
+			"2872066176395073354270436839808457995283183903908368639216624738139270132084",
+			"5471198344191077510316915787799643927338870830479949711570028837725727564729",
+			"1741430244738961672359734090272574360220271561693335033986668762944253150357",
+			"4356718847916159090890175332029871781666686525313324801485121290210350977313",
+			"1915825281181304681717438772665664008551415142216399579957772298761588670131",
+			"6752507498248911914258494537015947822770496022652738004954334046021268179431",
+			"6435500822045476885664457278250354078183208240173088455313671699589064210926",
+			"4005655958479828597482874865200570219717330877601858610297703126495951848191",
+			"7603056894886664726937737797763939814260275998741045549962283638102561816924",
+			"7795000395210164850220878606140677113277107373288537208497722455538220318806",
+			"917320595292638661667403842817932900132962299959363554479605980634588307616",
+			"6195953597262272816204513727519160769187324292780523758506326479711653329716",
+			"5210320134011615244811769147852947156213832233893904017659562469491212996451",
+			"2678514272744198115243461877272315308662882251029646710852224841033352683757",
+			"8245480591264354451287811907600289397193312401022996926386207111592224163474",
+			"1810723795729939140285130542608913912106146186624803481958058125368137246958",
+			"6934231245879731726925076918123133287631381869999919632026827777187779792677",
+			"590834790443507840356941266970275491247008371407676094240923921500838927945",
+			"3878921643809799253821622216886490966257939494370483252296976611002702558042",
+			"928826120010035496569791931286940620206504111619818440942374788925304449363",
+			"6725026018629510451281875456543760311503078154949570854941233701799070311778",
+			"3003148202782596396359186535120522667375370570161108490841163463175802317848",
+			"1886891004374730958071000580978109068595840002475804123014204342684111463015",
+			"4565805385814231959782079505107795930916475365536886260229957711935491849452",
+			"1211834574858376502921448977740611760260468466113172479096577881268438497754",
+			"6245508252456431611061613652416050411030580391326361212667451154702429416845",
+			"829315491499023305058712884431039396396667828840054776463716212478411575752",
+			"7538445340263150076248828200494071745257103202342849297416972546781664247095",
+			"5643327396007828505091548576410746339436977397667960710498393093284201075199",
+			"7515779657260457854721203868528633163235381684326685705752702650452559910840",
+			"7452702100554733045412057646117433044694697722837077538785827886899132485977",
+			"4553783823481622598308870589366435852853680348100846921212856662869809684516",
+			"582929454544911278140721152001621380689152904415578510371086119231524052122",
+			"2284335772357371554233517681079027956753057991678774936927402945058752085321",
+			"5456684552687898964128826005037487511571662299569174067002182911602835717397",
+			"2002766771156843817402660103013565137257774523956112626124274058994616233711",
+			"2312028605300893937127988713572164770169393391336467553404005721687095966100",
+			"7429303438479759992240463746909874635268332144238861549000030011997194127215",
+			"3106223239863153611616263771405196091523360216634991325659058227193347801900",
+			"6666946274405723279700227546539310587325390124439913891132320173241483283276",
+			"4676739466967224175622289159690926752476707108222464504599081378960389643604",
+			"5842607952834968017287120965908363015129772426718623461132697578633585777835",
+			"5364783892198285595872026636595901526366350468040516432385252101459015607425",
+			"3485071970907145275247724645416736271673602860278751978873660448943580044221",
+			"2798375185753767667864026874563050421569326201523512568962672770491907455655",
+			"2414417638752144032012037528494000192657497689382278627035876594592511005075",
+			"661987636789252083679799885930108147461569289914044898496917133723045450092",
+			"5687593546992634801859329177869530088715688782465673628312854308203741896917",
+			"7224382516678941224777121759643483563668065974824311819278532059088473345148",
+			"431324183660472307669367902329073117234054304078961028486928758152864434759",
+			"4518877277533199499328766120518460996085100690609686158177602172519906068797",
+			"5391835477134703796034069251540723745522041339998688727108117190445638218851",
+			"3084131590160580774264885819879705586133663474490831704510266745422724996982",
+			"7779069091452917819020830713067226165449989015518979911433840971527131208460",
+			"185039770821595137236804133069099322985998472494483272069999744789889748848",
+			"8029477893257488211042037110746440704171814734977854419426564734945403648225",
+			"3768811872439582740294129582266604115776257432703540992101255817181729546568",
+			"1482701443747440584653760022259498129978952597727550260447685354298247805065",
+			"6940725841548915128089313173093112642668367371612971137422813583883070977419",
+			"7498036603151279444684091327644632652332501180713944745737503727104975850454",
+			"4485051050305917836897380943903236575176223122801326374563206647816422053464",
+			"2862270592035779624634242584383423114075858614411294290698966342579552164222",
+			"1647597539230627465120566478976349420540412563694969080667082579863039057373",
+			"2670201293323303124920879729977856964926310351640714430891874568696667805337",
+			"3944874080431099508600740397660159104644491848586265845399439481741594893428",
+			"7441608756273399065608904743120755272296171834839220814870650164707179356912",
+			"6448147535752588404685046340000155603472743418043360747756219986387781466729",
+			"5246883906182231937623153878741265494276942057768692645745562548865838158227",
+			"4146044029517896137506086049777462016160932079767861805167428658152269564679",
+			"4856783742090289027612628795887617911978474669313962818839010919653628981233",
+			"2994890752665575333204004982104058531275290219946751520581554652298499133699",
+			"2449052342444801026698260247824121080288486515536555296023314187125749374667",
+			"2998786513251520792110140149737413892348621005345980678472512625605781277269",
+			"660277759815832118079627194170579158731511263502134490252450512640490622254",
+			"7602834738389654410189879303443257302829142924668683337951052496876613659845",
+			"4340254507665859492530130696279616022801890966228654788432228468846874201375",
+			"5538783359704656375901433745566656948139511044612264529704996966790826021331",
+			"3740488357066247880091047485243143039778583067909431245989521589582403638447",
+			"6444251413257263526126290780550542774216406844238753461960215028723949862696",
+			"7341641947663484844328043515343729807909123936609985775023717633635987610112",
+			"954055219472603312910258066170353018500350168365512755779257151877511630849",
+			"621979679398477706810119322235034055302387926836012978866753665488872010166",
+			"3045972520082664782397200265123298357095533988312257765706650537831607944165",
+			"3774523491402165283426529523346257893701036791463548019014538571630832704683",
+			"2633833230646472413009451168674544903622103194742856911343971423451860020388",
+			"2372068134128172337438976361288541510866738140480909090174334164945407149325",
+			"4819747858487835465383178000494913565381678619979030845813448322856621588130",
+			"8147948816888556558788092973070747332316476606228906915149695608802272561318",
+			"1970454002571994669100590431760352850990506694744060999685808298178024455964",
+			"2382858340760720645193645833728214361901466798856555408619241315953704006287",
+			"4540589684254621896005473622215466361161492265388117654076724327951184932003",
+			"5890930679685294775127431961519672035001620239007610895722648188666415313360",
+			"4865100902944156473127085391446401225646462288726828322536464280533200989062",
+			"3640872870152505776282378684709027479987537052878181719999535648429006990754",
+			"4779523772891030661115866801947819205976229759777229507696949668063262418709",
+			"365885605870642222320559055492012121319541489302160518999844827775852861569",
+			"1071971747185306581402948225183571120598245219902341532930085617181119603325",
+			"6856531985325327376182983118412466978838692044064549776560719026899179236755",
+			"3041505653817756266549815698332245539498299392746043138164441503445649195214",
+			"1648538485556134432618529454438366597771911820623077423559278747670707945349",
+			"8021843423576337942812993729691463285991026345526291427970076858087072972681",
+			"3507873657708557599838709925109001352680898474781609934600305570106363693891",
+			"3075383093881241495899353144182411167741769296334327341278851133385063141014",
+			"8347930078798039471049340625814038199459634608701298651893809115795742304550",
+			"4956438466327574269145554000595944930223480515490309938282011695704748745643",
+			"2154123169732422630171543118390041433815790688868035745995458312417851614105",
+			"471581645724928297880218751701571715649648075005561136173031649772147644664",
+			"6282973807228559347678931248652784570495288746294696867286034565521376625120",
+			"1416024922195333694909038858996253797782585494758699299469010179303933407535",
+			"2536786345769540458457197026434576201843040132602962654330301529321277853807",
+			"3625346994346262101074016662525684274846352014684613212636681749029860414438",
+			"8185154950738214151796515968928351126603905297125790874608920063796767252690",
+			"2091359350151991805321734262591896664272488333838196693958572789838473427626",
+			"7893870176223799684038550109973360297326111269737078480578958224089785457116",
+			"1520182496293084184060350659186894159187633793202146327512504615309868073244",
+			"986343632237534315729765358105004123719914801088741895575181866570497964221",
+			"4097141299559832108681797482282499886552681964557682291595867549941946001900",
+			"5170162657752733961617316579862704251015678496124961523018864855203991590574",
+			"7493448755702210572189375484947729156648010297427762392736281250135251611888",
+			"6306473423076606291527527393606984727052936248884714392394987865264933460998",
+			"6490159471621292220891426718270011645908869111376473944426770950932725435316",
+			"3085590823906807640982299920152736395554307120994537085809023375753886005214",
+			"6470210152105334455344254379422681911310598808426785938835085698000538958774",
+			"6384336895500659645759952084976852819294861590593737217260679303514486658147",
+			"5403908794555626454891599520460723503768107553937985694373405266900803378169",
+			"7491312003383848086366979202793200051175563793929611119984170139909207668912",
+			"4387144195323867178748400107405487976710661311964448504266174388350850987894",
+			"2359754701244196043720426483051454843104685842583792338379012975172349507848",
+			"6267415440471529358165929206436078810779767091328742668461372203706996320269",
+			"244265221148857592230117779251126109093672259705074593425726854244432431537",
+			"3339783573831930710228181302359655715657861147157281612383276198708893187560",
+			"4616762454119578616027399475977737765223162792543279667476807359876684539357",
+			"2701067941287335451337695832217251573222436646292125046249999856859643981490",
+			"5393196336119855744824571655674412475633706505827284947563193413492438488691",
+			"6796573436449302551523083955724739045751503643681750413111371067794789878597",
+			"1717871245275641451442602910236392322516037306074008675881250178168072663141",
+			"2525541969317989804751812899254922410702247459776828808500461785313548593449",
+			"4393406080224759831407943487747233497883289715389419134393180407785819428816",
+			"759271099303622493097265875295304471216619404471506323635435779228707599323",
+			"6657858624373344153040139320987988391335408962732827923838403731161871088770",
+			"6939038849898671885316533183739266254229700214001815157826574893807635000524",
+			"2956062250044031925588211089300332661152138256628933421957907397358544574740",
+		},
+		mds: []string{
+			"7388904030749824121217721821433853214953911918259805849443329273927733084161",
+			"4691367638571316902360458299323081406319944075085591015519574142176338466134",
+			"7600015574485533381823942444903391878238309401638657445141710110325668315137",
+			"2303035022571373752067861346940421781284336182314744680345972760704747974284",
+			"7740756603642672888894756193883084320427907723891225175607297334590958469121",
+			"7794887768703111160845069174259889105885445540142212764247907805462223912961",
+			"7841285910183486822516766014582864636277620811214487840225573923351880007681",
+			"7881497632799812395965569942862776762617506046143792906072884558856248623105",
+			"4691367638571316902360458299323081406319944075085591015519574142176338466134",
+			"7600015574485533381823942444903391878238309401638657445141710110325668315137",
+			"2303035022571373752067861346940421781284336182314744680345972760704747974284",
+			"7740756603642672888894756193883084320427907723891225175607297334590958469121",
+			"7794887768703111160845069174259889105885445540142212764247907805462223912961",
+			"7841285910183486822516766014582864636277620811214487840225573923351880007681",
+			"7881497632799812395965569942862776762617506046143792906072884558856248623105",
+			"7916682890089097272733273380107699873164905626706934838689281364922571161601",
+			"7600015574485533381823942444903391878238309401638657445141710110325668315137",
+			"2303035022571373752067861346940421781284336182314744680345972760704747974284",
+			"7740756603642672888894756193883084320427907723891225175607297334590958469121",
+			"7794887768703111160845069174259889105885445540142212764247907805462223912961",
+			"7841285910183486822516766014582864636277620811214487840225573923351880007681",
+			"7881497632799812395965569942862776762617506046143792906072884558856248623105",
+			"7916682890089097272733273380107699873164905626706934838689281364922571161601",
+			"5464063484924239686278651430976294814419699569805570712193386353828911860556",
+			"2303035022571373752067861346940421781284336182314744680345972760704747974284",
+			"7740756603642672888894756193883084320427907723891225175607297334590958469121",
+			"7794887768703111160845069174259889105885445540142212764247907805462223912961",
+			"7841285910183486822516766014582864636277620811214487840225573923351880007681",
+			"7881497632799812395965569942862776762617506046143792906072884558856248623105",
+			"7916682890089097272733273380107699873164905626706934838689281364922571161601",
+			"5464063484924239686278651430976294814419699569805570712193386353828911860556",
+			"2345683819285658451180229149661540703159972037542795507759787071088169233067",
+			"7740756603642672888894756193883084320427907723891225175607297334590958469121",
+			"7794887768703111160845069174259889105885445540142212764247907805462223912961",
+			"7841285910183486822516766014582864636277620811214487840225573923351880007681",
+			"7881497632799812395965569942862776762617506046143792906072884558856248623105",
+			"7916682890089097272733273380107699873164905626706934838689281364922571161601",
+			"5464063484924239686278651430976294814419699569805570712193386353828911860556",
+			"2345683819285658451180229149661540703159972037542795507759787071088169233067",
+			"5777789618029937658696564431797900258309825860894885777008317627732964216186",
+			"7794887768703111160845069174259889105885445540142212764247907805462223912961",
+			"7841285910183486822516766014582864636277620811214487840225573923351880007681",
+			"7881497632799812395965569942862776762617506046143792906072884558856248623105",
+			"7916682890089097272733273380107699873164905626706934838689281364922571161601",
+			"5464063484924239686278651430976294814419699569805570712193386353828911860556",
+			"2345683819285658451180229149661540703159972037542795507759787071088169233067",
+			"5777789618029937658696564431797900258309825860894885777008317627732964216186",
+			"8022238661956951903036383691842469204807104368396360636538471783121538777089",
+			"7841285910183486822516766014582864636277620811214487840225573923351880007681",
+			"7881497632799812395965569942862776762617506046143792906072884558856248623105",
+			"7916682890089097272733273380107699873164905626706934838689281364922571161601",
+			"5464063484924239686278651430976294814419699569805570712193386353828911860556",
+			"2345683819285658451180229149661540703159972037542795507759787071088169233067",
+			"5777789618029937658696564431797900258309825860894885777008317627732964216186",
+			"8022238661956951903036383691842469204807104368396360636538471783121538777089",
+			"8042344523265114689760785655982425267977046985861013169462127100873723084801",
+			"7881497632799812395965569942862776762617506046143792906072884558856248623105",
+			"7916682890089097272733273380107699873164905626706934838689281364922571161601",
+			"5464063484924239686278651430976294814419699569805570712193386353828911860556",
+			"2345683819285658451180229149661540703159972037542795507759787071088169233067",
+			"5777789618029937658696564431797900258309825860894885777008317627732964216186",
+			"8022238661956951903036383691842469204807104368396360636538471783121538777089",
+			"8042344523265114689760785655982425267977046985861013169462127100873723084801",
+			"1151517511285686876033930673470210890642168091157372340172986380352373987142",
+		},
+	},
+}
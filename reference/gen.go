@@ -0,0 +1,53 @@
+//go:build ignore
+
+// gen.go writes constants.go, the plain Poseidon377 constants as decimal strings, from
+// internal/params. Run it with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"math/big"
+	"os"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+
+	"github.com/vocdoni/poseidon377/internal/params"
+)
+
+func main() {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen.go. DO NOT EDIT.\n\npackage reference\n\n")
+	b.WriteString("type rawParameters struct {\n\tfullRounds, partialRounds int\n\talpha uint\n\tarc, mds []string\n}\n\n")
+	b.WriteString("// constants holds the plain round constants (row-major by round) and MDS matrix (row-major)\n// of every rate.\n")
+	b.WriteString("var constants = map[int]rawParameters{\n")
+	for rate := 1; rate <= len(params.AllParameters); rate++ {
+		p := params.AllParameters[rate]
+		if p.Alpha.Inverse {
+			log.Fatalf("rate %d: inverse alpha", rate)
+		}
+		fmt.Fprintf(&b, "%d: {\nfullRounds: %d,\npartialRounds: %d,\nalpha: %d,\n", rate, p.FullRounds, p.PartialRounds, p.Alpha.Exponent)
+		writeDecimals(&b, "arc", p.Arc)
+		writeDecimals(&b, "mds", p.MDS)
+		b.WriteString("},\n")
+	}
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("constants.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func writeDecimals(b *bytes.Buffer, name string, es []fr.Element) {
+	fmt.Fprintf(b, "%s: []string{\n", name)
+	for i := range es {
+		fmt.Fprintf(b, "%q,\n", es[i].BigInt(new(big.Int)).String())
+	}
+	b.WriteString("},\n")
+}
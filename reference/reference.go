@@ -0,0 +1,194 @@
+// Package reference is a slow, self-contained implementation of Poseidon377 for auditing and for
+// checking ports to other languages.
+//
+// It depends only on math/big and uses the plain (non-optimized) round constants and MDS matrix
+// with the textbook round structure from the Poseidon paper: every round adds a full row of round
+// constants, applies the S-box x^alpha (to every limb in full rounds, to state[0] in partial
+// rounds) and multiplies the state by the MDS matrix. It computes the same function as the
+// optimized poseidon377 package, which the tests check, but is written to be read rather than
+// to be fast.
+//
+// Values are *big.Int and are reduced modulo r on input, so non-canonical encodings are accepted
+// as in fr.Element.SetBigInt. Results are always in [0, r).
+//
+//go:generate go run gen.go
+package reference
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// modulus is r, the BLS12-377 scalar field order.
+const modulus = "8444461749428370424248824938781546531375899335154063827935233455917409239041"
+
+const (
+	// MaxRate is the largest rate with a parameter set.
+	MaxRate = 7
+	// MaxMultiHashInputs is the largest input count accepted by MultiHash and MultiHashV2.
+	MaxMultiHashInputs = 256
+	// lengthLabel is the label of the capacity of the MultiHashV2 root-capacity hash.
+	lengthLabel = "poseidon377.multihash.v2"
+)
+
+// Modulus returns r, the BLS12-377 scalar field order.
+func Modulus() *big.Int {
+	r, _ := new(big.Int).SetString(modulus, 10)
+	return r
+}
+
+// Parameters is a Poseidon instance in textbook form.
+type Parameters struct {
+	Rate          int
+	Width         int // Rate + 1; state[0] is the capacity
+	FullRounds    int
+	PartialRounds int
+	Alpha         uint
+
+	// ARC has one row of Width round constants per round.
+	ARC [][]*big.Int
+	// MDS is the Width x Width mixing matrix; the linear layer computes state' = MDS * state.
+	MDS [][]*big.Int
+}
+
+// ParametersFor returns a fresh copy of the parameter set for rate 1..MaxRate.
+func ParametersFor(rate int) (*Parameters, error) {
+	c, ok := constants[rate]
+	if !ok {
+		return nil, fmt.Errorf("reference: unsupported rate %d", rate)
+	}
+	width := rate + 1
+	p := &Parameters{
+		Rate:          rate,
+		Width:         width,
+		FullRounds:    c.fullRounds,
+		PartialRounds: c.partialRounds,
+		Alpha:         c.alpha,
+	}
+	arc := parseAll(c.arc)
+	for r := 0; r < c.fullRounds+c.partialRounds; r++ {
+		p.ARC = append(p.ARC, arc[r*width:(r+1)*width])
+	}
+	mds := parseAll(c.mds)
+	for i := 0; i < width; i++ {
+		p.MDS = append(p.MDS, mds[i*width:(i+1)*width])
+	}
+	return p, nil
+}
+
+func parseAll(decimals []string) []*big.Int {
+	out := make([]*big.Int, len(decimals))
+	for i, s := range decimals {
+		v, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			panic(fmt.Sprintf("reference: bad constant %q", s))
+		}
+		out[i] = v
+	}
+	return out
+}
+
+// Permute returns the permutation of state, which must have Width elements. The input is not
+// modified.
+func (p *Parameters) Permute(state []*big.Int) ([]*big.Int, error) {
+	if len(state) != p.Width {
+		return nil, fmt.Errorf("reference: state has %d elements, want %d", len(state), p.Width)
+	}
+	r := Modulus()
+	alpha := new(big.Int).SetUint64(uint64(p.Alpha))
+
+	s := make([]*big.Int, p.Width)
+	for i := range state {
+		s[i] = new(big.Int).Mod(state[i], r)
+	}
+
+	half := p.FullRounds / 2
+	for round := 0; round < p.FullRounds+p.PartialRounds; round++ {
+		// AddRoundConstants.
+		for i := range s {
+			s[i].Add(s[i], p.ARC[round][i]).Mod(s[i], r)
+		}
+		// SubWords: all limbs in the first and last half of the full rounds, state[0] otherwise.
+		full := round < half || round >= half+p.PartialRounds
+		for i := range s {
+			if full || i == 0 {
+				s[i].Exp(s[i], alpha, r)
+			}
+		}
+		// MixLayer.
+		next := make([]*big.Int, p.Width)
+		for i := range next {
+			next[i] = new(big.Int)
+			for j := range s {
+				next[i].Add(next[i], new(big.Int).Mul(p.MDS[i][j], s[j]))
+			}
+			next[i].Mod(next[i], r)
+		}
+		s = next
+	}
+	return s, nil
+}
+
+// Hash permutes [domain, inputs...] with the parameter set for len(inputs) and returns state[1].
+func Hash(domain *big.Int, inputs ...*big.Int) (*big.Int, error) {
+	p, err := ParametersFor(len(inputs))
+	if err != nil {
+		return nil, err
+	}
+	state := append([]*big.Int{domain}, inputs...)
+	out, err := p.Permute(state)
+	if err != nil {
+		return nil, err
+	}
+	return out[1], nil
+}
+
+// MultiHash hashes up to MaxMultiHashInputs inputs as a tree: each level is split into chunks of
+// MaxRate (the last one possibly shorter) hashed under domain, until at most MaxRate values are
+// left, which are hashed once more under domain.
+func MultiHash(domain *big.Int, inputs ...*big.Int) (*big.Int, error) {
+	return multiHash(domain, domain, inputs)
+}
+
+// MultiHashV2 is MultiHash with the root chunk hashed under
+// Hash(DomainFromLEBytes("poseidon377.multihash.v2"), domain, len(inputs)).
+func MultiHashV2(domain *big.Int, inputs ...*big.Int) (*big.Int, error) {
+	root, err := Hash(DomainFromLEBytes([]byte(lengthLabel)), domain, big.NewInt(int64(len(inputs))))
+	if err != nil {
+		return nil, err
+	}
+	return multiHash(domain, root, inputs)
+}
+
+func multiHash(domain, root *big.Int, inputs []*big.Int) (*big.Int, error) {
+	if len(inputs) == 0 {
+		return nil, fmt.Errorf("reference: need at least 1 input")
+	}
+	if len(inputs) > MaxMultiHashInputs {
+		return nil, fmt.Errorf("reference: too many inputs (%d > %d)", len(inputs), MaxMultiHashInputs)
+	}
+	level := inputs
+	for len(level) > MaxRate {
+		var next []*big.Int
+		for i := 0; i < len(level); i += MaxRate {
+			h, err := Hash(domain, level[i:min(i+MaxRate, len(level))]...)
+			if err != nil {
+				return nil, err
+			}
+			next = append(next, h)
+		}
+		level = next
+	}
+	return Hash(root, level...)
+}
+
+// DomainFromLEBytes interprets data as a little-endian integer reduced modulo r, as
+// decaf377::Fq::from_le_bytes_mod_order.
+func DomainFromLEBytes(data []byte) *big.Int {
+	be := make([]byte, len(data))
+	for i := range data {
+		be[len(data)-1-i] = data[i]
+	}
+	v := new(big.Int).SetBytes(be)
+	return v.Mod(v, Modulus())
+}
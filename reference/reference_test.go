@@ -0,0 +1,211 @@
+package reference_test
+
+import (
+	"math/big"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+
+	poseidon "github.com/vocdoni/poseidon377"
+	"github.com/vocdoni/poseidon377/internal/params"
+	"github.com/vocdoni/poseidon377/internal/testvectors"
+	"github.com/vocdoni/poseidon377/reference"
+)
+
+func parse(t *testing.T, s string) *big.Int {
+	t.Helper()
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		t.Fatalf("bad decimal %q", s)
+	}
+	return v
+}
+
+func bigs(es []fr.Element) []*big.Int {
+	out := make([]*big.Int, len(es))
+	for i := range es {
+		out[i] = es[i].BigInt(new(big.Int))
+	}
+	return out
+}
+
+func randomElements(rng *rand.Rand, n int) []fr.Element {
+	out := make([]fr.Element, n)
+	for i := range out {
+		out[i].SetBigInt(new(big.Int).Rand(rng, fr.Modulus()))
+	}
+	return out
+}
+
+func TestModulus(t *testing.T) {
+	if reference.Modulus().Cmp(fr.Modulus()) != 0 {
+		t.Fatalf("modulus %s, want %s", reference.Modulus(), fr.Modulus())
+	}
+}
+
+func TestConstantsMatchParams(t *testing.T) {
+	for rate := 1; rate <= reference.MaxRate; rate++ {
+		p, err := reference.ParametersFor(rate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := params.AllParameters[rate]
+		if p.Width != want.StateSize || p.FullRounds != want.FullRounds || p.PartialRounds != want.PartialRounds || p.Alpha != uint(want.Alpha.Exponent) {
+			t.Fatalf("rate %d: shape mismatch", rate)
+		}
+		arc := bigs(want.Arc)
+		for r, row := range p.ARC {
+			for i, c := range row {
+				if c.Cmp(arc[r*p.Width+i]) != 0 {
+					t.Fatalf("rate %d: arc[%d][%d] mismatch", rate, r, i)
+				}
+			}
+		}
+		mds := bigs(want.MDS)
+		for i, row := range p.MDS {
+			for j, c := range row {
+				if c.Cmp(mds[i*p.Width+j]) != 0 {
+					t.Fatalf("rate %d: mds[%d][%d] mismatch", rate, i, j)
+				}
+			}
+		}
+	}
+	if _, err := reference.ParametersFor(8); err == nil {
+		t.Fatal("expected an error for rate 8")
+	}
+}
+
+func TestHashMatchesNative(t *testing.T) {
+	rng := rand.New(rand.NewSource(43))
+	for rate := 1; rate <= reference.MaxRate; rate++ {
+		for i := 0; i < 4; i++ {
+			domain := randomElements(rng, 1)[0]
+			inputs := randomElements(rng, rate)
+			want, err := poseidon.Hash(domain, inputs...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := reference.Hash(domain.BigInt(new(big.Int)), bigs(inputs)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Cmp(want.BigInt(new(big.Int))) != 0 {
+				t.Fatalf("rate %d: reference %s, native %s", rate, got, want.BigInt(new(big.Int)))
+			}
+		}
+	}
+}
+
+func TestNonCanonicalInputs(t *testing.T) {
+	r := reference.Modulus()
+	one := big.NewInt(1)
+	want, err := reference.Hash(big.NewInt(5), one, big.NewInt(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := reference.Hash(new(big.Int).Add(r, big.NewInt(5)), new(big.Int).Add(r, one), new(big.Int).Sub(big.NewInt(2), r))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Cmp(want) != 0 {
+		t.Fatalf("unreduced inputs hash to %s, reduced to %s", got, want)
+	}
+	if one.Cmp(big.NewInt(1)) != 0 {
+		t.Fatal("Hash modified its input")
+	}
+}
+
+func TestVectors(t *testing.T) {
+	f, err := os.Open("../testdata/vectors.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	suite, err := testvectors.Load(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parse(t, suite.Modulus).Cmp(reference.Modulus()) != 0 {
+		t.Fatal("suite modulus mismatch")
+	}
+
+	groups := []struct {
+		name    string
+		vectors []testvectors.Vector
+		fn      func(*big.Int, ...*big.Int) (*big.Int, error)
+	}{
+		{"hash", suite.Hash, reference.Hash},
+		{"multihash", suite.MultiHash, reference.MultiHash},
+		{"multihash_v2", suite.MultiHashV2, reference.MultiHashV2},
+	}
+	for _, g := range groups {
+		for _, v := range g.vectors {
+			t.Run(g.name+"/"+v.Name, func(t *testing.T) {
+				domain := parse(t, v.Domain)
+				if v.DomainLabel != "" && reference.DomainFromLEBytes([]byte(v.DomainLabel)).Cmp(domain) != 0 {
+					t.Fatalf("domain of %q mismatch", v.DomainLabel)
+				}
+				inputs := make([]*big.Int, len(v.Inputs))
+				for i, s := range v.Inputs {
+					inputs[i] = parse(t, s)
+				}
+				got, err := g.fn(domain, inputs...)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if want := parse(t, v.Output); got.Cmp(want) != 0 {
+					t.Fatalf("got %s, want %s", got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestMultiHashMatchesNative(t *testing.T) {
+	rng := rand.New(rand.NewSource(44))
+	domain := poseidon.DomainFromLEBytes([]byte("reference"))
+	for _, n := range []int{1, 7, 8, 49, 50, 100} {
+		inputs := randomElements(rng, n)
+		want, err := poseidon.MultiHash(domain, inputs...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := reference.MultiHash(domain.BigInt(new(big.Int)), bigs(inputs)...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Cmp(want.BigInt(new(big.Int))) != 0 {
+			t.Fatalf("multihash %d: reference %s, native %s", n, got, want.BigInt(new(big.Int)))
+		}
+
+		wantV2, err := poseidon.MultiHashV2(domain, inputs...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gotV2, err := reference.MultiHashV2(domain.BigInt(new(big.Int)), bigs(inputs)...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gotV2.Cmp(wantV2.BigInt(new(big.Int))) != 0 {
+			t.Fatalf("multihash v2 %d: reference %s, native %s", n, gotV2, wantV2.BigInt(new(big.Int)))
+		}
+	}
+
+	for _, n := range []int{0, reference.MaxMultiHashInputs + 1} {
+		if _, err := reference.MultiHash(big.NewInt(0), make([]*big.Int, n)...); err == nil {
+			t.Fatalf("expected an error for %d inputs", n)
+		}
+	}
+}
+
+func TestPermuteRejectsWrongWidth(t *testing.T) {
+	p, err := reference.ParametersFor(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Permute(make([]*big.Int, 2)); err == nil {
+		t.Fatal("expected an error for a short state")
+	}
+}
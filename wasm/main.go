@@ -0,0 +1,109 @@
+//go:build js && wasm
+
+// Command wasm exposes the Go hasher to JavaScript as globalThis.poseidon377:
+//
+//	hash(inputs, domain)         Hash over 1..7 inputs
+//	multiHash(inputs, domain)    MultiHash over 1..256 inputs
+//	multiHashV2(inputs, domain)  MultiHashV2 over 1..256 inputs
+//	domainFromLEBytes(bytes)     DomainFromLEBytes of a Uint8Array
+//
+// Field elements are passed as decimal or 0x-prefixed hex strings in [0, r) and returned as
+// decimal strings. Errors are returned as Error values. The loader in poseidon377.mjs also accepts
+// numbers and bigints and turns errors into exceptions.
+//
+// Build with GOOS=js GOARCH=wasm go build, or tinygo build -target wasm. Only syscall/js is used;
+// TestNodeHarness and TestNodeHarnessTinyGo run the vector suite against both builds.
+package main
+
+import (
+	"fmt"
+	"math/big"
+	"syscall/js"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+
+	poseidon "github.com/vocdoni/poseidon377"
+)
+
+func main() {
+	api := js.Global().Get("Object").New()
+	api.Set("hash", hashFunc(poseidon.Hash))
+	api.Set("multiHash", hashFunc(poseidon.MultiHash))
+	api.Set("multiHashV2", hashFunc(poseidon.MultiHashV2))
+	api.Set("domainFromLEBytes", js.FuncOf(domainFromLEBytes))
+	js.Global().Set("poseidon377", api)
+	select {}
+}
+
+// hashFunc wraps a native hash as a JS function (inputs, domain) => string.
+func hashFunc(h func(fr.Element, ...fr.Element) (fr.Element, error)) js.Func {
+	return js.FuncOf(func(_ js.Value, args []js.Value) any {
+		if len(args) != 2 {
+			return jsError(fmt.Errorf("poseidon377: expected (inputs, domain), got %d arguments", len(args)))
+		}
+		inputs, err := parseArray(args[0])
+		if err != nil {
+			return jsError(err)
+		}
+		domain, err := parseValue(args[1])
+		if err != nil {
+			return jsError(fmt.Errorf("poseidon377: domain: %w", err))
+		}
+		out, err := h(domain, inputs...)
+		if err != nil {
+			return jsError(err)
+		}
+		return out.BigInt(new(big.Int)).String()
+	})
+}
+
+func domainFromLEBytes(_ js.Value, args []js.Value) any {
+	if len(args) != 1 || args[0].Type() != js.TypeObject || args[0].Get("length").Type() != js.TypeNumber {
+		return jsError(fmt.Errorf("poseidon377: expected a Uint8Array"))
+	}
+	data := make([]byte, args[0].Get("length").Int())
+	js.CopyBytesToGo(data, args[0])
+	d := poseidon.DomainFromLEBytes(data)
+	return d.BigInt(new(big.Int)).String()
+}
+
+func parseArray(v js.Value) ([]fr.Element, error) {
+	if !js.Global().Get("Array").Call("isArray", v).Bool() {
+		return nil, fmt.Errorf("poseidon377: inputs must be an array")
+	}
+	out := make([]fr.Element, v.Length())
+	for i := range out {
+		e, err := parseValue(v.Index(i))
+		if err != nil {
+			return nil, fmt.Errorf("poseidon377: input %d: %w", i, err)
+		}
+		out[i] = e
+	}
+	return out, nil
+}
+
+// parseValue accepts a decimal or 0x-prefixed hex string holding an integer in [0, r).
+func parseValue(v js.Value) (fr.Element, error) {
+	if v.Type() != js.TypeString {
+		return fr.Element{}, fmt.Errorf("expected a string, got %s", v.Type())
+	}
+	s := v.String()
+	base, digits := 10, s
+	if len(s) > 2 && (s[:2] == "0x" || s[:2] == "0X") {
+		base, digits = 16, s[2:]
+	}
+	bi, ok := new(big.Int).SetString(digits, base)
+	if !ok || bi.Sign() < 0 {
+		return fr.Element{}, fmt.Errorf("invalid value %q", s)
+	}
+	if bi.Cmp(fr.Modulus()) >= 0 {
+		return fr.Element{}, fmt.Errorf("value %q is not below the field modulus", s)
+	}
+	var e fr.Element
+	e.SetBigInt(bi)
+	return e, nil
+}
+
+func jsError(err error) js.Value {
+	return js.Global().Get("Error").New(err.Error())
+}
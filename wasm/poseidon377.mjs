@@ -0,0 +1,64 @@
+// Loader for the WebAssembly build of the Go hasher (see main.go).
+//
+// The wasm_exec.js shipped with the toolchain that built the module must be loaded first: it
+// defines globalThis.Go. Use $(go env GOROOT)/lib/wasm/wasm_exec.js for Go and
+// $(tinygo env TINYGOROOT)/targets/wasm_exec.js for TinyGo.
+
+function toField(x, what) {
+    switch (typeof x) {
+        case "string":
+            return x;
+        case "number":
+        case "bigint":
+            return x.toString();
+        default:
+            throw new TypeError(`poseidon377: ${what} must be a string, number or bigint`);
+    }
+}
+
+function unwrap(result) {
+    if (result instanceof Error) {
+        throw result;
+    }
+    return result;
+}
+
+function wrapHash(fn) {
+    return (inputs, domain = 0) => {
+        if (!Array.isArray(inputs)) {
+            throw new TypeError("poseidon377: inputs must be an array");
+        }
+        return unwrap(fn(inputs.map((x, i) => toField(x, `input ${i}`)), toField(domain, "domain")));
+    };
+}
+
+// instantiate runs the module (bytes, a Response or a compiled WebAssembly.Module) and returns
+// { hash, multiHash, multiHashV2, domainFromLEBytes }. Field elements are returned as decimal
+// strings.
+export async function instantiate(source) {
+    if (typeof globalThis.Go !== "function") {
+        throw new Error("poseidon377: load wasm_exec.js before instantiating the module");
+    }
+    const go = new globalThis.Go();
+    let instance;
+    if (source instanceof WebAssembly.Module) {
+        instance = await WebAssembly.instantiate(source, go.importObject);
+    } else if (typeof Response !== "undefined" && source instanceof Response) {
+        ({ instance } = await WebAssembly.instantiateStreaming(source, go.importObject));
+    } else {
+        ({ instance } = await WebAssembly.instantiate(source, go.importObject));
+    }
+    // main registers globalThis.poseidon377 and then blocks, so run returns control here with the
+    // API in place.
+    go.run(instance);
+    const api = globalThis.poseidon377;
+    if (!api) {
+        throw new Error("poseidon377: module did not register its API");
+    }
+    return {
+        hash: wrapHash(api.hash),
+        multiHash: wrapHash(api.multiHash),
+        multiHashV2: wrapHash(api.multiHashV2),
+        domainFromLEBytes: (bytes) => unwrap(api.domainFromLEBytes(new Uint8Array(bytes))),
+    };
+}
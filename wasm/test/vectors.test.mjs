@@ -0,0 +1,57 @@
+// Checks the WebAssembly build against the shared vector suite. Run through `go test ./wasm`, or
+// directly with
+//
+//	POSEIDON377_WASM=poseidon377.wasm WASM_EXEC=$(go env GOROOT)/lib/wasm/wasm_exec.js node --test wasm/test
+import assert from "node:assert/strict";
+import { readFileSync } from "node:fs";
+import { before, describe, it } from "node:test";
+import { pathToFileURL } from "node:url";
+
+import { instantiate } from "../poseidon377.mjs";
+
+const suite = JSON.parse(readFileSync(new URL("../../testdata/vectors.json", import.meta.url), "utf8"));
+
+describe("poseidon377 wasm", () => {
+    let poseidon;
+
+    before(async () => {
+        const { POSEIDON377_WASM: wasm, WASM_EXEC: wasmExec } = process.env;
+        assert.ok(wasm && wasmExec, "set POSEIDON377_WASM and WASM_EXEC");
+        await import(pathToFileURL(wasmExec).href);
+        poseidon = await instantiate(readFileSync(wasm));
+    });
+
+    it("matches the hash vectors", () => {
+        assert.equal(suite.version, 2);
+        for (const v of suite.hash) {
+            if (v.domain_label) {
+                assert.equal(poseidon.domainFromLEBytes(Buffer.from(v.domain_label)), v.domain, v.name);
+            }
+            assert.equal(poseidon.hash(v.inputs, v.domain), v.output, v.name);
+        }
+    });
+
+    it("matches the multihash vectors", () => {
+        for (const v of suite.multihash) {
+            assert.equal(poseidon.multiHash(v.inputs, v.domain), v.output, v.name);
+        }
+        for (const v of suite.multihash_v2) {
+            assert.equal(poseidon.multiHashV2(v.inputs, v.domain), v.output, v.name);
+        }
+    });
+
+    it("accepts numbers, bigints and hex strings", () => {
+        const want = poseidon.hash(["1", "2"], "0");
+        assert.equal(poseidon.hash([1, 2n]), want);
+        assert.equal(poseidon.hash(["0x1", "0x02"], 0n), want);
+    });
+
+    it("rejects invalid input", () => {
+        assert.throws(() => poseidon.hash([], 0), /poseidon377/);
+        assert.throws(() => poseidon.hash([1, 2, 3, 4, 5, 6, 7, 8], 0), /poseidon377/);
+        assert.throws(() => poseidon.multiHash([], 0), /poseidon377/);
+        assert.throws(() => poseidon.hash([suite.modulus], 0), /not below the field modulus/);
+        assert.throws(() => poseidon.hash(["-1"], 0), /invalid value/);
+        assert.throws(() => poseidon.hash([{}], 0), TypeError);
+    });
+});
@@ -0,0 +1,94 @@
+//go:build !js
+
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// requireTools turns a missing node or tinygo into a failure instead of a skip, for CI runs that
+// must exercise both builds.
+const requireTools = "POSEIDON377_REQUIRE_TOOLS"
+
+// TestNodeHarness builds the module for GOOS=js GOARCH=wasm and runs test/vectors.test.mjs
+// against it under Node.
+func TestNodeHarness(t *testing.T) {
+	node := lookTool(t, "node")
+
+	// go test puts the toolchain that runs it first in PATH.
+	goBin := lookTool(t, "go")
+	wasm := filepath.Join(t.TempDir(), "poseidon377.wasm")
+	build := exec.Command(goBin, "build", "-o", wasm, ".")
+	build.Env = append(os.Environ(), "GOOS=js", "GOARCH=wasm")
+	if out, err := build.CombinedOutput(); err != nil {
+		t.Fatalf("build: %v\n%s", err, out)
+	}
+	runHarness(t, node, wasm, wasmExec(t, goBin))
+}
+
+// TestNodeHarnessTinyGo is TestNodeHarness for a module built with tinygo -target wasm, loaded
+// with the wasm_exec.js shipped with TinyGo.
+func TestNodeHarnessTinyGo(t *testing.T) {
+	node := lookTool(t, "node")
+	tinygo := lookTool(t, "tinygo")
+
+	wasm := filepath.Join(t.TempDir(), "poseidon377.wasm")
+	build := exec.Command(tinygo, "build", "-o", wasm, "-target", "wasm", ".")
+	if out, err := build.CombinedOutput(); err != nil {
+		t.Fatalf("tinygo build: %v\n%s", err, out)
+	}
+	out, err := exec.Command(tinygo, "env", "TINYGOROOT").Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	runHarness(t, node, wasm, filepath.Join(strings.TrimSpace(string(out)), "targets", "wasm_exec.js"))
+}
+
+func runHarness(t *testing.T, node, wasm, wasmExec string) {
+	t.Helper()
+	cmd := exec.Command(node, "--test", filepath.Join("test", "vectors.test.mjs"))
+	cmd.Env = append(os.Environ(),
+		"POSEIDON377_WASM="+wasm,
+		"WASM_EXEC="+wasmExec,
+	)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("node: %v\n%s", err, out)
+	}
+	t.Logf("%s", out)
+}
+
+// lookTool finds name in PATH; a missing tool skips the test unless POSEIDON377_REQUIRE_TOOLS is
+// set.
+func lookTool(t *testing.T, name string) string {
+	t.Helper()
+	path, err := exec.LookPath(name)
+	if err != nil {
+		if os.Getenv(requireTools) != "" {
+			t.Fatalf("%s not found and %s is set", name, requireTools)
+		}
+		t.Skipf("%s not found", name)
+	}
+	return path
+}
+
+// wasmExec locates wasm_exec.js: lib/wasm since Go 1.24, misc/wasm before.
+func wasmExec(t *testing.T, goBin string) string {
+	out, err := exec.Command(goBin, "env", "GOROOT").Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := strings.TrimSpace(string(out))
+	for _, dir := range []string{"lib/wasm", "misc/wasm"} {
+		p := filepath.Join(root, dir, "wasm_exec.js")
+		if _, err := os.Stat(p); err == nil {
+			return p
+		}
+	}
+	t.Fatalf("wasm_exec.js not found in GOROOT %s", root)
+	return ""
+}